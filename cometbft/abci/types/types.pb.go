@@ -1101,6 +1101,8 @@ type RequestPrepareProposal struct {
 	NextValidatorsHash []byte             `protobuf:"bytes,7,opt,name=next_validators_hash,json=nextValidatorsHash,proto3" json:"next_validators_hash,omitempty"`
 	// address of the public key of the validator proposing the block.
 	ProposerAddress []byte `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	// the round of the proposal.
+	Round int32 `protobuf:"varint,9,opt,name=round,proto3" json:"round,omitempty"`
 }
 
 func (m *RequestPrepareProposal) Reset()         { *m = RequestPrepareProposal{} }
@@ -1192,6 +1194,13 @@ func (m *RequestPrepareProposal) GetProposerAddress() []byte {
 	return nil
 }
 
+func (m *RequestPrepareProposal) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
 type RequestProcessProposal struct {
 	Txs                [][]byte      `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
 	ProposedLastCommit CommitInfo    `protobuf:"bytes,2,opt,name=proposed_last_commit,json=proposedLastCommit,proto3" json:"proposed_last_commit"`
@@ -1203,6 +1212,8 @@ type RequestProcessProposal struct {
 	NextValidatorsHash []byte    `protobuf:"bytes,7,opt,name=next_validators_hash,json=nextValidatorsHash,proto3" json:"next_validators_hash,omitempty"`
 	// address of the public key of the original proposer of the block.
 	ProposerAddress []byte `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	// the round of the proposal.
+	Round int32 `protobuf:"varint,9,opt,name=round,proto3" json:"round,omitempty"`
 }
 
 func (m *RequestProcessProposal) Reset()         { *m = RequestProcessProposal{} }
@@ -1294,6 +1305,13 @@ func (m *RequestProcessProposal) GetProposerAddress() []byte {
 	return nil
 }
 
+func (m *RequestProcessProposal) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
 // Extends a vote with application-injected data
 type RequestExtendVote struct {
 	// the hash of the block that this vote may be referring to
@@ -1407,6 +1425,9 @@ type RequestVerifyVoteExtension struct {
 	ValidatorAddress []byte `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Height           int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 	VoteExtension    []byte `protobuf:"bytes,4,opt,name=vote_extension,json=voteExtension,proto3" json:"vote_extension,omitempty"`
+	// the vote codes of the validator's prevote for the block, one per
+	// governance tx of the block, empty if the prevote was not received
+	VoteCodes []int64 `protobuf:"varint,5,rep,packed,name=vote_codes,json=voteCodes,proto3" json:"vote_codes,omitempty"`
 }
 
 func (m *RequestVerifyVoteExtension) Reset()         { *m = RequestVerifyVoteExtension{} }
@@ -1470,6 +1491,13 @@ func (m *RequestVerifyVoteExtension) GetVoteExtension() []byte {
 	return nil
 }
 
+func (m *RequestVerifyVoteExtension) GetVoteCodes() []int64 {
+	if m != nil {
+		return m.VoteCodes
+	}
+	return nil
+}
+
 type RequestFinalizeBlock struct {
 	Txs               [][]byte      `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
 	DecidedLastCommit CommitInfo    `protobuf:"bytes,2,opt,name=decided_last_commit,json=decidedLastCommit,proto3" json:"decided_last_commit"`
@@ -1480,8 +1508,8 @@ type RequestFinalizeBlock struct {
 	Time               time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
 	NextValidatorsHash []byte    `protobuf:"bytes,7,opt,name=next_validators_hash,json=nextValidatorsHash,proto3" json:"next_validators_hash,omitempty"`
 	// proposer_address is the address of the public key of the original proposer of the block.
-	ProposerAddress []byte `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
-	VoteCode        int64  `protobuf:"varint,9,opt,name=vote_code,json=voteCode,proto3" json:"vote_code,omitempty"`
+	ProposerAddress []byte  `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	VoteCodes       []int64 `protobuf:"varint,9,rep,packed,name=vote_codes,json=voteCodes,proto3" json:"vote_codes,omitempty"`
 }
 
 func (m *RequestFinalizeBlock) Reset()         { *m = RequestFinalizeBlock{} }
@@ -1573,11 +1601,11 @@ func (m *RequestFinalizeBlock) GetProposerAddress() []byte {
 	return nil
 }
 
-func (m *RequestFinalizeBlock) GetVoteCode() int64 {
+func (m *RequestFinalizeBlock) GetVoteCodes() []int64 {
 	if m != nil {
-		return m.VoteCode
+		return m.VoteCodes
 	}
-	return 0
+	return nil
 }
 
 type Response struct {
@@ -2612,8 +2640,8 @@ func (m *ResponsePrepareProposal) GetTxs() [][]byte {
 }
 
 type ResponseProcessProposal struct {
-	Status   ResponseProcessProposal_ProposalStatus `protobuf:"varint,1,opt,name=status,proto3,enum=tendermint.abci.ResponseProcessProposal_ProposalStatus" json:"status,omitempty"`
-	VoteCode int64                                  `protobuf:"varint,2,opt,name=vote_code,json=voteCode,proto3" json:"vote_code,omitempty"`
+	Status    ResponseProcessProposal_ProposalStatus `protobuf:"varint,1,opt,name=status,proto3,enum=tendermint.abci.ResponseProcessProposal_ProposalStatus" json:"status,omitempty"`
+	VoteCodes []int64                                `protobuf:"varint,2,rep,packed,name=vote_codes,json=voteCodes,proto3" json:"vote_codes,omitempty"`
 }
 
 func (m *ResponseProcessProposal) Reset()         { *m = ResponseProcessProposal{} }
@@ -2656,11 +2684,11 @@ func (m *ResponseProcessProposal) GetStatus() ResponseProcessProposal_ProposalSt
 	return ResponseProcessProposal_UNKNOWN
 }
 
-func (m *ResponseProcessProposal) GetVoteCode() int64 {
+func (m *ResponseProcessProposal) GetVoteCodes() []int64 {
 	if m != nil {
-		return m.VoteCode
+		return m.VoteCodes
 	}
-	return 0
+	return nil
 }
 
 type ResponseExtendVote struct {
@@ -3680,207 +3708,207 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 3191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xbb, 0x73, 0x1b, 0xd7,
-	0xd5, 0xc7, 0xe2, 0x8d, 0x83, 0x07, 0x97, 0x97, 0x94, 0x04, 0x41, 0x32, 0x49, 0xaf, 0xc7, 0xb6,
-	0x2c, 0xdb, 0xa4, 0x3f, 0xea, 0x93, 0x1f, 0x23, 0x3b, 0x33, 0x20, 0x04, 0x05, 0xa4, 0x64, 0x92,
-	0x5e, 0x82, 0xf2, 0x38, 0x0f, 0xaf, 0x97, 0xc0, 0x05, 0xb1, 0x16, 0x80, 0x5d, 0xef, 0x5e, 0xd0,
-	0xa0, 0xab, 0x4c, 0x3c, 0x99, 0xc9, 0xb8, 0xf2, 0x4c, 0x52, 0xb8, 0x88, 0x8b, 0x14, 0x69, 0xf2,
-	0x17, 0xa4, 0x4a, 0x9a, 0x14, 0x2e, 0x52, 0xb8, 0x4c, 0xe5, 0x64, 0xec, 0xce, 0x6d, 0x8a, 0xb4,
-	0x99, 0xfb, 0xd8, 0x17, 0x80, 0x25, 0x00, 0xd9, 0x29, 0x32, 0x49, 0xb7, 0xf7, 0xec, 0x39, 0xe7,
-	0xee, 0x3d, 0xf7, 0xdc, 0xf3, 0xf8, 0xed, 0x85, 0x6b, 0x04, 0x0f, 0xda, 0xd8, 0xee, 0x1b, 0x03,
-	0xb2, 0xa5, 0x9f, 0xb4, 0x8c, 0x2d, 0x72, 0x6e, 0x61, 0x67, 0xd3, 0xb2, 0x4d, 0x62, 0xa2, 0x25,
-	0xff, 0xe5, 0x26, 0x7d, 0x59, 0x79, 0x22, 0xc0, 0xdd, 0xb2, 0xcf, 0x2d, 0x62, 0x6e, 0x59, 0xb6,
-	0x69, 0x76, 0x38, 0x7f, 0xe5, 0xfa, 0xe4, 0xeb, 0x47, 0xf8, 0x5c, 0x68, 0x0b, 0x09, 0xb3, 0x59,
-	0xb6, 0x2c, 0xdd, 0xd6, 0xfb, 0xee, 0xeb, 0x8d, 0x89, 0xd7, 0x67, 0x7a, 0xcf, 0x68, 0xeb, 0xc4,
-	0xb4, 0x05, 0xc7, 0xfa, 0xa9, 0x69, 0x9e, 0xf6, 0xf0, 0x16, 0x1b, 0x9d, 0x0c, 0x3b, 0x5b, 0xc4,
-	0xe8, 0x63, 0x87, 0xe8, 0x7d, 0x4b, 0x30, 0xac, 0x9e, 0x9a, 0xa7, 0x26, 0x7b, 0xdc, 0xa2, 0x4f,
-	0x9c, 0xaa, 0xfc, 0x29, 0x07, 0x19, 0x15, 0x7f, 0x30, 0xc4, 0x0e, 0x41, 0xdb, 0x90, 0xc4, 0xad,
-	0xae, 0x59, 0x96, 0x36, 0xa4, 0x1b, 0xf9, 0xed, 0xeb, 0x9b, 0x63, 0x0b, 0xdc, 0x14, 0x7c, 0xf5,
-	0x56, 0xd7, 0x6c, 0xc4, 0x54, 0xc6, 0x8b, 0x6e, 0x43, 0xaa, 0xd3, 0x1b, 0x3a, 0xdd, 0x72, 0x9c,
-	0x09, 0x3d, 0x11, 0x25, 0x74, 0x8f, 0x32, 0x35, 0x62, 0x2a, 0xe7, 0xa6, 0x53, 0x19, 0x83, 0x8e,
-	0x59, 0x4e, 0x5c, 0x3c, 0xd5, 0xee, 0xa0, 0xc3, 0xa6, 0xa2, 0xbc, 0x68, 0x07, 0xc0, 0x18, 0x18,
-	0x44, 0x6b, 0x75, 0x75, 0x63, 0x50, 0x4e, 0x31, 0xc9, 0x27, 0xa3, 0x25, 0x0d, 0x52, 0xa3, 0x8c,
-	0x8d, 0x98, 0x9a, 0x33, 0xdc, 0x01, 0xfd, 0xdc, 0x0f, 0x86, 0xd8, 0x3e, 0x2f, 0xa7, 0x2f, 0xfe,
-	0xdc, 0xb7, 0x28, 0x13, 0xfd, 0x5c, 0xc6, 0x8d, 0x5e, 0x87, 0x6c, 0xab, 0x8b, 0x5b, 0x8f, 0x34,
-	0x32, 0x2a, 0x67, 0x99, 0xe4, 0x7a, 0x94, 0x64, 0x8d, 0xf2, 0x35, 0x47, 0x8d, 0x98, 0x9a, 0x69,
-	0xf1, 0x47, 0xf4, 0x2a, 0xa4, 0x5b, 0x66, 0xbf, 0x6f, 0x90, 0x72, 0x9e, 0xc9, 0xae, 0x45, 0xca,
-	0x32, 0xae, 0x46, 0x4c, 0x15, 0xfc, 0x68, 0x1f, 0x4a, 0x3d, 0xc3, 0x21, 0x9a, 0x33, 0xd0, 0x2d,
-	0xa7, 0x6b, 0x12, 0xa7, 0x5c, 0x60, 0x1a, 0x9e, 0x8e, 0xd2, 0xf0, 0xc0, 0x70, 0xc8, 0x91, 0xcb,
-	0xdc, 0x88, 0xa9, 0xc5, 0x5e, 0x90, 0x40, 0xf5, 0x99, 0x9d, 0x0e, 0xb6, 0x3d, 0x85, 0xe5, 0xe2,
-	0xc5, 0xfa, 0x0e, 0x28, 0xb7, 0x2b, 0x4f, 0xf5, 0x99, 0x41, 0x02, 0xfa, 0x31, 0xac, 0xf4, 0x4c,
-	0xbd, 0xed, 0xa9, 0xd3, 0x5a, 0xdd, 0xe1, 0xe0, 0x51, 0xb9, 0xc4, 0x94, 0x3e, 0x17, 0xf9, 0x91,
-	0xa6, 0xde, 0x76, 0x55, 0xd4, 0xa8, 0x40, 0x23, 0xa6, 0x2e, 0xf7, 0xc6, 0x89, 0xe8, 0x5d, 0x58,
-	0xd5, 0x2d, 0xab, 0x77, 0x3e, 0xae, 0x7d, 0x89, 0x69, 0xbf, 0x19, 0xa5, 0xbd, 0x4a, 0x65, 0xc6,
-	0xd5, 0x23, 0x7d, 0x82, 0x8a, 0x9a, 0x20, 0x5b, 0x36, 0xb6, 0x74, 0x1b, 0x6b, 0x96, 0x6d, 0x5a,
-	0xa6, 0xa3, 0xf7, 0xca, 0x32, 0xd3, 0xfd, 0x6c, 0x94, 0xee, 0x43, 0xce, 0x7f, 0x28, 0xd8, 0x1b,
-	0x31, 0x75, 0xc9, 0x0a, 0x93, 0xb8, 0x56, 0xb3, 0x85, 0x1d, 0xc7, 0xd7, 0xba, 0x3c, 0x4b, 0x2b,
-	0xe3, 0x0f, 0x6b, 0x0d, 0x91, 0x50, 0x1d, 0xf2, 0x78, 0x44, 0xc5, 0xb5, 0x33, 0x93, 0xe0, 0x32,
-	0x62, 0x0a, 0x95, 0xc8, 0x13, 0xca, 0x58, 0x1f, 0x9a, 0x04, 0x37, 0x62, 0x2a, 0x60, 0x6f, 0x84,
-	0x74, 0xb8, 0x74, 0x86, 0x6d, 0xa3, 0x73, 0xce, 0xd4, 0x68, 0xec, 0x8d, 0x63, 0x98, 0x83, 0xf2,
-	0x0a, 0x53, 0xf8, 0x7c, 0x94, 0xc2, 0x87, 0x4c, 0x88, 0xaa, 0xa8, 0xbb, 0x22, 0x8d, 0x98, 0xba,
-	0x72, 0x36, 0x49, 0xa6, 0x2e, 0xd6, 0x31, 0x06, 0x7a, 0xcf, 0xf8, 0x08, 0x6b, 0x27, 0x3d, 0xb3,
-	0xf5, 0xa8, 0xbc, 0x7a, 0xb1, 0x8b, 0xdd, 0x13, 0xdc, 0x3b, 0x94, 0x99, 0xba, 0x58, 0x27, 0x48,
-	0xd8, 0xc9, 0x40, 0xea, 0x4c, 0xef, 0x0d, 0xf1, 0x5e, 0x32, 0x9b, 0x94, 0x53, 0x7b, 0xc9, 0x6c,
-	0x46, 0xce, 0xee, 0x25, 0xb3, 0x39, 0x19, 0xf6, 0x92, 0x59, 0x90, 0xf3, 0xca, 0xb3, 0x90, 0x0f,
-	0x04, 0x26, 0x54, 0x86, 0x4c, 0x1f, 0x3b, 0x8e, 0x7e, 0x8a, 0x59, 0x1c, 0xcb, 0xa9, 0xee, 0x50,
-	0x29, 0x41, 0x21, 0x18, 0x8c, 0x94, 0x4f, 0x25, 0x4f, 0x92, 0xc6, 0x19, 0x2a, 0x79, 0x86, 0x6d,
-	0x66, 0x0e, 0x21, 0x29, 0x86, 0xe8, 0x29, 0x28, 0xb2, 0xa5, 0x68, 0xee, 0x7b, 0x1a, 0xec, 0x92,
-	0x6a, 0x81, 0x11, 0x1f, 0x0a, 0xa6, 0x75, 0xc8, 0x5b, 0xdb, 0x96, 0xc7, 0x92, 0x60, 0x2c, 0x60,
-	0x6d, 0x5b, 0x2e, 0xc3, 0x93, 0x50, 0xa0, 0xeb, 0xf6, 0x38, 0x92, 0x6c, 0x92, 0x3c, 0xa5, 0x09,
-	0x16, 0xe5, 0x2f, 0x71, 0x90, 0xc7, 0x03, 0x18, 0x7a, 0x15, 0x92, 0x34, 0x96, 0x8b, 0xb0, 0x5c,
-	0xd9, 0xe4, 0x81, 0x7e, 0xd3, 0x0d, 0xf4, 0x9b, 0x4d, 0x37, 0xd0, 0xef, 0x64, 0xbf, 0xf8, 0x6a,
-	0x3d, 0xf6, 0xe9, 0xdf, 0xd6, 0x25, 0x95, 0x49, 0xa0, 0xab, 0x34, 0x6c, 0xe9, 0xc6, 0x40, 0x33,
-	0xda, 0xec, 0x93, 0x73, 0x34, 0x26, 0xe9, 0xc6, 0x60, 0xb7, 0x8d, 0x1e, 0x80, 0xdc, 0x32, 0x07,
-	0x0e, 0x1e, 0x38, 0x43, 0x47, 0xe3, 0xa9, 0x46, 0x04, 0xe3, 0x50, 0x48, 0xe5, 0x09, 0xaf, 0xe6,
-	0x72, 0x1e, 0x32, 0x46, 0x75, 0xa9, 0x15, 0x26, 0xa0, 0x7b, 0x00, 0x5e, 0x3e, 0x72, 0xca, 0xc9,
-	0x8d, 0xc4, 0x8d, 0xfc, 0xf6, 0xc6, 0xc4, 0x86, 0x3f, 0x74, 0x59, 0x8e, 0xad, 0xb6, 0x4e, 0xf0,
-	0x4e, 0x92, 0x7e, 0xae, 0x1a, 0x90, 0x44, 0xcf, 0xc0, 0x92, 0x6e, 0x59, 0x9a, 0x43, 0x74, 0x82,
-	0xb5, 0x93, 0x73, 0x82, 0x1d, 0x16, 0xe7, 0x0b, 0x6a, 0x51, 0xb7, 0xac, 0x23, 0x4a, 0xdd, 0xa1,
-	0x44, 0xf4, 0x34, 0x94, 0x68, 0x4c, 0x37, 0xf4, 0x9e, 0xd6, 0xc5, 0xc6, 0x69, 0x97, 0xb0, 0x78,
-	0x9e, 0x50, 0x8b, 0x82, 0xda, 0x60, 0x44, 0xa5, 0xed, 0xed, 0x38, 0x8b, 0xe7, 0x08, 0x41, 0xb2,
-	0xad, 0x13, 0x9d, 0x59, 0xb2, 0xa0, 0xb2, 0x67, 0x4a, 0xb3, 0x74, 0xd2, 0x15, 0xf6, 0x61, 0xcf,
-	0xe8, 0x32, 0xa4, 0x85, 0xda, 0x04, 0x53, 0x2b, 0x46, 0x68, 0x15, 0x52, 0x96, 0x6d, 0x9e, 0x61,
-	0xb6, 0x75, 0x59, 0x95, 0x0f, 0x14, 0x15, 0x4a, 0xe1, 0xd8, 0x8f, 0x4a, 0x10, 0x27, 0x23, 0x31,
-	0x4b, 0x9c, 0x8c, 0xd0, 0x4b, 0x90, 0xa4, 0x86, 0x64, 0x73, 0x94, 0xa6, 0x64, 0x3b, 0x21, 0xd7,
-	0x3c, 0xb7, 0xb0, 0xca, 0x38, 0x95, 0x25, 0x28, 0x86, 0x72, 0x82, 0x72, 0x19, 0x56, 0xa7, 0x85,
-	0x78, 0xa5, 0xeb, 0xd1, 0x43, 0xa1, 0x1a, 0xdd, 0x86, 0xac, 0x17, 0xe3, 0xb9, 0xe3, 0x5c, 0x9d,
-	0x98, 0xd6, 0x65, 0x56, 0x3d, 0x56, 0xea, 0x31, 0x74, 0x03, 0xba, 0xba, 0xc8, 0xe8, 0x05, 0x35,
-	0xa3, 0x5b, 0x56, 0x43, 0x77, 0xba, 0xca, 0x7b, 0x50, 0x8e, 0x8a, 0xdf, 0x01, 0x83, 0x49, 0xcc,
-	0xed, 0x5d, 0x83, 0x5d, 0x86, 0x74, 0xc7, 0xb4, 0xfb, 0x3a, 0x61, 0xca, 0x8a, 0xaa, 0x18, 0x51,
-	0x43, 0xf2, 0x58, 0x9e, 0x60, 0x64, 0x3e, 0x50, 0x34, 0xb8, 0x1a, 0x19, 0xc3, 0xa9, 0x88, 0x31,
-	0x68, 0x63, 0x6e, 0xd6, 0xa2, 0xca, 0x07, 0xbe, 0x22, 0xfe, 0xb1, 0x7c, 0x40, 0xa7, 0x75, 0xd8,
-	0x5a, 0x99, 0xfe, 0x9c, 0x2a, 0x46, 0xca, 0x67, 0x09, 0xb8, 0x3c, 0x3d, 0x92, 0xa3, 0x0d, 0x28,
-	0xf4, 0xf5, 0x91, 0x46, 0x46, 0xc2, 0xed, 0x24, 0xb6, 0xf1, 0xd0, 0xd7, 0x47, 0xcd, 0x11, 0xf7,
-	0x39, 0x19, 0x12, 0x64, 0xe4, 0x94, 0xe3, 0x1b, 0x89, 0x1b, 0x05, 0x95, 0x3e, 0xa2, 0x63, 0x58,
-	0xee, 0x99, 0x2d, 0xbd, 0xa7, 0xf5, 0x74, 0x87, 0x68, 0x22, 0xc5, 0xf3, 0x43, 0xf4, 0xd4, 0x84,
-	0xb1, 0x79, 0x4c, 0xc6, 0x6d, 0xbe, 0x9f, 0x34, 0xe0, 0x08, 0xff, 0x5f, 0x62, 0x3a, 0x1e, 0xe8,
-	0xee, 0x56, 0xa3, 0xbb, 0x90, 0xef, 0x1b, 0xce, 0x09, 0xee, 0xea, 0x67, 0x86, 0x69, 0x8b, 0xd3,
-	0x34, 0xe9, 0x34, 0x6f, 0xfa, 0x3c, 0x42, 0x53, 0x50, 0x2c, 0xb0, 0x25, 0xa9, 0x90, 0x0f, 0xbb,
-	0xd1, 0x24, 0xbd, 0x70, 0x34, 0x79, 0x09, 0x56, 0x07, 0x78, 0x44, 0x34, 0xff, 0xbc, 0x72, 0x3f,
-	0xc9, 0x30, 0xd3, 0x23, 0xfa, 0xce, 0x3b, 0xe1, 0x0e, 0x75, 0x19, 0xf4, 0x1c, 0xcb, 0x85, 0x96,
-	0xe9, 0x60, 0x5b, 0xd3, 0xdb, 0x6d, 0x1b, 0x3b, 0x0e, 0x2b, 0x9f, 0x0a, 0x2c, 0xc1, 0x31, 0x7a,
-	0x95, 0x93, 0x95, 0x5f, 0x06, 0xb7, 0x26, 0x9c, 0xfb, 0x84, 0xe1, 0x25, 0xdf, 0xf0, 0x47, 0xb0,
-	0x2a, 0xe4, 0xdb, 0x21, 0xdb, 0xf3, 0x1a, 0xf4, 0xda, 0xe4, 0xf9, 0x1a, 0xb7, 0x39, 0x72, 0xc5,
-	0xa3, 0xcd, 0x9e, 0x78, 0x3c, 0xb3, 0x23, 0x48, 0x32, 0xa3, 0x24, 0x79, 0x88, 0xa1, 0xcf, 0xff,
-	0x69, 0x5b, 0xf1, 0x71, 0x02, 0x96, 0x27, 0x0a, 0x09, 0x6f, 0x61, 0xd2, 0xd4, 0x85, 0xc5, 0xa7,
-	0x2e, 0x2c, 0xb1, 0xf0, 0xc2, 0xc4, 0x5e, 0x27, 0x67, 0xef, 0x75, 0xea, 0x7b, 0xdc, 0xeb, 0xf4,
-	0xe3, 0xed, 0xf5, 0xbf, 0x75, 0x17, 0x7e, 0x23, 0x41, 0x25, 0xba, 0xfa, 0x9a, 0xba, 0x1d, 0xcf,
-	0xc3, 0xb2, 0xf7, 0x29, 0x9e, 0x7a, 0x1e, 0x18, 0x65, 0xef, 0x85, 0xd0, 0x1f, 0x99, 0xe3, 0x9e,
-	0x86, 0xd2, 0x58, 0x6d, 0xc8, 0x5d, 0xb9, 0x78, 0x16, 0x9c, 0x5f, 0xf9, 0x6d, 0xc2, 0x4b, 0x3c,
-	0xa1, 0x02, 0x6e, 0xca, 0x69, 0x7d, 0x0b, 0x56, 0xda, 0xb8, 0x65, 0xb4, 0x1f, 0xf7, 0xb0, 0x2e,
-	0x0b, 0xe9, 0xff, 0x9d, 0xd5, 0x09, 0x2f, 0x41, 0xd7, 0x20, 0xc7, 0x76, 0xab, 0x65, 0xb6, 0x71,
-	0x39, 0xc7, 0xbe, 0x38, 0x4b, 0x09, 0x35, 0xb3, 0x8d, 0x95, 0x5f, 0x03, 0x64, 0x55, 0xec, 0x58,
-	0xb4, 0x58, 0x43, 0x3b, 0x90, 0xc3, 0xa3, 0x16, 0xb6, 0x88, 0x5b, 0xdf, 0x4e, 0xef, 0x1f, 0x38,
-	0x77, 0xdd, 0xe5, 0xa4, 0xdd, 0xb3, 0x27, 0x86, 0x6e, 0x09, 0x80, 0x20, 0xba, 0xd7, 0x17, 0xe2,
-	0x41, 0x84, 0xe0, 0x65, 0x17, 0x21, 0x48, 0x44, 0x36, 0xbf, 0x5c, 0x6a, 0x0c, 0x22, 0xb8, 0x25,
-	0x20, 0x82, 0xe4, 0x8c, 0xc9, 0x42, 0x18, 0x41, 0x2d, 0x84, 0x11, 0xa4, 0x67, 0x2c, 0x33, 0x02,
-	0x24, 0x78, 0xd9, 0x05, 0x09, 0x32, 0x33, 0xbe, 0x78, 0x0c, 0x25, 0x78, 0x23, 0x80, 0x12, 0xe4,
-	0x98, 0xe8, 0x46, 0xa4, 0xe8, 0x14, 0x98, 0xe0, 0x35, 0x0f, 0x26, 0x28, 0x44, 0x42, 0x0c, 0x42,
-	0x78, 0x1c, 0x27, 0x38, 0x98, 0xc0, 0x09, 0x78, 0x5f, 0xff, 0x4c, 0xa4, 0x8a, 0x19, 0x40, 0xc1,
-	0xc1, 0x04, 0x50, 0x50, 0x9a, 0xa1, 0x70, 0x06, 0x52, 0xf0, 0x93, 0xe9, 0x48, 0x41, 0x74, 0x2f,
-	0x2f, 0x3e, 0x73, 0x3e, 0xa8, 0x40, 0x8b, 0x80, 0x0a, 0xe4, 0xc8, 0xb6, 0x96, 0xab, 0x9f, 0x1b,
-	0x2b, 0x38, 0x9e, 0x82, 0x15, 0xf0, 0xae, 0xfe, 0x46, 0xa4, 0xf2, 0x39, 0xc0, 0x82, 0xe3, 0x29,
-	0x60, 0x01, 0x9a, 0xa9, 0x76, 0x26, 0x5a, 0x70, 0x2f, 0x8c, 0x16, 0xac, 0x44, 0x94, 0xa4, 0xfe,
-	0x69, 0x8f, 0x80, 0x0b, 0x4e, 0xa2, 0xe0, 0x02, 0xde, 0xd2, 0xbf, 0x10, 0xa9, 0x71, 0x01, 0xbc,
-	0xe0, 0x60, 0x02, 0x2f, 0xb8, 0x34, 0xc3, 0xd3, 0xe6, 0x07, 0x0c, 0x52, 0x72, 0x7a, 0x2f, 0x99,
-	0xcd, 0xca, 0x39, 0x0e, 0x15, 0xec, 0x25, 0xb3, 0x79, 0xb9, 0xa0, 0x3c, 0x47, 0xcb, 0x9b, 0xb1,
-	0x38, 0x47, 0x1b, 0x09, 0x6c, 0xdb, 0xa6, 0x2d, 0x5a, 0x7f, 0x3e, 0x50, 0x6e, 0xd0, 0x06, 0xd2,
-	0x8f, 0x69, 0x17, 0x80, 0x0b, 0xac, 0x61, 0x0b, 0xc4, 0x31, 0xe5, 0x0f, 0x92, 0x2f, 0xcb, 0xe0,
-	0x85, 0x60, 0xf3, 0x99, 0x13, 0xcd, 0x67, 0x00, 0x72, 0x88, 0x87, 0x21, 0x87, 0x75, 0xc8, 0xd3,
-	0x46, 0x6c, 0x0c, 0x4d, 0xd0, 0x2d, 0x0f, 0x4d, 0xb8, 0x09, 0xcb, 0x2c, 0x9b, 0x72, 0x60, 0x42,
-	0xe4, 0xac, 0x24, 0xcb, 0x00, 0x4b, 0xf4, 0x05, 0xb7, 0x0e, 0x4f, 0x5e, 0x2f, 0xc2, 0x4a, 0x80,
-	0xd7, 0x6b, 0xf0, 0x78, 0x6b, 0x2d, 0x7b, 0xdc, 0x55, 0xd1, 0xe9, 0xfd, 0x59, 0xf2, 0x2d, 0xe4,
-	0xc3, 0x10, 0xd3, 0x10, 0x03, 0xe9, 0x7b, 0x42, 0x0c, 0xe2, 0x8f, 0x8d, 0x18, 0x04, 0x1b, 0xd6,
-	0x44, 0xb8, 0x61, 0xfd, 0xa7, 0xe4, 0xef, 0x89, 0xd7, 0xff, 0xb3, 0x44, 0xc9, 0x5b, 0x48, 0xf6,
-	0x4c, 0xeb, 0x95, 0x9e, 0x79, 0x2a, 0x1a, 0x45, 0xfa, 0x48, 0xb9, 0xbc, 0xc4, 0x93, 0x13, 0x79,
-	0xc5, 0xeb, 0x3e, 0x79, 0x55, 0x20, 0xba, 0x4f, 0x19, 0x12, 0x8f, 0x30, 0xc7, 0x92, 0x0b, 0x2a,
-	0x7d, 0xa4, 0x7c, 0xcc, 0xf9, 0x44, 0x76, 0xe7, 0x03, 0xf4, 0x2a, 0xe4, 0xd8, 0x9f, 0x00, 0xcd,
-	0xb4, 0x1c, 0x81, 0x1f, 0x87, 0xea, 0x1e, 0xfe, 0x3b, 0x60, 0xf3, 0x90, 0xf2, 0x1c, 0x58, 0x8e,
-	0x9a, 0xb5, 0xc4, 0x53, 0xa0, 0x1c, 0xc9, 0x85, 0xca, 0x91, 0xeb, 0x90, 0xa3, 0x5f, 0xef, 0x58,
-	0x7a, 0x0b, 0x97, 0x81, 0x7d, 0xa8, 0x4f, 0x50, 0x7e, 0x1f, 0x87, 0xa5, 0xb1, 0x44, 0x33, 0x75,
-	0xed, 0xae, 0x4b, 0xc6, 0x03, 0x78, 0xc8, 0x7c, 0xf6, 0x58, 0x03, 0x38, 0xd5, 0x1d, 0xed, 0x43,
-	0x7d, 0x40, 0x70, 0x5b, 0x18, 0x25, 0x40, 0x41, 0x15, 0xc8, 0xd2, 0xd1, 0xd0, 0xc1, 0x6d, 0x01,
-	0xcd, 0x78, 0x63, 0xd4, 0x80, 0x34, 0x3e, 0xc3, 0x03, 0xe2, 0x94, 0x33, 0x6c, 0xdb, 0x2f, 0x4f,
-	0xf6, 0xca, 0xf4, 0xf5, 0x4e, 0x99, 0x6e, 0xf6, 0xb7, 0x5f, 0xad, 0xcb, 0x9c, 0xfb, 0x05, 0xb3,
-	0x6f, 0x10, 0xdc, 0xb7, 0xc8, 0xb9, 0x2a, 0xe4, 0xc3, 0x56, 0xc8, 0x8e, 0x59, 0x81, 0x81, 0x84,
-	0x05, 0xb7, 0xf7, 0xa7, 0x36, 0x35, 0x4c, 0xdb, 0x20, 0xe7, 0x6a, 0xb1, 0x8f, 0xfb, 0x96, 0x69,
-	0xf6, 0x34, 0x7e, 0xc6, 0xab, 0x50, 0x0a, 0xe7, 0x55, 0xf4, 0x14, 0x14, 0x6d, 0x4c, 0x74, 0x63,
-	0xa0, 0x85, 0x2a, 0xe4, 0x02, 0x27, 0xf2, 0x33, 0xb5, 0x97, 0xcc, 0x4a, 0x72, 0x7c, 0x2f, 0x99,
-	0x8d, 0xcb, 0x09, 0xe5, 0x10, 0x2e, 0x4d, 0xcd, 0xab, 0xe8, 0x15, 0xc8, 0xf9, 0x29, 0x59, 0x62,
-	0xab, 0xbd, 0x00, 0x86, 0xf1, 0x79, 0x95, 0x3f, 0x4a, 0xbe, 0xca, 0x30, 0xb0, 0x53, 0x87, 0xb4,
-	0x8d, 0x9d, 0x61, 0x8f, 0x43, 0x2d, 0xa5, 0xed, 0x17, 0xe7, 0xcb, 0xc8, 0x94, 0x3a, 0xec, 0x11,
-	0x55, 0x08, 0x2b, 0xef, 0x42, 0x9a, 0x53, 0x50, 0x1e, 0x32, 0xc7, 0xfb, 0xf7, 0xf7, 0x0f, 0xde,
-	0xde, 0x97, 0x63, 0x08, 0x20, 0x5d, 0xad, 0xd5, 0xea, 0x87, 0x4d, 0x59, 0x42, 0x39, 0x48, 0x55,
-	0x77, 0x0e, 0xd4, 0xa6, 0x1c, 0xa7, 0x64, 0xb5, 0xbe, 0x57, 0xaf, 0x35, 0xe5, 0x04, 0x5a, 0x86,
-	0x22, 0x7f, 0xd6, 0xee, 0x1d, 0xa8, 0x6f, 0x56, 0x9b, 0x72, 0x32, 0x40, 0x3a, 0xaa, 0xef, 0xdf,
-	0xad, 0xab, 0x72, 0x4a, 0xf9, 0x3f, 0xb8, 0x1a, 0x99, 0xc3, 0x7d, 0xd4, 0x46, 0x0a, 0xa0, 0x36,
-	0xca, 0x67, 0x71, 0xda, 0xf1, 0x44, 0x25, 0x66, 0xb4, 0x37, 0xb6, 0xf0, 0xed, 0x05, 0xb2, 0xfa,
-	0xd8, 0xea, 0x69, 0x93, 0x63, 0xe3, 0x0e, 0x26, 0xad, 0x2e, 0x2f, 0x14, 0x78, 0x04, 0x2a, 0xaa,
-	0x45, 0x41, 0x65, 0x42, 0x0e, 0x67, 0x7b, 0x1f, 0xb7, 0x88, 0xc6, 0x9d, 0xc8, 0x61, 0x9d, 0x46,
-	0x8e, 0xb2, 0x51, 0xea, 0x11, 0x27, 0x2a, 0xef, 0x2d, 0x64, 0xcb, 0x1c, 0xa4, 0xd4, 0x7a, 0x53,
-	0x7d, 0x47, 0x4e, 0x20, 0x04, 0x25, 0xf6, 0xa8, 0x1d, 0xed, 0x57, 0x0f, 0x8f, 0x1a, 0x07, 0xd4,
-	0x96, 0x2b, 0xb0, 0xe4, 0xda, 0xd2, 0x25, 0xa6, 0x94, 0xe7, 0xe1, 0x4a, 0x44, 0x55, 0x31, 0xd9,
-	0x6f, 0x51, 0xdf, 0xb9, 0x12, 0x51, 0x2c, 0xa0, 0x03, 0x48, 0x3b, 0x44, 0x27, 0x43, 0x47, 0x18,
-	0xf1, 0x95, 0x79, 0xcb, 0x8c, 0x4d, 0xf7, 0xe1, 0x88, 0x89, 0xab, 0x42, 0x4d, 0xb8, 0x01, 0x89,
-	0x8f, 0x35, 0x20, 0xb7, 0xa1, 0x14, 0x16, 0x8b, 0x36, 0x90, 0xef, 0x61, 0x71, 0xe5, 0x0e, 0xa0,
-	0xc9, 0xd2, 0x64, 0x4a, 0x63, 0x2a, 0x4d, 0x6b, 0x4c, 0x7f, 0x27, 0xc1, 0xb5, 0x0b, 0xca, 0x10,
-	0xf4, 0xd6, 0x98, 0x05, 0x5e, 0x5b, 0xa4, 0x88, 0xd9, 0xe4, 0xb4, 0xb0, 0x0d, 0x94, 0x5b, 0x50,
-	0x08, 0xd2, 0xe7, 0x5b, 0xe4, 0xb7, 0x71, 0xff, 0x84, 0x87, 0x3b, 0x68, 0x3f, 0x3e, 0x4a, 0xdf,
-	0x31, 0x3e, 0xbe, 0x0e, 0x40, 0x46, 0x1a, 0xf7, 0x79, 0x37, 0xc9, 0x3e, 0x31, 0x05, 0x99, 0xc4,
-	0xad, 0xe6, 0x48, 0x9c, 0x90, 0x1c, 0x11, 0x4f, 0x0e, 0x3a, 0x0a, 0xc2, 0x09, 0x43, 0x96, 0x80,
-	0x1d, 0xd1, 0x6a, 0xcf, 0x9b, 0xa9, 0x7d, 0xd8, 0x81, 0x93, 0x1d, 0xf4, 0x0e, 0x5c, 0x19, 0xab,
-	0x22, 0x3c, 0xd5, 0xc9, 0x79, 0x8b, 0x89, 0x4b, 0xe1, 0x62, 0xc2, 0x55, 0x1d, 0x2c, 0x05, 0x52,
-	0xe1, 0x52, 0xe0, 0x1d, 0x00, 0x1f, 0x56, 0xa0, 0xe1, 0xc7, 0x36, 0x87, 0x83, 0x36, 0xf3, 0x80,
-	0x94, 0xca, 0x07, 0xe8, 0x36, 0xa4, 0xa8, 0x27, 0xb9, 0x76, 0x9a, 0x8c, 0xd3, 0xd4, 0x13, 0x02,
-	0xb0, 0x04, 0xe7, 0x56, 0x0c, 0x40, 0x93, 0xd0, 0x6e, 0xc4, 0x14, 0x6f, 0x84, 0xa7, 0x78, 0x32,
-	0x12, 0x24, 0x9e, 0x3e, 0xd5, 0x47, 0x90, 0x62, 0x3b, 0x4f, 0x33, 0x32, 0xfb, 0x9f, 0x20, 0x4a,
-	0x49, 0xfa, 0x8c, 0x7e, 0x0a, 0xa0, 0x13, 0x62, 0x1b, 0x27, 0x43, 0x7f, 0x82, 0xf5, 0xe9, 0x9e,
-	0x53, 0x75, 0xf9, 0x76, 0xae, 0x0b, 0x17, 0x5a, 0xf5, 0x45, 0x03, 0x6e, 0x14, 0x50, 0xa8, 0xec,
-	0x43, 0x29, 0x2c, 0xeb, 0x16, 0x3f, 0xfc, 0x1b, 0xc2, 0xc5, 0x0f, 0xaf, 0x65, 0x45, 0xf1, 0xe3,
-	0x95, 0x4e, 0x09, 0xfe, 0xd3, 0x84, 0x0d, 0x94, 0x9f, 0xc5, 0xa1, 0x10, 0x74, 0xbc, 0xff, 0xbe,
-	0xfa, 0x44, 0xf9, 0x85, 0x04, 0x59, 0x6f, 0xf9, 0xe1, 0x3f, 0x28, 0xa1, 0x5f, 0x4e, 0xdc, 0x7a,
-	0xf1, 0xe0, 0x6f, 0x0f, 0xfe, 0x83, 0x29, 0xe1, 0xfd, 0x60, 0xba, 0xe3, 0xe5, 0xc6, 0x28, 0xb4,
-	0x24, 0x68, 0x6b, 0xe1, 0x55, 0x6e, 0x29, 0x70, 0x07, 0x72, 0xde, 0xe9, 0xa5, 0x1d, 0x89, 0x0b,
-	0x39, 0x49, 0xe2, 0x0c, 0x09, 0xa8, 0x69, 0x15, 0x52, 0x96, 0xf9, 0xa1, 0xf8, 0xa7, 0x92, 0x50,
-	0xf9, 0x40, 0x69, 0xc3, 0xd2, 0xd8, 0xd1, 0x47, 0x77, 0x20, 0x63, 0x0d, 0x4f, 0x34, 0xd7, 0x39,
-	0xc6, 0x80, 0x39, 0xb7, 0xd6, 0x1d, 0x9e, 0xf4, 0x8c, 0xd6, 0x7d, 0x7c, 0xee, 0x7e, 0x8c, 0x35,
-	0x3c, 0xb9, 0xcf, 0x7d, 0x88, 0xcf, 0x12, 0x0f, 0xce, 0xf2, 0x2b, 0x09, 0xb2, 0xee, 0x99, 0x40,
-	0x3f, 0x80, 0x9c, 0x17, 0x56, 0xbc, 0x9f, 0xa2, 0x91, 0xf1, 0x48, 0xe8, 0xf7, 0x45, 0x50, 0xd5,
-	0xfd, 0x9b, 0x6b, 0xb4, 0xb5, 0x4e, 0x4f, 0xe7, 0xbe, 0x54, 0x0a, 0xdb, 0x8c, 0x07, 0x1e, 0x16,
-	0x8f, 0x77, 0xef, 0xde, 0xeb, 0xe9, 0xa7, 0x6a, 0x9e, 0xc9, 0xec, 0xb6, 0xe9, 0x40, 0x94, 0x7d,
-	0xff, 0x90, 0x40, 0x1e, 0x3f, 0xb1, 0xdf, 0xf9, 0xeb, 0x26, 0xd3, 0x5c, 0x62, 0x4a, 0x9a, 0x43,
-	0x5b, 0xb0, 0xe2, 0x71, 0x68, 0x8e, 0x71, 0x3a, 0xd0, 0xc9, 0xd0, 0xc6, 0x02, 0xca, 0x44, 0xde,
-	0xab, 0x23, 0xf7, 0xcd, 0xe4, 0xaa, 0x53, 0x8f, 0xb9, 0xea, 0x8f, 0xe3, 0x90, 0x0f, 0x00, 0xab,
-	0xe8, 0xff, 0x03, 0xc1, 0xa8, 0x34, 0x25, 0x33, 0x04, 0x78, 0xfd, 0x1f, 0x9c, 0x61, 0x33, 0xc5,
-	0x17, 0x37, 0x53, 0x14, 0x7c, 0xed, 0xe2, 0xb4, 0xc9, 0x85, 0x71, 0xda, 0x17, 0x00, 0x11, 0x93,
-	0xe8, 0x3d, 0xed, 0xcc, 0x24, 0xc6, 0xe0, 0x54, 0xe3, 0x6e, 0xc8, 0x43, 0x87, 0xcc, 0xde, 0x3c,
-	0x64, 0x2f, 0x0e, 0x99, 0x47, 0xfe, 0x5c, 0x82, 0xac, 0x57, 0x93, 0x2f, 0xfa, 0xfb, 0xf3, 0x32,
-	0xa4, 0x45, 0xd9, 0xc9, 0xff, 0x7f, 0x8a, 0xd1, 0x54, 0x40, 0xba, 0x02, 0xd9, 0x3e, 0x26, 0x3a,
-	0x8b, 0x83, 0x3c, 0xab, 0x79, 0xe3, 0x9b, 0xaf, 0x41, 0x3e, 0xf0, 0xeb, 0x98, 0x86, 0xc6, 0xfd,
-	0xfa, 0xdb, 0x72, 0xac, 0x92, 0xf9, 0xe4, 0xf3, 0x8d, 0xc4, 0x3e, 0xfe, 0x90, 0x9e, 0x66, 0xb5,
-	0x5e, 0x6b, 0xd4, 0x6b, 0xf7, 0x65, 0xa9, 0x92, 0xff, 0xe4, 0xf3, 0x8d, 0x8c, 0x8a, 0x19, 0xdc,
-	0x78, 0xf3, 0x3e, 0x2c, 0x8d, 0x6d, 0x4c, 0xb8, 0x6c, 0x41, 0x50, 0xba, 0x7b, 0x7c, 0xf8, 0x60,
-	0xb7, 0x56, 0x6d, 0xd6, 0xb5, 0x87, 0x07, 0xcd, 0xba, 0x2c, 0xa1, 0x2b, 0xb0, 0xf2, 0x60, 0xf7,
-	0x87, 0x8d, 0xa6, 0x56, 0x7b, 0xb0, 0x5b, 0xdf, 0x6f, 0x6a, 0xd5, 0x66, 0xb3, 0x5a, 0xbb, 0x2f,
-	0xc7, 0xb7, 0x3f, 0xcf, 0x43, 0xb2, 0xba, 0x53, 0xdb, 0x45, 0x35, 0x48, 0x32, 0x9c, 0xe4, 0xc2,
-	0xbb, 0x63, 0x95, 0x8b, 0x81, 0x63, 0x74, 0x0f, 0x52, 0x0c, 0x42, 0x41, 0x17, 0x5f, 0x26, 0xab,
-	0xcc, 0x40, 0x92, 0xe9, 0xc7, 0xb0, 0x13, 0x79, 0xe1, 0xed, 0xb2, 0xca, 0xc5, 0xc0, 0x32, 0x7a,
-	0x00, 0x19, 0xb7, 0x83, 0x9e, 0x75, 0xe5, 0xab, 0x32, 0x13, 0xed, 0xa5, 0x4b, 0xe3, 0x48, 0xc4,
-	0xc5, 0x17, 0xcf, 0x2a, 0x33, 0x20, 0x67, 0xb4, 0x0b, 0x69, 0xd1, 0xab, 0xce, 0xb8, 0x4b, 0x56,
-	0x99, 0x05, 0x22, 0x23, 0x15, 0x72, 0x3e, 0xc6, 0x33, 0xfb, 0x3a, 0x5d, 0x65, 0x0e, 0x34, 0x1d,
-	0xbd, 0x0b, 0xc5, 0x70, 0x1f, 0x3c, 0xdf, 0x7d, 0xb5, 0xca, 0x9c, 0x70, 0x35, 0xd5, 0x1f, 0x6e,
-	0x8a, 0xe7, 0xbb, 0xbf, 0x56, 0x99, 0x13, 0xbd, 0x46, 0xef, 0xc3, 0xf2, 0x64, 0xd3, 0x3a, 0xff,
-	0x75, 0xb6, 0xca, 0x02, 0x78, 0x36, 0xea, 0x03, 0x9a, 0xd2, 0xec, 0x2e, 0x70, 0xbb, 0xad, 0xb2,
-	0x08, 0xbc, 0x8d, 0xda, 0xb0, 0x34, 0xde, 0x41, 0xce, 0x7b, 0xdb, 0xad, 0x32, 0x37, 0xd4, 0xcd,
-	0x67, 0x09, 0x77, 0x9e, 0xf3, 0xde, 0x7e, 0xab, 0xcc, 0x8d, 0x7c, 0xa3, 0x63, 0x80, 0x40, 0x7f,
-	0x38, 0xc7, 0x6d, 0xb8, 0xca, 0x3c, 0x18, 0x38, 0xb2, 0x60, 0x65, 0x5a, 0xe3, 0xb8, 0xc8, 0xe5,
-	0xb8, 0xca, 0x42, 0xd0, 0x38, 0xf5, 0xe7, 0x70, 0x0b, 0x38, 0xdf, 0x65, 0xb9, 0xca, 0x9c, 0x18,
-	0xf9, 0x4e, 0xf5, 0x8b, 0xaf, 0xd7, 0xa4, 0x2f, 0xbf, 0x5e, 0x93, 0xfe, 0xfe, 0xf5, 0x9a, 0xf4,
-	0xe9, 0x37, 0x6b, 0xb1, 0x2f, 0xbf, 0x59, 0x8b, 0xfd, 0xf5, 0x9b, 0xb5, 0xd8, 0x8f, 0x9e, 0x3d,
-	0x35, 0x48, 0x77, 0x78, 0xb2, 0xd9, 0x32, 0xfb, 0x5b, 0x2d, 0xb3, 0x8f, 0xc9, 0x49, 0x87, 0xf8,
-	0x0f, 0xfe, 0x9d, 0xe7, 0x93, 0x34, 0xcb, 0xa0, 0xb7, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0xf2, 0x5c, 0xa4, 0x13, 0x2d, 0x00, 0x00,
+	// 3195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x3d, 0x70, 0xe3, 0xc6,
+	0x15, 0x26, 0xf8, 0xcf, 0xc7, 0x3f, 0x68, 0xa5, 0x3b, 0xf3, 0xe8, 0xb3, 0x24, 0xc3, 0x63, 0xfb,
+	0x7c, 0xb6, 0x25, 0x47, 0x17, 0xff, 0xcd, 0xd9, 0x99, 0xa1, 0x78, 0xbc, 0x50, 0xba, 0xb3, 0x24,
+	0x43, 0xbc, 0xf3, 0x38, 0x3f, 0x86, 0x21, 0x72, 0x29, 0xc2, 0x47, 0x12, 0x30, 0xb0, 0x94, 0x29,
+	0x57, 0x99, 0x78, 0xd2, 0xb8, 0xf2, 0x4c, 0x52, 0xa4, 0x71, 0x9a, 0x4c, 0x9a, 0x14, 0xa9, 0x33,
+	0x93, 0x99, 0x24, 0x45, 0x0a, 0x17, 0x29, 0x5c, 0xa6, 0x72, 0x32, 0x76, 0xe7, 0x36, 0x45, 0xda,
+	0xcc, 0xfe, 0x00, 0x04, 0x48, 0x40, 0x24, 0xcf, 0x4e, 0x93, 0xa4, 0xdb, 0x7d, 0x78, 0xef, 0x2d,
+	0x76, 0xf7, 0xed, 0xfb, 0xf9, 0x76, 0xe1, 0x51, 0x82, 0x87, 0x1d, 0x6c, 0x0f, 0x8c, 0x21, 0xd9,
+	0xd6, 0x4f, 0xda, 0xc6, 0x36, 0x39, 0xb7, 0xb0, 0xb3, 0x65, 0xd9, 0x26, 0x31, 0x51, 0x79, 0xf2,
+	0x71, 0x8b, 0x7e, 0xac, 0x3e, 0xe6, 0xe3, 0x6e, 0xdb, 0xe7, 0x16, 0x31, 0xb7, 0x2d, 0xdb, 0x34,
+	0xbb, 0x9c, 0xbf, 0x7a, 0x75, 0xf6, 0xf3, 0x03, 0x7c, 0x2e, 0xb4, 0x05, 0x84, 0xd9, 0x28, 0xdb,
+	0x96, 0x6e, 0xeb, 0x03, 0xf7, 0xf3, 0xe6, 0xcc, 0xe7, 0x33, 0xbd, 0x6f, 0x74, 0x74, 0x62, 0xda,
+	0x82, 0x63, 0xe3, 0xd4, 0x34, 0x4f, 0xfb, 0x78, 0x9b, 0xf5, 0x4e, 0x46, 0xdd, 0x6d, 0x62, 0x0c,
+	0xb0, 0x43, 0xf4, 0x81, 0x25, 0x18, 0xd6, 0x4e, 0xcd, 0x53, 0x93, 0x35, 0xb7, 0x69, 0x8b, 0x53,
+	0x95, 0x3f, 0xe5, 0x20, 0xa3, 0xe2, 0xf7, 0x47, 0xd8, 0x21, 0x68, 0x07, 0x92, 0xb8, 0xdd, 0x33,
+	0x2b, 0xd2, 0xa6, 0x74, 0x2d, 0xbf, 0x73, 0x75, 0x6b, 0x6a, 0x82, 0x5b, 0x82, 0xaf, 0xd1, 0xee,
+	0x99, 0xcd, 0x98, 0xca, 0x78, 0xd1, 0x8b, 0x90, 0xea, 0xf6, 0x47, 0x4e, 0xaf, 0x12, 0x67, 0x42,
+	0x8f, 0x45, 0x09, 0xdd, 0xa6, 0x4c, 0xcd, 0x98, 0xca, 0xb9, 0xe9, 0x50, 0xc6, 0xb0, 0x6b, 0x56,
+	0x12, 0x17, 0x0f, 0xb5, 0x37, 0xec, 0xb2, 0xa1, 0x28, 0x2f, 0xda, 0x05, 0x30, 0x86, 0x06, 0xd1,
+	0xda, 0x3d, 0xdd, 0x18, 0x56, 0x52, 0x4c, 0xf2, 0xf1, 0x68, 0x49, 0x83, 0xd4, 0x29, 0x63, 0x33,
+	0xa6, 0xe6, 0x0c, 0xb7, 0x43, 0x7f, 0xf7, 0xfd, 0x11, 0xb6, 0xcf, 0x2b, 0xe9, 0x8b, 0x7f, 0xf7,
+	0x4d, 0xca, 0x44, 0x7f, 0x97, 0x71, 0xa3, 0xd7, 0x20, 0xdb, 0xee, 0xe1, 0xf6, 0x03, 0x8d, 0x8c,
+	0x2b, 0x59, 0x26, 0xb9, 0x11, 0x25, 0x59, 0xa7, 0x7c, 0xad, 0x71, 0x33, 0xa6, 0x66, 0xda, 0xbc,
+	0x89, 0x5e, 0x81, 0x74, 0xdb, 0x1c, 0x0c, 0x0c, 0x52, 0xc9, 0x33, 0xd9, 0xf5, 0x48, 0x59, 0xc6,
+	0xd5, 0x8c, 0xa9, 0x82, 0x1f, 0x1d, 0x40, 0xa9, 0x6f, 0x38, 0x44, 0x73, 0x86, 0xba, 0xe5, 0xf4,
+	0x4c, 0xe2, 0x54, 0x0a, 0x4c, 0xc3, 0x93, 0x51, 0x1a, 0xee, 0x1a, 0x0e, 0x39, 0x76, 0x99, 0x9b,
+	0x31, 0xb5, 0xd8, 0xf7, 0x13, 0xa8, 0x3e, 0xb3, 0xdb, 0xc5, 0xb6, 0xa7, 0xb0, 0x52, 0xbc, 0x58,
+	0xdf, 0x21, 0xe5, 0x76, 0xe5, 0xa9, 0x3e, 0xd3, 0x4f, 0x40, 0x3f, 0x84, 0xd5, 0xbe, 0xa9, 0x77,
+	0x3c, 0x75, 0x5a, 0xbb, 0x37, 0x1a, 0x3e, 0xa8, 0x94, 0x98, 0xd2, 0x67, 0x22, 0x7f, 0xd2, 0xd4,
+	0x3b, 0xae, 0x8a, 0x3a, 0x15, 0x68, 0xc6, 0xd4, 0x95, 0xfe, 0x34, 0x11, 0xbd, 0x03, 0x6b, 0xba,
+	0x65, 0xf5, 0xcf, 0xa7, 0xb5, 0x97, 0x99, 0xf6, 0xeb, 0x51, 0xda, 0x6b, 0x54, 0x66, 0x5a, 0x3d,
+	0xd2, 0x67, 0xa8, 0xa8, 0x05, 0xb2, 0x65, 0x63, 0x4b, 0xb7, 0xb1, 0x66, 0xd9, 0xa6, 0x65, 0x3a,
+	0x7a, 0xbf, 0x22, 0x33, 0xdd, 0x4f, 0x47, 0xe9, 0x3e, 0xe2, 0xfc, 0x47, 0x82, 0xbd, 0x19, 0x53,
+	0xcb, 0x56, 0x90, 0xc4, 0xb5, 0x9a, 0x6d, 0xec, 0x38, 0x13, 0xad, 0x2b, 0xf3, 0xb4, 0x32, 0xfe,
+	0xa0, 0xd6, 0x00, 0x09, 0x35, 0x20, 0x8f, 0xc7, 0x54, 0x5c, 0x3b, 0x33, 0x09, 0xae, 0x20, 0xa6,
+	0x50, 0x89, 0x3c, 0xa1, 0x8c, 0xf5, 0xbe, 0x49, 0x70, 0x33, 0xa6, 0x02, 0xf6, 0x7a, 0x48, 0x87,
+	0x4b, 0x67, 0xd8, 0x36, 0xba, 0xe7, 0x4c, 0x8d, 0xc6, 0xbe, 0x38, 0x86, 0x39, 0xac, 0xac, 0x32,
+	0x85, 0xcf, 0x46, 0x29, 0xbc, 0xcf, 0x84, 0xa8, 0x8a, 0x86, 0x2b, 0xd2, 0x8c, 0xa9, 0xab, 0x67,
+	0xb3, 0x64, 0x6a, 0x62, 0x5d, 0x63, 0xa8, 0xf7, 0x8d, 0x0f, 0xb1, 0x76, 0xd2, 0x37, 0xdb, 0x0f,
+	0x2a, 0x6b, 0x17, 0x9b, 0xd8, 0x6d, 0xc1, 0xbd, 0x4b, 0x99, 0xa9, 0x89, 0x75, 0xfd, 0x84, 0xdd,
+	0x0c, 0xa4, 0xce, 0xf4, 0xfe, 0x08, 0xef, 0x27, 0xb3, 0x49, 0x39, 0xb5, 0x9f, 0xcc, 0x66, 0xe4,
+	0xec, 0x7e, 0x32, 0x9b, 0x93, 0x61, 0x3f, 0x99, 0x05, 0x39, 0xaf, 0x3c, 0x0d, 0x79, 0x9f, 0x63,
+	0x42, 0x15, 0xc8, 0x0c, 0xb0, 0xe3, 0xe8, 0xa7, 0x98, 0xf9, 0xb1, 0x9c, 0xea, 0x76, 0x95, 0x12,
+	0x14, 0xfc, 0xce, 0x48, 0xf9, 0x44, 0x82, 0xbc, 0xcf, 0xcf, 0x50, 0xc9, 0x33, 0x6c, 0xb3, 0xe5,
+	0x10, 0x92, 0xa2, 0x8b, 0x9e, 0x80, 0x22, 0x9b, 0x8a, 0xe6, 0x7e, 0xa7, 0xce, 0x2e, 0xa9, 0x16,
+	0x18, 0xf1, 0xbe, 0x60, 0xda, 0x80, 0xbc, 0xb5, 0x63, 0x79, 0x2c, 0x09, 0xc6, 0x02, 0xd6, 0x8e,
+	0xe5, 0x32, 0x3c, 0x0e, 0x05, 0x3a, 0x6f, 0x8f, 0x23, 0xc9, 0x06, 0xc9, 0x53, 0x9a, 0x60, 0x51,
+	0xfe, 0x1a, 0x07, 0x79, 0xda, 0x81, 0xa1, 0x57, 0x20, 0x49, 0x7d, 0xb9, 0x70, 0xcb, 0xd5, 0x2d,
+	0xee, 0xe8, 0xb7, 0x5c, 0x47, 0xbf, 0xd5, 0x72, 0x1d, 0xfd, 0x6e, 0xf6, 0xb3, 0x2f, 0x36, 0x62,
+	0x9f, 0xfc, 0x7d, 0x43, 0x52, 0x99, 0x04, 0xba, 0x42, 0xdd, 0x96, 0x6e, 0x0c, 0x35, 0xa3, 0xc3,
+	0x7e, 0x39, 0x47, 0x7d, 0x92, 0x6e, 0x0c, 0xf7, 0x3a, 0xe8, 0x2e, 0xc8, 0x6d, 0x73, 0xe8, 0xe0,
+	0xa1, 0x33, 0x72, 0x34, 0x1e, 0x6a, 0x2a, 0x89, 0x59, 0x97, 0xca, 0x03, 0x5e, 0xdd, 0xe5, 0x3c,
+	0x62, 0x8c, 0x6a, 0xb9, 0x1d, 0x24, 0xa0, 0xdb, 0x00, 0x5e, 0x3c, 0x72, 0x2a, 0xc9, 0xcd, 0xc4,
+	0xb5, 0xfc, 0xce, 0xe6, 0xcc, 0x86, 0xdf, 0x77, 0x59, 0xee, 0x59, 0x1d, 0x9d, 0xe0, 0xdd, 0x24,
+	0xfd, 0x5d, 0xd5, 0x27, 0x89, 0x9e, 0x82, 0xb2, 0x6e, 0x59, 0x9a, 0x43, 0x74, 0x82, 0xb5, 0x93,
+	0x73, 0x82, 0x1d, 0xe6, 0xe7, 0x0b, 0x6a, 0x51, 0xb7, 0xac, 0x63, 0x4a, 0xdd, 0xa5, 0x44, 0xf4,
+	0x24, 0x94, 0xa8, 0x4f, 0x37, 0xf4, 0xbe, 0xd6, 0xc3, 0xc6, 0x69, 0x8f, 0x30, 0x7f, 0x9e, 0x50,
+	0x8b, 0x82, 0xda, 0x64, 0x44, 0xa5, 0x03, 0x05, 0xbf, 0x3f, 0x47, 0x08, 0x92, 0x1d, 0x9d, 0xe8,
+	0x6c, 0x25, 0x0b, 0x2a, 0x6b, 0x53, 0x9a, 0xa5, 0x93, 0x9e, 0x58, 0x1f, 0xd6, 0x46, 0x97, 0x21,
+	0x2d, 0xd4, 0x26, 0x98, 0x5a, 0xd1, 0x43, 0x6b, 0x90, 0xb2, 0x6c, 0xf3, 0x0c, 0xb3, 0xad, 0xcb,
+	0xaa, 0xbc, 0xa3, 0xa8, 0x50, 0x0a, 0xfa, 0x7e, 0x54, 0x82, 0x38, 0x19, 0x8b, 0x51, 0xe2, 0x64,
+	0x8c, 0x5e, 0x80, 0x24, 0x5d, 0x48, 0x36, 0x46, 0x29, 0x24, 0xda, 0x09, 0xb9, 0xd6, 0xb9, 0x85,
+	0x55, 0xc6, 0xa9, 0x94, 0xa1, 0x18, 0x88, 0x09, 0xca, 0x65, 0x58, 0x0b, 0x73, 0xf1, 0x4a, 0x0f,
+	0xd6, 0xc2, 0x5c, 0x35, 0x7a, 0x11, 0xb2, 0x9e, 0x8f, 0xe7, 0x86, 0x73, 0x65, 0x66, 0x58, 0x97,
+	0x59, 0xf5, 0x58, 0xa9, 0xc5, 0xd0, 0x0d, 0xe8, 0xe9, 0x22, 0xa2, 0x17, 0xd4, 0x8c, 0x6e, 0x59,
+	0x4d, 0xdd, 0xe9, 0x29, 0xef, 0x42, 0x25, 0xca, 0x7f, 0xfb, 0x16, 0x4c, 0x62, 0x66, 0x2f, 0x7a,
+	0x94, 0xde, 0x35, 0xed, 0x81, 0x4e, 0x98, 0xb2, 0xa2, 0x2a, 0x7a, 0x74, 0x21, 0xb9, 0x2f, 0x4f,
+	0x30, 0x32, 0xef, 0x28, 0x1a, 0x5c, 0x89, 0xf4, 0xe1, 0x54, 0xc4, 0x18, 0x76, 0x30, 0x5f, 0xd6,
+	0xa2, 0xca, 0x3b, 0x13, 0x45, 0xfc, 0x67, 0x79, 0x87, 0x0e, 0xeb, 0xb0, 0xb9, 0x32, 0xfd, 0x39,
+	0x55, 0xf4, 0x94, 0xdf, 0x25, 0xe0, 0x72, 0xb8, 0x27, 0x47, 0x9b, 0x50, 0x18, 0xe8, 0x63, 0x8d,
+	0x8c, 0x85, 0xd9, 0x49, 0x6c, 0xe3, 0x61, 0xa0, 0x8f, 0x5b, 0x63, 0x6e, 0x73, 0x32, 0x24, 0xc8,
+	0xd8, 0xa9, 0xc4, 0x37, 0x13, 0xd7, 0x0a, 0x2a, 0x6d, 0xa2, 0x7b, 0xb0, 0xd2, 0x37, 0xdb, 0x7a,
+	0x5f, 0xeb, 0xeb, 0x0e, 0xd1, 0x44, 0x88, 0xe7, 0x87, 0xe8, 0x89, 0x99, 0xc5, 0xe6, 0x3e, 0x19,
+	0x77, 0xf8, 0x7e, 0x52, 0x87, 0x23, 0xec, 0xbf, 0xcc, 0x74, 0xdc, 0xd5, 0xdd, 0xad, 0x46, 0xb7,
+	0x20, 0x3f, 0x30, 0x9c, 0x13, 0xdc, 0xd3, 0xcf, 0x0c, 0xd3, 0x16, 0xa7, 0x69, 0xd6, 0x68, 0xde,
+	0x98, 0xf0, 0x08, 0x4d, 0x7e, 0x31, 0xdf, 0x96, 0xa4, 0x02, 0x36, 0xec, 0x7a, 0x93, 0xf4, 0xd2,
+	0xde, 0xe4, 0x05, 0x58, 0x1b, 0xe2, 0x31, 0xd1, 0x26, 0xe7, 0x95, 0xdb, 0x49, 0x86, 0x2d, 0x3d,
+	0xa2, 0xdf, 0xbc, 0x13, 0xee, 0x50, 0x93, 0x41, 0xcf, 0xb0, 0x58, 0x68, 0x99, 0x0e, 0xb6, 0x35,
+	0xbd, 0xd3, 0xb1, 0xb1, 0xe3, 0xb0, 0xf4, 0xa9, 0xa0, 0x96, 0x5d, 0x7a, 0x8d, 0x93, 0xe9, 0x46,
+	0xda, 0xe6, 0x68, 0xd8, 0xa9, 0xe4, 0x36, 0xa5, 0x6b, 0x29, 0x95, 0x77, 0x94, 0x5f, 0xf9, 0x37,
+	0x2c, 0x18, 0x11, 0xc5, 0x76, 0x48, 0x93, 0xed, 0x38, 0x86, 0x35, 0xa1, 0xb5, 0x13, 0xd8, 0x11,
+	0x9e, 0x99, 0x3e, 0x3a, 0x7b, 0xea, 0xa6, 0x77, 0x02, 0xb9, 0xe2, 0xd1, 0x9b, 0x91, 0x78, 0xb8,
+	0xcd, 0x40, 0x90, 0x64, 0x4b, 0x95, 0xe4, 0x8e, 0x87, 0xb6, 0xff, 0x3b, 0x36, 0xe8, 0xa3, 0x04,
+	0xac, 0xcc, 0x24, 0x1d, 0xde, 0x74, 0xa5, 0xd0, 0xe9, 0xc6, 0x43, 0xa7, 0x9b, 0x58, 0x7a, 0xba,
+	0xc2, 0x02, 0x92, 0xf3, 0x2d, 0x20, 0xf5, 0x2d, 0x5a, 0x40, 0xfa, 0xe1, 0x2c, 0xe0, 0x3f, 0xb9,
+	0x37, 0xca, 0x1f, 0x24, 0xa8, 0x46, 0x67, 0x6a, 0xa1, 0xdb, 0xf1, 0x2c, 0xac, 0x78, 0xbf, 0xe2,
+	0xa9, 0xe7, 0x4e, 0x54, 0xf6, 0x3e, 0xb8, 0x7b, 0x1f, 0x15, 0x0f, 0x9f, 0x84, 0xd2, 0x54, 0x1e,
+	0xc9, 0x0d, 0xbc, 0x78, 0x16, 0x18, 0xff, 0x31, 0x00, 0xc6, 0xd6, 0x36, 0x3b, 0x2c, 0xa0, 0x27,
+	0xae, 0x25, 0xd4, 0x1c, 0xa5, 0xd4, 0x29, 0x41, 0xf9, 0x75, 0x02, 0xd6, 0xc2, 0x72, 0xc1, 0x90,
+	0x23, 0xfe, 0x26, 0xac, 0x76, 0x70, 0xdb, 0xe8, 0x3c, 0xec, 0x09, 0x5f, 0x11, 0xd2, 0xff, 0x3f,
+	0xe0, 0xb3, 0x07, 0x3c, 0xb8, 0x4b, 0xb9, 0xe9, 0x5d, 0xfa, 0x05, 0x40, 0x56, 0xc5, 0x8e, 0x65,
+	0x0e, 0x1d, 0x8c, 0x76, 0x21, 0x87, 0xc7, 0x6d, 0x6c, 0x11, 0x37, 0x59, 0x0e, 0x2f, 0x46, 0x38,
+	0x77, 0xc3, 0xe5, 0xa4, 0xa5, 0xb8, 0x27, 0x86, 0x6e, 0x08, 0xb4, 0x21, 0x1a, 0x38, 0x10, 0xe2,
+	0x7e, 0xb8, 0xe1, 0x25, 0x17, 0x6e, 0x48, 0x44, 0x56, 0xd2, 0x5c, 0x6a, 0x0a, 0x6f, 0xb8, 0x21,
+	0xf0, 0x86, 0xe4, 0x9c, 0xc1, 0x02, 0x80, 0x43, 0x3d, 0x00, 0x38, 0xa4, 0xe7, 0x4c, 0x33, 0x02,
+	0x71, 0x78, 0xc9, 0x45, 0x1c, 0x32, 0x73, 0xfe, 0x78, 0x0a, 0x72, 0x78, 0xdd, 0x07, 0x39, 0xe4,
+	0x98, 0xe8, 0x66, 0xa4, 0x68, 0x08, 0xe6, 0xf0, 0xaa, 0x87, 0x39, 0x14, 0x22, 0xf1, 0x0a, 0x21,
+	0x3c, 0x0d, 0x3a, 0x1c, 0xce, 0x80, 0x0e, 0x1c, 0x24, 0x78, 0x2a, 0x52, 0xc5, 0x1c, 0xd4, 0xe1,
+	0x70, 0x06, 0x75, 0x28, 0xcd, 0x51, 0x38, 0x07, 0x76, 0xf8, 0x51, 0x38, 0xec, 0x10, 0x0d, 0x0c,
+	0x88, 0xdf, 0x5c, 0x0c, 0x77, 0xd0, 0x22, 0x70, 0x07, 0x39, 0xb2, 0x46, 0xe6, 0xea, 0x17, 0x06,
+	0x1e, 0xee, 0x85, 0x00, 0x0f, 0x1c, 0x22, 0xb8, 0x16, 0xa9, 0x7c, 0x01, 0xe4, 0xe1, 0x5e, 0x08,
+	0xf2, 0x80, 0xe6, 0xaa, 0x9d, 0x0b, 0x3d, 0xdc, 0x0e, 0x42, 0x0f, 0xab, 0x11, 0xf9, 0xed, 0xe4,
+	0xb4, 0x47, 0x60, 0x0f, 0x27, 0x51, 0xd8, 0x03, 0xc7, 0x07, 0x9e, 0x8b, 0xd4, 0xb8, 0x04, 0xf8,
+	0x70, 0x38, 0x03, 0x3e, 0x5c, 0x9a, 0x63, 0x69, 0x8b, 0xa3, 0x0f, 0x29, 0x39, 0xbd, 0x9f, 0xcc,
+	0x66, 0xe5, 0x1c, 0xc7, 0x1d, 0xf6, 0x93, 0xd9, 0xbc, 0x5c, 0x50, 0x9e, 0x81, 0x15, 0x57, 0x95,
+	0xe7, 0xe7, 0x68, 0xae, 0x84, 0x6d, 0xdb, 0xb4, 0x05, 0x8e, 0xc0, 0x3b, 0xca, 0x35, 0x28, 0x78,
+	0xac, 0x17, 0x23, 0x15, 0xac, 0xfa, 0xf3, 0xf9, 0x31, 0xe5, 0xf7, 0x12, 0x14, 0xfc, 0x2e, 0x2a,
+	0x50, 0xc9, 0xe6, 0x44, 0x25, 0xeb, 0xc3, 0x2f, 0xe2, 0x41, 0xfc, 0x62, 0x03, 0xf2, 0xb4, 0xaa,
+	0x9b, 0x82, 0x26, 0x74, 0xcb, 0x83, 0x26, 0xae, 0xc3, 0x0a, 0x8b, 0xa7, 0x1c, 0xe5, 0x10, 0x51,
+	0x2b, 0xc9, 0xa2, 0x56, 0x99, 0x7e, 0xe0, 0xab, 0xc3, 0xc8, 0xe8, 0x79, 0x58, 0xf5, 0xf1, 0x7a,
+	0xd5, 0x22, 0xaf, 0xd3, 0x65, 0x8f, 0xbb, 0x26, 0xca, 0xc6, 0xbf, 0x48, 0xb0, 0x32, 0xe3, 0x22,
+	0x43, 0xe1, 0x07, 0xe9, 0x5b, 0x82, 0x1f, 0xe2, 0x0f, 0x0d, 0x3f, 0xf8, 0xab, 0xdf, 0x44, 0xb0,
+	0xfa, 0xfd, 0x97, 0x04, 0xc5, 0x80, 0xa7, 0xa6, 0x5b, 0x40, 0x43, 0xa5, 0xa8, 0x47, 0x59, 0x9b,
+	0x66, 0x2c, 0x7d, 0xf3, 0x54, 0x54, 0x9d, 0xb4, 0x49, 0xb9, 0xbc, 0xc0, 0x93, 0x13, 0x71, 0xc5,
+	0x2b, 0x65, 0x79, 0x5e, 0xc0, 0x3b, 0x54, 0xf6, 0x01, 0xe6, 0xc0, 0x74, 0x41, 0xa5, 0x4d, 0xb4,
+	0x26, 0x8c, 0x4f, 0xc4, 0x77, 0xde, 0x41, 0xaf, 0x40, 0x8e, 0x5d, 0x2b, 0x68, 0xa6, 0xe5, 0x54,
+	0xb2, 0xb3, 0x99, 0x0f, 0xbf, 0x5b, 0xd8, 0x3a, 0xa2, 0x3c, 0x87, 0x96, 0xa3, 0x66, 0x2d, 0xd1,
+	0xf2, 0x25, 0x24, 0xb9, 0x40, 0x42, 0x72, 0x15, 0x72, 0x2c, 0xe8, 0x5b, 0x7a, 0x1b, 0x57, 0x80,
+	0xfd, 0xe8, 0x84, 0xa0, 0xfc, 0x36, 0x0e, 0xe5, 0xa9, 0x40, 0x13, 0x3a, 0x77, 0xd7, 0x24, 0xe3,
+	0x3e, 0x70, 0x65, 0xb1, 0xf5, 0x58, 0x07, 0x38, 0xd5, 0x1d, 0xed, 0x03, 0x7d, 0x48, 0x70, 0x47,
+	0x2c, 0x8a, 0x8f, 0x82, 0xaa, 0x90, 0xa5, 0xbd, 0x91, 0x83, 0x3b, 0x02, 0xe7, 0xf1, 0xfa, 0xa8,
+	0x09, 0x69, 0x7c, 0x86, 0x87, 0xc4, 0xa9, 0x64, 0xd8, 0xb6, 0x5f, 0x9e, 0x2d, 0xbc, 0xe9, 0xe7,
+	0xdd, 0x0a, 0xdd, 0xec, 0xaf, 0xbf, 0xd8, 0x90, 0x39, 0xf7, 0x73, 0xe6, 0xc0, 0x20, 0x78, 0x60,
+	0x91, 0x73, 0x55, 0xc8, 0x07, 0x57, 0x21, 0x3b, 0xb5, 0x0a, 0x0c, 0x71, 0x2c, 0xb8, 0x40, 0x02,
+	0x5d, 0x53, 0xc3, 0xb4, 0x0d, 0x72, 0xae, 0x16, 0x07, 0x78, 0x60, 0x99, 0x66, 0x5f, 0xe3, 0x67,
+	0xbc, 0x06, 0x25, 0x6f, 0xad, 0x78, 0x34, 0x7d, 0x02, 0x8a, 0x36, 0x26, 0x14, 0x84, 0x0b, 0xa4,
+	0xd0, 0x05, 0x4e, 0xe4, 0x67, 0x6a, 0x3f, 0x99, 0x95, 0xe4, 0xf8, 0x7e, 0x32, 0x1b, 0x97, 0x13,
+	0xca, 0x11, 0x5c, 0x0a, 0x8d, 0xab, 0xe8, 0x65, 0xc8, 0x4d, 0x42, 0xb2, 0xb4, 0x99, 0xb8, 0x18,
+	0xd3, 0x99, 0xf0, 0x2a, 0x7f, 0x94, 0xe0, 0x52, 0x68, 0x64, 0x45, 0x0d, 0x48, 0xdb, 0xd8, 0x19,
+	0xf5, 0x39, 0x6e, 0x53, 0xda, 0x79, 0x7e, 0xb1, 0x88, 0x4c, 0xa9, 0xa3, 0x3e, 0x51, 0x85, 0xb0,
+	0xf2, 0x0e, 0xa4, 0x39, 0x05, 0xe5, 0x21, 0x73, 0xef, 0xe0, 0xce, 0xc1, 0xe1, 0x5b, 0x07, 0x72,
+	0x0c, 0x01, 0xa4, 0x6b, 0xf5, 0x7a, 0xe3, 0xa8, 0x25, 0x4b, 0x28, 0x07, 0xa9, 0xda, 0xee, 0xa1,
+	0xda, 0x92, 0xe3, 0x94, 0xac, 0x36, 0xf6, 0x1b, 0xf5, 0x96, 0x9c, 0x40, 0x2b, 0x50, 0xe4, 0x6d,
+	0xed, 0xf6, 0xa1, 0xfa, 0x46, 0xad, 0x25, 0x27, 0x7d, 0xa4, 0xe3, 0xc6, 0xc1, 0xad, 0x86, 0x2a,
+	0xa7, 0x94, 0xef, 0xc0, 0x15, 0xf7, 0x3f, 0x66, 0xb1, 0x27, 0x0f, 0x02, 0x92, 0x7c, 0x10, 0x90,
+	0xf2, 0xcb, 0x38, 0x54, 0x5d, 0x99, 0x10, 0x34, 0x69, 0x7f, 0x6a, 0xe2, 0x3b, 0x4b, 0x44, 0xf5,
+	0xa9, 0xd9, 0xd3, 0x2a, 0xc8, 0xc6, 0x5d, 0x4c, 0xda, 0x3d, 0x9e, 0x28, 0x70, 0x0f, 0x54, 0x54,
+	0x8b, 0x82, 0xca, 0x84, 0x1c, 0xce, 0xf6, 0x1e, 0x6e, 0x13, 0x8d, 0x1b, 0x91, 0xc3, 0x6a, 0x8d,
+	0x9c, 0x5a, 0xe4, 0xd4, 0x63, 0x4e, 0x54, 0xde, 0x5d, 0x6a, 0x2d, 0x73, 0x90, 0x52, 0x1b, 0x2d,
+	0xf5, 0x6d, 0x39, 0x81, 0x10, 0x94, 0x58, 0x53, 0x3b, 0x3e, 0xa8, 0x1d, 0x1d, 0x37, 0x0f, 0xe9,
+	0x5a, 0xae, 0x42, 0xd9, 0x5d, 0x4b, 0x97, 0x98, 0x52, 0x9e, 0x85, 0x47, 0x22, 0xb2, 0x8a, 0xd9,
+	0x8a, 0x4b, 0xf9, 0xb3, 0xe4, 0xe7, 0x0e, 0x66, 0x06, 0x87, 0x90, 0x76, 0x88, 0x4e, 0x46, 0x8e,
+	0x58, 0xc4, 0x97, 0x17, 0x4d, 0x33, 0xb6, 0xdc, 0xc6, 0x31, 0x13, 0x57, 0x85, 0x9a, 0xa9, 0x12,
+	0x24, 0x3e, 0x5d, 0x82, 0xbc, 0x08, 0xa5, 0xa0, 0x60, 0xf4, 0x12, 0x4d, 0x6c, 0x2c, 0xae, 0xdc,
+	0x04, 0x34, 0x9b, 0x9c, 0x84, 0xd4, 0xae, 0x52, 0x48, 0xed, 0xaa, 0xfc, 0x46, 0x82, 0x47, 0x2f,
+	0x48, 0x44, 0xd0, 0x9b, 0x53, 0x6b, 0xf0, 0xea, 0x32, 0x69, 0xcc, 0x16, 0xa7, 0x05, 0x57, 0x41,
+	0xb9, 0x01, 0x05, 0x3f, 0x7d, 0xb1, 0x49, 0x7e, 0x1d, 0x87, 0x4b, 0xa1, 0x39, 0x8d, 0xcf, 0x43,
+	0x4a, 0xdf, 0xd0, 0x43, 0xbe, 0x06, 0x40, 0xc6, 0x1a, 0xb7, 0x7a, 0x37, 0xcc, 0xce, 0x96, 0x52,
+	0x8d, 0x31, 0x6e, 0xb7, 0xc6, 0xe2, 0x8c, 0xe4, 0x88, 0x68, 0x51, 0x70, 0xc6, 0x87, 0x38, 0x8c,
+	0x58, 0x08, 0x76, 0x44, 0xb9, 0xbd, 0x68, 0xac, 0x96, 0xcf, 0x82, 0x64, 0x07, 0xbd, 0x0d, 0x8f,
+	0x4c, 0xe5, 0x11, 0x9e, 0xea, 0xe4, 0xa2, 0xe9, 0xc4, 0xa5, 0x60, 0x3a, 0xe1, 0xaa, 0xf6, 0x27,
+	0x03, 0xa9, 0x60, 0x32, 0xf0, 0x36, 0xc0, 0x04, 0x5a, 0x98, 0x20, 0x63, 0x92, 0x0f, 0x19, 0xa3,
+	0x37, 0xcd, 0xd4, 0x92, 0xdc, 0x75, 0x9a, 0xf5, 0xd4, 0xd4, 0x12, 0x7c, 0xd0, 0x04, 0xe7, 0x56,
+	0x0c, 0x40, 0xb3, 0x48, 0x71, 0xc4, 0x10, 0xaf, 0x07, 0x87, 0x78, 0x3c, 0x12, 0x73, 0x0e, 0x1f,
+	0xea, 0x43, 0x48, 0xb1, 0x9d, 0xa7, 0x31, 0x99, 0x5d, 0x4f, 0x88, 0x64, 0x92, 0xb6, 0xd1, 0x8f,
+	0x01, 0x74, 0x42, 0x6c, 0xe3, 0x64, 0x34, 0x19, 0x60, 0x23, 0xdc, 0x72, 0x6a, 0x2e, 0xdf, 0xee,
+	0x55, 0x61, 0x42, 0x6b, 0x13, 0x51, 0x9f, 0x19, 0xf9, 0x14, 0x2a, 0x07, 0x50, 0x0a, 0xca, 0xba,
+	0xe9, 0x0f, 0xff, 0x87, 0x60, 0xfa, 0xc3, 0xb3, 0x59, 0xde, 0x99, 0x24, 0x4f, 0x09, 0x7e, 0x07,
+	0xc3, 0x3a, 0xca, 0x4f, 0xe2, 0x50, 0xf0, 0x1b, 0xde, 0xff, 0x5e, 0x86, 0xa2, 0xfc, 0x4c, 0x82,
+	0xac, 0x37, 0xfd, 0xe0, 0x85, 0x4c, 0xe0, 0x06, 0x8b, 0xaf, 0x5e, 0xdc, 0x7f, 0x8b, 0xc2, 0xef,
+	0xab, 0x12, 0xde, 0x7d, 0xd5, 0x4d, 0x2f, 0x3a, 0x46, 0xe1, 0x25, 0xfe, 0xb5, 0x16, 0x56, 0xe5,
+	0x26, 0x03, 0x37, 0x21, 0xe7, 0x9d, 0x5e, 0x5a, 0x93, 0xb8, 0xb0, 0x93, 0x24, 0xce, 0xd0, 0x04,
+	0x4f, 0xb6, 0xcc, 0x0f, 0xc4, 0x15, 0x4d, 0x42, 0xe5, 0x1d, 0xa5, 0x03, 0xe5, 0xa9, 0xa3, 0x8f,
+	0x6e, 0x42, 0xc6, 0x1a, 0x9d, 0x68, 0xae, 0x71, 0x4c, 0x81, 0x73, 0x6e, 0xb6, 0x3b, 0x3a, 0xe9,
+	0x1b, 0xed, 0x3b, 0xf8, 0xdc, 0xfd, 0x19, 0x6b, 0x74, 0x72, 0x87, 0xdb, 0x10, 0x1f, 0x25, 0xee,
+	0x1f, 0xe5, 0xe7, 0x12, 0x64, 0xdd, 0x33, 0x81, 0xbe, 0x07, 0x39, 0xcf, 0xad, 0x78, 0x77, 0xac,
+	0x91, 0xfe, 0x48, 0xe8, 0x9f, 0x88, 0xa0, 0x9a, 0x7b, 0x39, 0x6c, 0x74, 0xb4, 0x6e, 0x5f, 0xe7,
+	0xb6, 0x54, 0x0a, 0xae, 0x19, 0x77, 0x3c, 0xcc, 0x1f, 0xef, 0xdd, 0xba, 0xdd, 0xd7, 0x4f, 0xd5,
+	0x3c, 0x93, 0xd9, 0xeb, 0xd0, 0x8e, 0x48, 0xfc, 0xfe, 0x29, 0x81, 0x3c, 0x7d, 0x62, 0xbf, 0xf1,
+	0xdf, 0xcd, 0x86, 0xb9, 0x44, 0x18, 0x44, 0xbb, 0x0d, 0xab, 0x1e, 0x87, 0xe6, 0x18, 0xa7, 0x43,
+	0x9d, 0x8c, 0x6c, 0x2c, 0xe0, 0x4c, 0xe4, 0x7d, 0x3a, 0x76, 0xbf, 0xcc, 0xce, 0x3a, 0xf5, 0x90,
+	0xb3, 0xfe, 0x28, 0x0e, 0x79, 0x1f, 0xb8, 0x8a, 0xbe, 0xeb, 0x73, 0x46, 0xa5, 0x90, 0xc8, 0xe0,
+	0xe3, 0x9d, 0xdc, 0x97, 0x06, 0x97, 0x29, 0xbe, 0xfc, 0x32, 0x45, 0x21, 0xdc, 0x2e, 0x56, 0x9b,
+	0x5c, 0x1a, 0xab, 0x7d, 0x0e, 0x10, 0x31, 0x89, 0xde, 0xa7, 0x68, 0x87, 0x31, 0x3c, 0xd5, 0xb8,
+	0x19, 0x72, 0xd7, 0x21, 0xb3, 0x2f, 0xf7, 0xd9, 0x87, 0x23, 0x66, 0x91, 0x3f, 0x95, 0x20, 0xeb,
+	0x65, 0xe5, 0xcb, 0xde, 0xa6, 0x5e, 0x86, 0xb4, 0x48, 0x3c, 0xf9, 0x75, 0xaa, 0xe8, 0x85, 0x82,
+	0xd2, 0x55, 0xc8, 0x0e, 0x30, 0xd1, 0x99, 0x1f, 0xe4, 0x51, 0xcd, 0xeb, 0x5f, 0x7f, 0x15, 0xf2,
+	0xbe, 0x9b, 0x68, 0xea, 0x1a, 0x0f, 0x1a, 0x6f, 0xc9, 0xb1, 0x6a, 0xe6, 0xe3, 0x4f, 0x37, 0x13,
+	0x07, 0xf8, 0x03, 0x7a, 0x9a, 0xd5, 0x46, 0xbd, 0xd9, 0xa8, 0xdf, 0x91, 0xa5, 0x6a, 0xfe, 0xe3,
+	0x4f, 0x37, 0x33, 0x2a, 0x66, 0x80, 0xe3, 0xf5, 0x3b, 0x50, 0x9e, 0xda, 0x98, 0x60, 0xda, 0x82,
+	0xa0, 0x74, 0xeb, 0xde, 0xd1, 0xdd, 0xbd, 0x7a, 0xad, 0xd5, 0xd0, 0xee, 0x1f, 0xb6, 0x1a, 0xb2,
+	0x84, 0x1e, 0x81, 0xd5, 0xbb, 0x7b, 0xdf, 0x6f, 0xb6, 0xb4, 0xfa, 0xdd, 0xbd, 0xc6, 0x41, 0x4b,
+	0xab, 0xb5, 0x5a, 0xb5, 0xfa, 0x1d, 0x39, 0xbe, 0xf3, 0x69, 0x1e, 0x92, 0xb5, 0xdd, 0xfa, 0x1e,
+	0xaa, 0x43, 0x92, 0x21, 0x25, 0x17, 0x3e, 0x45, 0xab, 0x5e, 0x0c, 0x1d, 0xa3, 0xdb, 0x90, 0x62,
+	0x20, 0x0a, 0xba, 0xf8, 0x6d, 0x5a, 0x75, 0x0e, 0x96, 0x4c, 0x7f, 0x86, 0x9d, 0xc8, 0x0b, 0x1f,
+	0xab, 0x55, 0x2f, 0x86, 0x96, 0xd1, 0x5d, 0xc8, 0xb8, 0x35, 0xf4, 0xbc, 0x17, 0x64, 0xd5, 0xb9,
+	0x78, 0x2f, 0x9d, 0x1a, 0xc7, 0x22, 0x2e, 0x7e, 0xc7, 0x56, 0x9d, 0x03, 0x3a, 0xa3, 0x3d, 0x48,
+	0x8b, 0x6a, 0x75, 0xce, 0xd3, 0xb4, 0xea, 0x3c, 0x18, 0x19, 0xa9, 0x90, 0x9b, 0xa0, 0x3c, 0xf3,
+	0x5f, 0xe7, 0x55, 0x17, 0xc0, 0xd3, 0xd1, 0x3b, 0x50, 0x0c, 0x56, 0xc2, 0x8b, 0x3d, 0x7f, 0xab,
+	0x2e, 0x08, 0x58, 0x53, 0xfd, 0xc1, 0xb2, 0x78, 0xb1, 0xe7, 0x70, 0xd5, 0x05, 0xf1, 0x6b, 0xf4,
+	0x1e, 0xac, 0xcc, 0x96, 0xad, 0x8b, 0xbf, 0x8e, 0xab, 0x2e, 0x81, 0x68, 0xa3, 0x01, 0xa0, 0x90,
+	0x72, 0x77, 0x89, 0xc7, 0x72, 0xd5, 0x65, 0x00, 0x6e, 0xd4, 0x81, 0xf2, 0x74, 0x0d, 0xb9, 0xe8,
+	0xe3, 0xb9, 0xea, 0xc2, 0x60, 0x37, 0x1f, 0x25, 0x58, 0x7b, 0x2e, 0xfa, 0x98, 0xae, 0xba, 0x30,
+	0xf6, 0x8d, 0xee, 0x01, 0xf8, 0xea, 0xc3, 0x05, 0x1e, 0xd7, 0x55, 0x17, 0x41, 0xc1, 0x91, 0x05,
+	0xab, 0x61, 0x85, 0xe3, 0x32, 0x6f, 0xed, 0xaa, 0x4b, 0x81, 0xe3, 0xd4, 0x9e, 0x83, 0x25, 0xe0,
+	0x62, 0x6f, 0xef, 0xaa, 0x0b, 0xa2, 0xe4, 0xbb, 0xb5, 0xcf, 0xbe, 0x5c, 0x97, 0x3e, 0xff, 0x72,
+	0x5d, 0xfa, 0xc7, 0x97, 0xeb, 0xd2, 0x27, 0x5f, 0xad, 0xc7, 0x3e, 0xff, 0x6a, 0x3d, 0xf6, 0xb7,
+	0xaf, 0xd6, 0x63, 0x3f, 0x78, 0xfa, 0xd4, 0x20, 0xbd, 0xd1, 0xc9, 0x56, 0xdb, 0x1c, 0x6c, 0xb7,
+	0xcd, 0x01, 0x26, 0x27, 0x5d, 0x32, 0x69, 0x4c, 0x9e, 0x50, 0x9f, 0xa4, 0x59, 0x04, 0xbd, 0xf1,
+	0xef, 0x01, 0x00, 0xc8, 0x8b, 0xe3, 0x7f, 0x62, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5332,6 +5360,11 @@ func (m *RequestPrepareProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.ProposerAddress) > 0 {
 		i -= len(m.ProposerAddress)
 		copy(dAtA[i:], m.ProposerAddress)
@@ -5420,6 +5453,11 @@ func (m *RequestProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.ProposerAddress) > 0 {
 		i -= len(m.ProposerAddress)
 		copy(dAtA[i:], m.ProposerAddress)
@@ -5600,6 +5638,25 @@ func (m *RequestVerifyVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteCodes) > 0 {
+		dAtA2 := make([]byte, len(m.VoteCodes)*10)
+		var j1 int
+		for _, num1 := range m.VoteCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.VoteExtension) > 0 {
 		i -= len(m.VoteExtension)
 		copy(dAtA[i:], m.VoteExtension)
@@ -5649,10 +5706,24 @@ func (m *RequestFinalizeBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.VoteCode != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VoteCode))
+	if len(m.VoteCodes) > 0 {
+		dAtA9 := make([]byte, len(m.VoteCodes)*10)
+		var j9 int
+		for _, num1 := range m.VoteCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA9[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA9[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA9[:j9])
+		i = encodeVarintTypes(dAtA, i, uint64(j9))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x4a
 	}
 	if len(m.ProposerAddress) > 0 {
 		i -= len(m.ProposerAddress)
@@ -6713,10 +6784,24 @@ func (m *ResponseProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	if m.VoteCode != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VoteCode))
+	if len(m.VoteCodes) > 0 {
+		dAtA2 := make([]byte, len(m.VoteCodes)*10)
+		var j2 int
+		for _, num1 := range m.VoteCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA2[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA2[:j2])
+		i = encodeVarintTypes(dAtA, i, uint64(j2))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.Status != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
@@ -7884,6 +7969,9 @@ func (m *RequestPrepareProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
 	return n
 }
 
@@ -7924,6 +8012,9 @@ func (m *RequestProcessProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
 	return n
 }
 
@@ -7988,6 +8079,13 @@ func (m *RequestVerifyVoteExtension) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if len(m.VoteCodes) > 0 {
+		l = 0
+		for _, e := range m.VoteCodes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
 	return n
 }
 
@@ -8028,8 +8126,12 @@ func (m *RequestFinalizeBlock) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.VoteCode != 0 {
-		n += 1 + sovTypes(uint64(m.VoteCode))
+	if len(m.VoteCodes) > 0 {
+		l = 0
+		for _, e := range m.VoteCodes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
 	}
 	return n
 }
@@ -8518,8 +8620,12 @@ func (m *ResponseProcessProposal) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovTypes(uint64(m.Status))
 	}
-	if m.VoteCode != 0 {
-		n += 1 + sovTypes(uint64(m.VoteCode))
+	if len(m.VoteCodes) > 0 {
+		l = 0
+		for _, e := range m.VoteCodes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
 	}
 	return n
 }
@@ -10959,6 +11065,25 @@ func (m *RequestPrepareProposal) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11262,6 +11387,25 @@ func (m *RequestProcessProposal) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11736,6 +11880,82 @@ func (m *RequestVerifyVoteExtension) Unmarshal(dAtA []byte) error {
 				m.VoteExtension = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VoteCodes = append(m.VoteCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VoteCodes) == 0 {
+					m.VoteCodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VoteCodes = append(m.VoteCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCodes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -12040,23 +12260,80 @@ func (m *RequestFinalizeBlock) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteCode", wireType)
-			}
-			m.VoteCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.VoteCodes = append(m.VoteCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoteCode |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VoteCodes) == 0 {
+					m.VoteCodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VoteCodes = append(m.VoteCodes, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCodes", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -14470,23 +14747,80 @@ func (m *ResponseProcessProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteCode", wireType)
-			}
-			m.VoteCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.VoteCodes = append(m.VoteCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoteCode |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VoteCodes) == 0 {
+					m.VoteCodes = make([]int64, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VoteCodes = append(m.VoteCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCodes", wireType)
 			}
 		default:
 			iNdEx = preIndex
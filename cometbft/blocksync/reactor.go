@@ -493,8 +493,18 @@ FOR_LOOP:
 			// first.Hash() doesn't verify the tx contents, so MakePartSet() is
 			// currently necessary.
 			// TODO(sergio): Should we also validate against the extended commit?
-			err = state.Validators.VerifyCommitLight(
-				chainID, firstID, first.Height, second.LastCommit)
+			//
+			// The vote codes handed to the application are the ones decided by
+			// second.LastCommit rather than the unauthenticated header field, so
+			// once vote codes are signed every signature has to be verified.
+			if state.ConsensusParams.ABCI.VoteCodeEnabled(first.Height) {
+				err = state.Validators.VerifyCommit(
+					chainID, firstID, first.Height, second.LastCommit)
+			} else {
+				err = state.Validators.VerifyCommitLight(
+					chainID, firstID, first.Height, second.LastCommit.WithoutVoteCodes())
+			}
+			first.VoteCodes = second.LastCommit.DecidedVoteCodes(state.Validators)
 
 			if err == nil {
 				// validate the block before we persist it
@@ -541,7 +551,7 @@ FOR_LOOP:
 				// guaranteed to be populated by the peer if extensions are not enabled.
 				// Currently, the peer should provide an extCommit even if the vote extension data are absent
 				// but this may change so using second.LastCommit is safer.
-				bcR.Logger.Info("save block poolRoutine", "height", first.Height, "votecode", first.VoteCode)
+				bcR.Logger.Info("save block poolRoutine", "height", first.Height, "votecodes", first.VoteCodes)
 				bcR.store.SaveBlock(first, firstParts, second.LastCommit)
 			}
 
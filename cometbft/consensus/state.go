@@ -616,7 +616,7 @@ func (cs *State) votesFromExtendedCommit(state sm.State) (*types.VoteSet, error)
 		return nil, fmt.Errorf("heights don't match in votesFromExtendedCommit %v!=%v",
 			ec.Height, state.LastBlockHeight)
 	}
-	vs := ec.ToExtendedVoteSet(state.ChainID, state.LastValidators)
+	vs := ec.ToExtendedVoteSet(state.ChainID, state.LastValidators, state.ConsensusParams.ABCI)
 	if !vs.HasTwoThirdsMajority() {
 		return nil, errors.New("extended commit does not have +2/3 majority")
 	}
@@ -635,7 +635,7 @@ func (cs *State) votesFromSeenCommit(state sm.State) (*types.VoteSet, error) {
 		return nil, fmt.Errorf("heights don't match in votesFromSeenCommit %v!=%v",
 			commit.Height, state.LastBlockHeight)
 	}
-	vs := commit.ToVoteSet(state.ChainID, state.LastValidators)
+	vs := commit.ToVoteSet(state.ChainID, state.LastValidators, state.ConsensusParams.ABCI)
 	if !vs.HasTwoThirdsMajority() {
 		return nil, errors.New("commit does not have +2/3 majority")
 	}
@@ -740,10 +740,11 @@ func (cs *State) updateToState(state sm.State) {
 	cs.ValidRound = -1
 	cs.ValidBlock = nil
 	cs.ValidBlockParts = nil
+	voteCodeEnabled := state.ConsensusParams.ABCI.VoteCodeEnabled(height)
 	if state.ConsensusParams.ABCI.VoteExtensionsEnabled(height) {
-		cs.Votes = cstypes.NewExtendedHeightVoteSet(state.ChainID, height, validators)
+		cs.Votes = cstypes.NewExtendedHeightVoteSet(state.ChainID, height, validators, voteCodeEnabled)
 	} else {
-		cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, validators)
+		cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, validators, voteCodeEnabled)
 	}
 	cs.CommitRound = -1
 	cs.LastValidators = state.LastValidators
@@ -1302,7 +1303,7 @@ func (cs *State) createProposalBlock(ctx context.Context) (*types.Block, error)
 
 	proposerAddr := cs.privValidatorPubKey.Address()
 
-	ret, err := cs.blockExec.CreateProposalBlock(ctx, cs.Height, cs.state, lastExtCommit, proposerAddr)
+	ret, err := cs.blockExec.CreateProposalBlock(ctx, cs.Height, cs.Round, cs.state, lastExtCommit, proposerAddr)
 	if err != nil {
 		panic(err)
 	}
@@ -1338,20 +1339,34 @@ func (cs *State) enterPrevote(height int64, round int32) {
 	// (so we have more time to try and collect +2/3 prevotes for a single block)
 }
 
+// lockedVoteCodes returns the vote codes of our prevote for the locked block
+// in the round we locked on it, so that prevoting the locked block again keeps
+// our opinion instead of casting no codes.
+func (cs *State) lockedVoteCodes() []int64 {
+	if cs.privValidatorPubKey == nil {
+		return nil
+	}
+	vote := cs.Votes.Prevotes(cs.LockedRound).GetByAddress(cs.privValidatorPubKey.Address())
+	if vote == nil || !cs.LockedBlock.HashesTo(vote.BlockID.Hash) {
+		return nil
+	}
+	return vote.VoteCodes
+}
+
 func (cs *State) defaultDoPrevote(height int64, round int32) {
 	logger := cs.Logger.With("height", height, "round", round)
 
 	// If a block is locked, prevote that.
 	if cs.LockedBlock != nil {
 		logger.Debug("prevote step; already locked on a block; prevoting locked block")
-		cs.signAddVote(cmtproto.PrevoteType, cs.LockedBlock.Hash(), cs.LockedBlockParts.Header(), nil, 0)
+		cs.signAddVote(cmtproto.PrevoteType, cs.LockedBlock.Hash(), cs.LockedBlockParts.Header(), nil, cs.lockedVoteCodes())
 		return
 	}
 
 	// If ProposalBlock is nil, prevote nil.
 	if cs.ProposalBlock == nil {
 		logger.Debug("prevote step: ProposalBlock is nil")
-		cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{}, nil, 0)
+		cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{}, nil, nil)
 		return
 	}
 
@@ -1361,7 +1376,7 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		// ProposalBlock is invalid, prevote nil.
 		logger.Error("prevote step: consensus deems this block invalid; prevoting nil",
 			"err", err)
-		cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{}, nil, 0)
+		cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{}, nil, nil)
 		return
 	}
 
@@ -1375,7 +1390,7 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		Please see `PrepareProosal`-`ProcessProposal` coherence and determinism properties
 		in the ABCI++ specification.
 	*/
-	isAppValid, voteCode, err := cs.blockExec.ProcessProposal(cs.ProposalBlock, cs.state)
+	isAppValid, voteCodes, err := cs.blockExec.ProcessProposal(cs.ProposalBlock, round, cs.state)
 	if err != nil {
 		panic(fmt.Sprintf(
 			"state machine returned an error (%v) when calling ProcessProposal", err,
@@ -1387,14 +1402,14 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 	if !isAppValid {
 		logger.Error("prevote step: state machine rejected a proposed block; this should not happen:"+
 			"the proposer may be misbehaving; prevoting nil", "err", err)
-		cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{}, nil, 0)
+		cs.signAddVote(cmtproto.PrevoteType, nil, types.PartSetHeader{}, nil, nil)
 		return
 	}
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
-	logger.Info("prevote step: ProposalBlock is valid", "voteCode", voteCode)
-	cs.signAddVote(cmtproto.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil, voteCode)
+	logger.Info("prevote step: ProposalBlock is valid", "voteCodes", voteCodes)
+	cs.signAddVote(cmtproto.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil, voteCodes)
 }
 
 // Enter: any +2/3 prevotes at next round.
@@ -1450,13 +1465,10 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 		cs.updateRoundStep(round, cstypes.RoundStepPrecommit)
 		cs.newStep()
 	}()
-	cs.privValidatorPubKey.Address()
-	// check for a polka
-	blockID, ok, voteCode := cs.Votes.Prevotes(round).TwoThirdsMajority()
-	selfPrevote := cs.Votes.Prevotes(round).GetByAddress(cs.privValidatorPubKey.Address())
-	if selfPrevote != nil {
-		voteCode = selfPrevote.VoteCode
-	}
+	// check for a polka. Prevotes carry each validator's own vote codes, the
+	// precommit carries the outcome of their tally: for each governance tx the
+	// code backed by +2/3 or types.VoteCodeNoQuorum if the prevotes split.
+	blockID, ok, voteCodes := cs.Votes.Prevotes(round).TwoThirdsMajority()
 
 	// If we don't have a polka, we must precommit nil.
 	if !ok {
@@ -1466,7 +1478,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 			logger.Debug("precommit step; no +2/3 prevotes during enterPrecommit; precommitting nil")
 		}
 
-		cs.signAddVote(cmtproto.PrecommitType, nil, types.PartSetHeader{}, nil, 0)
+		cs.signAddVote(cmtproto.PrecommitType, nil, types.PartSetHeader{}, nil, nil)
 		return
 	}
 
@@ -1496,7 +1508,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 			}
 		}
 
-		cs.signAddVote(cmtproto.PrecommitType, nil, types.PartSetHeader{}, nil, 0)
+		cs.signAddVote(cmtproto.PrecommitType, nil, types.PartSetHeader{}, nil, nil)
 		return
 	}
 
@@ -1511,7 +1523,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 			logger.Error("failed publishing event relock", "err", err)
 		}
 
-		cs.signAddVote(cmtproto.PrecommitType, blockID.Hash, blockID.PartSetHeader, cs.LockedBlock, voteCode)
+		cs.signAddVote(cmtproto.PrecommitType, blockID.Hash, blockID.PartSetHeader, cs.LockedBlock, voteCodes)
 		return
 	}
 
@@ -1532,7 +1544,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 			logger.Error("failed publishing event lock", "err", err)
 		}
 
-		cs.signAddVote(cmtproto.PrecommitType, blockID.Hash, blockID.PartSetHeader, cs.ProposalBlock, voteCode)
+		cs.signAddVote(cmtproto.PrecommitType, blockID.Hash, blockID.PartSetHeader, cs.ProposalBlock, voteCodes)
 		return
 	}
 
@@ -1554,7 +1566,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 		logger.Error("failed publishing event unlock", "err", err)
 	}
 
-	cs.signAddVote(cmtproto.PrecommitType, nil, types.PartSetHeader{}, nil, 0)
+	cs.signAddVote(cmtproto.PrecommitType, nil, types.PartSetHeader{}, nil, nil)
 }
 
 // Enter: any +2/3 precommits for next round.
@@ -1664,7 +1676,6 @@ func (cs *State) tryFinalizeCommit(height int64) {
 		logger.Error("failed attempt to finalize commit; there was no +2/3 majority or +2/3 was for nil")
 		return
 	}
-	// cs.ProposalBlock.VoteCode = voteCode
 
 	if !cs.ProposalBlock.HashesTo(blockID.Hash) {
 		// TODO: this happens every time if we're not a validator (ugly logs)
@@ -1694,13 +1705,19 @@ func (cs *State) finalizeCommit(height int64) {
 
 	cs.calculatePrevoteMessageDelayMetrics()
 
-	blockID, ok, voteCode := cs.Votes.Precommits(cs.CommitRound).TwoThirdsMajority()
-	cs.ProposalBlock.Header.VoteCode = voteCode
+	blockID, ok, _ := cs.Votes.Precommits(cs.CommitRound).TwoThirdsMajority()
 	block, blockParts := cs.ProposalBlock, cs.ProposalBlockParts
 
 	if !ok {
 		panic("cannot finalize commit; commit does not have 2/3 majority")
 	}
+
+	// NOTE: the seenCommit is local justification to commit this block,
+	// but may differ from the LastCommit included in the next block.
+	// The vote codes are decided from its signatures, whatever codes the
+	// precommits for the block carry.
+	seenExtendedCommit := cs.Votes.Precommits(cs.CommitRound).MakeExtendedCommit(cs.state.ConsensusParams.ABCI)
+	block.Header.VoteCodes = seenExtendedCommit.ToCommit().DecidedVoteCodes(cs.state.Validators)
 	if !blockParts.HasHeader(blockID.PartSetHeader) {
 		panic("expected ProposalBlockParts header to be commit header")
 	}
@@ -1724,13 +1741,10 @@ func (cs *State) finalizeCommit(height int64) {
 
 	// Save to blockStore.
 	if cs.blockStore.Height() < block.Height {
-		// NOTE: the seenCommit is local justification to commit this block,
-		// but may differ from the LastCommit included in the next block
-		seenExtendedCommit := cs.Votes.Precommits(cs.CommitRound).MakeExtendedCommit(cs.state.ConsensusParams.ABCI)
 		if cs.state.ConsensusParams.ABCI.VoteExtensionsEnabled(block.Height) {
 			cs.blockStore.SaveBlockWithExtendedCommit(block, blockParts, seenExtendedCommit)
 		} else {
-			cs.Logger.Info("save block finalizeCommit", "height", block.Height, "votecode", block.VoteCode)
+			cs.Logger.Info("save block finalizeCommit", "height", block.Height, "votecodes", block.VoteCodes)
 			cs.blockStore.SaveBlock(block, blockParts, seenExtendedCommit.ToCommit())
 		}
 	} else {
@@ -2030,8 +2044,8 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 func (cs *State) handleCompleteProposal(blockHeight int64) {
 	// Update Valid* if we can.
 	prevotes := cs.Votes.Prevotes(cs.Round)
-	blockID, hasTwoThirds, voteCode := prevotes.TwoThirdsMajority()
-	cs.ProposalBlock.VoteCode = voteCode
+	blockID, hasTwoThirds, voteCodes := prevotes.TwoThirdsMajority()
+	cs.ProposalBlock.VoteCodes = voteCodes
 	if hasTwoThirds && !blockID.IsZero() && (cs.ValidRound < cs.Round) {
 		if cs.ProposalBlock.HashesTo(blockID.Hash) {
 			cs.Logger.Debug(
@@ -2124,7 +2138,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		"cs_height", cs.Height,
 		"extLen", len(vote.Extension),
 		"extSigLen", len(vote.ExtensionSignature),
-		"vote_code", vote.VoteCode,
+		"vote_codes", vote.VoteCodes,
 	)
 
 	if vote.Height < cs.Height || (vote.Height == cs.Height && vote.Round < cs.Round) {
@@ -2206,7 +2220,13 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 				return false, err
 			}
 
-			err := cs.blockExec.VerifyVoteExtension(context.TODO(), vote)
+			// the extension is checked against the validator's own vote
+			// codes, carried by its prevote for the block in the round
+			prevote := cs.Votes.Prevotes(vote.Round).GetByIndex(vote.ValidatorIndex)
+			if prevote != nil && !prevote.BlockID.Equals(vote.BlockID) {
+				prevote = nil
+			}
+			err := cs.blockExec.VerifyVoteExtension(context.TODO(), vote, prevote)
 			cs.metrics.MarkVoteExtensionReceived(err == nil)
 			if err != nil {
 				return false, err
@@ -2367,7 +2387,7 @@ func (cs *State) signVote(
 	hash []byte,
 	header types.PartSetHeader,
 	block *types.Block,
-	voteCode int64,
+	voteCodes []int64,
 ) (*types.Vote, error) {
 	// Flush the WAL. Otherwise, we may not recompute the same vote to sign,
 	// and the privValidator will refuse to sign anything.
@@ -2390,7 +2410,7 @@ func (cs *State) signVote(
 		Timestamp:        cs.voteTime(),
 		Type:             msgType,
 		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
-		VoteCode:         voteCode,
+		VoteCodes:        voteCodes,
 	}
 
 	extEnabled := cs.state.ConsensusParams.ABCI.VoteExtensionsEnabled(vote.Height)
@@ -2406,10 +2426,16 @@ func (cs *State) signVote(
 		}
 	}
 
+	// Before vote codes are authenticated the vote is signed without its codes,
+	// which are restored afterwards so that they are still gossiped and tallied.
+	if !cs.state.ConsensusParams.ABCI.VoteCodeEnabled(vote.Height) {
+		vote.VoteCodes = nil
+	}
 	recoverable, err := types.SignAndCheckVote(vote, cs.privValidator, cs.state.ChainID, extEnabled && (msgType == cmtproto.PrecommitType))
 	if err != nil && !recoverable {
 		panic(fmt.Sprintf("non-recoverable error when signing vote %v: %v", vote, err))
 	}
+	vote.VoteCodes = voteCodes
 
 	return vote, err
 }
@@ -2442,9 +2468,9 @@ func (cs *State) signAddVote(
 	hash []byte,
 	header types.PartSetHeader,
 	block *types.Block,
-	voteCode int64,
+	voteCodes []int64,
 ) {
-	cs.Logger.Debug("signAddVote", "msgType", msgType, "height", cs.Height, "round", cs.Round, "voteCode", voteCode)
+	cs.Logger.Debug("signAddVote", "msgType", msgType, "height", cs.Height, "round", cs.Round, "voteCodes", voteCodes)
 	if cs.privValidator == nil { // the node does not have a key
 		return
 	}
@@ -2461,7 +2487,7 @@ func (cs *State) signAddVote(
 	}
 
 	// TODO: pass pubKey to signVote
-	vote, err := cs.signVote(msgType, hash, header, block, voteCode)
+	vote, err := cs.signVote(msgType, hash, header, block, voteCodes)
 	if err != nil {
 		cs.Logger.Error("failed signing vote", "height", cs.Height, "round", cs.Round, "vote", vote, "err", err)
 		return
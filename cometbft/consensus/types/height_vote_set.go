@@ -43,6 +43,7 @@ type HeightVoteSet struct {
 	height            int64
 	valSet            *types.ValidatorSet
 	extensionsEnabled bool
+	voteCodeEnabled   bool
 
 	mtx               sync.Mutex
 	round             int32                  // max tracked round
@@ -50,19 +51,21 @@ type HeightVoteSet struct {
 	peerCatchupRounds map[p2p.ID][]int32     // keys: peer.ID; values: at most 2 rounds
 }
 
-func NewHeightVoteSet(chainID string, height int64, valSet *types.ValidatorSet) *HeightVoteSet {
+func NewHeightVoteSet(chainID string, height int64, valSet *types.ValidatorSet, voteCodeEnabled bool) *HeightVoteSet {
 	hvs := &HeightVoteSet{
 		chainID:           chainID,
 		extensionsEnabled: false,
+		voteCodeEnabled:   voteCodeEnabled,
 	}
 	hvs.Reset(height, valSet)
 	return hvs
 }
 
-func NewExtendedHeightVoteSet(chainID string, height int64, valSet *types.ValidatorSet) *HeightVoteSet {
+func NewExtendedHeightVoteSet(chainID string, height int64, valSet *types.ValidatorSet, voteCodeEnabled bool) *HeightVoteSet {
 	hvs := &HeightVoteSet{
 		chainID:           chainID,
 		extensionsEnabled: true,
+		voteCodeEnabled:   voteCodeEnabled,
 	}
 	hvs.Reset(height, valSet)
 	return hvs
@@ -122,6 +125,8 @@ func (hvs *HeightVoteSet) addRound(round int32) {
 	} else {
 		precommits = types.NewVoteSet(hvs.chainID, hvs.height, round, cmtproto.PrecommitType, hvs.valSet)
 	}
+	prevotes.SetVoteCodeEnabled(hvs.voteCodeEnabled)
+	precommits.SetVoteCodeEnabled(hvs.voteCodeEnabled)
 	hvs.roundVoteSets[round] = RoundVoteSet{
 		Prevotes:   prevotes,
 		Precommits: precommits,
@@ -26,7 +26,7 @@ func TestMain(m *testing.M) {
 func TestPeerCatchupRounds(t *testing.T) {
 	valSet, privVals := types.RandValidatorSet(10, 1)
 
-	hvs := NewExtendedHeightVoteSet(test.DefaultTestChainID, 1, valSet)
+	hvs := NewExtendedHeightVoteSet(test.DefaultTestChainID, 1, valSet, true)
 
 	vote999_0 := makeVoteHR(1, 0, 999, privVals)
 	added, err := hvs.AddVote(vote999_0, "peer1", true)
@@ -58,14 +58,14 @@ func TestPeerCatchupRounds(t *testing.T) {
 func TestInconsistentExtensionData(t *testing.T) {
 	valSet, privVals := types.RandValidatorSet(10, 1)
 
-	hvsE := NewExtendedHeightVoteSet(test.DefaultTestChainID, 1, valSet)
+	hvsE := NewExtendedHeightVoteSet(test.DefaultTestChainID, 1, valSet, true)
 	voteNoExt := makeVoteHR(1, 0, 20, privVals)
 	voteNoExt.Extension, voteNoExt.ExtensionSignature = nil, nil
 	require.Panics(t, func() {
 		_, _ = hvsE.AddVote(voteNoExt, "peer1", false)
 	})
 
-	hvsNoE := NewHeightVoteSet(test.DefaultTestChainID, 1, valSet)
+	hvsNoE := NewHeightVoteSet(test.DefaultTestChainID, 1, valSet, true)
 	voteExt := makeVoteHR(1, 0, 20, privVals)
 	require.Panics(t, func() {
 		_, _ = hvsNoE.AddVote(voteExt, "peer1", true)
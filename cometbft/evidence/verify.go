@@ -52,6 +52,12 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 		if err != nil {
 			return err
 		}
+		// Votes cast before vote codes were authenticated are signed without them.
+		if !state.ConsensusParams.ABCI.VoteCodeEnabled(ev.Height()) {
+			evCopy := *ev
+			evCopy.VoteA, evCopy.VoteB = ev.VoteA.WithoutVoteCodes(), ev.VoteB.WithoutVoteCodes()
+			ev = &evCopy
+		}
 		return VerifyDuplicateVote(ev, state.ChainID, valSet)
 
 	case *types.LightClientAttackEvidence:
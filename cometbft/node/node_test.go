@@ -326,6 +326,7 @@ func TestCreateProposalBlock(t *testing.T) {
 	block, err := blockExec.CreateProposalBlock(
 		ctx,
 		height,
+		0,
 		state,
 		extCommit,
 		proposerAddr,
@@ -403,6 +404,7 @@ func TestMaxProposalBlockSize(t *testing.T) {
 	block, err := blockExec.CreateProposalBlock(
 		ctx,
 		height,
+		0,
 		state,
 		extCommit,
 		proposerAddr,
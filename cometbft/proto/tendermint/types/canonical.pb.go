@@ -231,6 +231,7 @@ type CanonicalVote struct {
 	BlockID   *CanonicalBlockID `protobuf:"bytes,4,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
 	Timestamp time.Time         `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 	ChainID   string            `protobuf:"bytes,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	VoteCodes []int64           `protobuf:"fixed64,7,rep,packed,name=vote_codes,json=voteCodes,proto3" json:"vote_codes,omitempty"`
 }
 
 func (m *CanonicalVote) Reset()         { *m = CanonicalVote{} }
@@ -308,6 +309,13 @@ func (m *CanonicalVote) GetChainID() string {
 	return ""
 }
 
+func (m *CanonicalVote) GetVoteCodes() []int64 {
+	if m != nil {
+		return m.VoteCodes
+	}
+	return nil
+}
+
 // CanonicalVoteExtension provides us a way to serialize a vote extension from
 // a particular validator such that we can sign over those serialized bytes.
 type CanonicalVoteExtension struct {
@@ -389,40 +397,41 @@ func init() {
 func init() { proto.RegisterFile("tendermint/types/canonical.proto", fileDescriptor_8d1a1a84ff7267ed) }
 
 var fileDescriptor_8d1a1a84ff7267ed = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xc1, 0x6e, 0x9b, 0x40,
-	0x10, 0x35, 0x0e, 0xb6, 0x61, 0x13, 0xb7, 0xee, 0x2a, 0x8a, 0xa8, 0x15, 0x01, 0xe2, 0x50, 0xd1,
-	0x0b, 0x48, 0xf1, 0x1f, 0x90, 0x56, 0xaa, 0xab, 0x46, 0x8d, 0x48, 0x94, 0x43, 0x2f, 0xd6, 0x02,
-	0x1b, 0x40, 0x05, 0x16, 0xc1, 0x5a, 0x6a, 0x2e, 0xed, 0x2f, 0xe4, 0x3b, 0xfa, 0x25, 0x39, 0xe6,
-	0xd8, 0x5e, 0xdc, 0x0a, 0xff, 0x48, 0xb5, 0x0b, 0x06, 0x2b, 0xa9, 0x2c, 0x55, 0xad, 0x7a, 0x41,
-	0x33, 0x6f, 0xde, 0xce, 0x3c, 0xbd, 0x61, 0x17, 0xe8, 0x14, 0x67, 0x01, 0x2e, 0xd2, 0x38, 0xa3,
-	0x36, 0xbd, 0xc9, 0x71, 0x69, 0xfb, 0x28, 0x23, 0x59, 0xec, 0xa3, 0xc4, 0xca, 0x0b, 0x42, 0x09,
-	0x9c, 0x74, 0x0c, 0x8b, 0x33, 0xa6, 0x87, 0x21, 0x09, 0x09, 0x2f, 0xda, 0x2c, 0xaa, 0x79, 0xd3,
-	0xe3, 0x47, 0x9d, 0xf8, 0xb7, 0xa9, 0x6a, 0x21, 0x21, 0x61, 0x82, 0x6d, 0x9e, 0x79, 0xcb, 0x6b,
-	0x9b, 0xc6, 0x29, 0x2e, 0x29, 0x4a, 0xf3, 0x9a, 0x60, 0x7c, 0x06, 0x93, 0xd3, 0xcd, 0x64, 0x27,
-	0x21, 0xfe, 0xc7, 0xf9, 0x2b, 0x08, 0x81, 0x18, 0xa1, 0x32, 0x52, 0x04, 0x5d, 0x30, 0x0f, 0x5c,
-	0x1e, 0xc3, 0x2b, 0xf0, 0x34, 0x47, 0x05, 0x5d, 0x94, 0x98, 0x2e, 0x22, 0x8c, 0x02, 0x5c, 0x28,
-	0x7d, 0x5d, 0x30, 0xf7, 0x4f, 0x4c, 0xeb, 0xa1, 0x50, 0xab, 0x6d, 0x78, 0x8e, 0x0a, 0x7a, 0x81,
-	0xe9, 0x1b, 0xce, 0x77, 0xc4, 0xbb, 0x95, 0xd6, 0x73, 0xc7, 0xf9, 0x36, 0x68, 0x38, 0xe0, 0xe8,
-	0xf7, 0x74, 0x78, 0x08, 0x06, 0x94, 0x50, 0x94, 0x70, 0x19, 0x63, 0xb7, 0x4e, 0x5a, 0x6d, 0xfd,
-	0x4e, 0x9b, 0xf1, 0xbd, 0x0f, 0x9e, 0x75, 0x4d, 0x0a, 0x92, 0x93, 0x12, 0x25, 0x70, 0x06, 0x44,
-	0x26, 0x87, 0x1f, 0x7f, 0x72, 0xa2, 0x3d, 0x96, 0x79, 0x11, 0x87, 0x19, 0x0e, 0xce, 0xca, 0xf0,
-	0xf2, 0x26, 0xc7, 0x2e, 0x27, 0xc3, 0x23, 0x30, 0x8c, 0x70, 0x1c, 0x46, 0x94, 0x0f, 0x98, 0xb8,
-	0x4d, 0xc6, 0xc4, 0x14, 0x64, 0x99, 0x05, 0xca, 0x1e, 0x87, 0xeb, 0x04, 0xbe, 0x04, 0x72, 0x4e,
-	0x92, 0x45, 0x5d, 0x11, 0x75, 0xc1, 0xdc, 0x73, 0x0e, 0xaa, 0x95, 0x26, 0x9d, 0xbf, 0x7f, 0xe7,
-	0x32, 0xcc, 0x95, 0x72, 0x92, 0xf0, 0x08, 0xbe, 0x05, 0x92, 0xc7, 0xec, 0x5d, 0xc4, 0x81, 0x32,
-	0xe0, 0xc6, 0x19, 0x3b, 0x8c, 0x6b, 0x36, 0xe1, 0xec, 0x57, 0x2b, 0x6d, 0xd4, 0x24, 0xee, 0x88,
-	0x37, 0x98, 0x07, 0xd0, 0x01, 0x72, 0xbb, 0x46, 0x65, 0xc8, 0x9b, 0x4d, 0xad, 0x7a, 0xd1, 0xd6,
-	0x66, 0xd1, 0xd6, 0xe5, 0x86, 0xe1, 0x48, 0xcc, 0xf7, 0xdb, 0x1f, 0x9a, 0xe0, 0x76, 0xc7, 0xe0,
-	0x0b, 0x20, 0xf9, 0x11, 0x8a, 0x33, 0xa6, 0x67, 0xa4, 0x0b, 0xa6, 0x5c, 0xcf, 0x3a, 0x65, 0x18,
-	0x9b, 0xc5, 0x8b, 0xf3, 0xc0, 0xf8, 0xda, 0x07, 0xe3, 0x56, 0xd6, 0x15, 0xa1, 0xf8, 0x7f, 0xf8,
-	0xba, 0x6d, 0x96, 0xf8, 0x2f, 0xcd, 0x1a, 0xfc, 0xbd, 0x59, 0xc3, 0x1d, 0x66, 0x7d, 0xd9, 0xfa,
-	0x99, 0x99, 0x57, 0xaf, 0x3f, 0x51, 0x9c, 0x95, 0x31, 0xc9, 0xe0, 0x31, 0x90, 0xf1, 0x26, 0x69,
-	0xee, 0x55, 0x07, 0xfc, 0xa1, 0x3b, 0xcf, 0xb7, 0xd4, 0x30, 0x77, 0xe4, 0x56, 0x80, 0x73, 0x76,
-	0x57, 0xa9, 0xc2, 0x7d, 0xa5, 0x0a, 0x3f, 0x2b, 0x55, 0xb8, 0x5d, 0xab, 0xbd, 0xfb, 0xb5, 0xda,
-	0xfb, 0xb6, 0x56, 0x7b, 0x1f, 0x66, 0x61, 0x4c, 0xa3, 0xa5, 0x67, 0xf9, 0x24, 0xb5, 0x7d, 0x92,
-	0x62, 0xea, 0x5d, 0xd3, 0x2e, 0xa8, 0x5f, 0x95, 0x87, 0x2f, 0x89, 0x37, 0xe4, 0xf8, 0xec, 0x57,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x22, 0x5b, 0x0b, 0xae, 0x04, 0x00, 0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xf3, 0xed, 0x6d, 0x03, 0x61, 0x55, 0x45, 0x26, 0x2a, 0xb6, 0xe5, 0x03, 0x32, 0x17,
+	0x5b, 0x6a, 0xfe, 0x81, 0x03, 0x12, 0x41, 0x54, 0x54, 0x6e, 0xd5, 0x03, 0x97, 0x68, 0x63, 0x6f,
+	0x6d, 0x0b, 0xc7, 0x63, 0xd9, 0x1b, 0x44, 0x2f, 0xf0, 0x17, 0xfa, 0xb3, 0xca, 0xad, 0x47, 0xb8,
+	0x04, 0x94, 0xfc, 0x11, 0xb4, 0xeb, 0xc4, 0x0e, 0x2d, 0x20, 0x21, 0x50, 0x2f, 0xd1, 0xbc, 0x37,
+	0xcf, 0x33, 0x4f, 0x6f, 0x94, 0x45, 0x3a, 0xa3, 0x89, 0x4f, 0xb3, 0x79, 0x94, 0x30, 0x9b, 0x5d,
+	0xa6, 0x34, 0xb7, 0x3d, 0x92, 0x40, 0x12, 0x79, 0x24, 0xb6, 0xd2, 0x0c, 0x18, 0xe0, 0x7e, 0xa5,
+	0xb0, 0x84, 0x62, 0x78, 0x10, 0x40, 0x00, 0xa2, 0x69, 0xf3, 0xaa, 0xd0, 0x0d, 0x0f, 0xef, 0x4c,
+	0x12, 0xbf, 0x9b, 0xae, 0x16, 0x00, 0x04, 0x31, 0xb5, 0x05, 0x9a, 0x2d, 0x2e, 0x6c, 0x16, 0xcd,
+	0x69, 0xce, 0xc8, 0x3c, 0x2d, 0x04, 0xc6, 0x47, 0xd4, 0x1f, 0x6f, 0x37, 0x3b, 0x31, 0x78, 0xef,
+	0x26, 0xcf, 0x31, 0x46, 0xcd, 0x90, 0xe4, 0xa1, 0x22, 0xe9, 0x92, 0xb9, 0xef, 0x8a, 0x1a, 0x9f,
+	0xa3, 0x87, 0x29, 0xc9, 0xd8, 0x34, 0xa7, 0x6c, 0x1a, 0x52, 0xe2, 0xd3, 0x4c, 0xa9, 0xeb, 0x92,
+	0xb9, 0x77, 0x64, 0x5a, 0xb7, 0x8d, 0x5a, 0xe5, 0xc0, 0x13, 0x92, 0xb1, 0x53, 0xca, 0x5e, 0x0a,
+	0xbd, 0xd3, 0xbc, 0x5e, 0x6a, 0x35, 0xb7, 0x97, 0xee, 0x92, 0x86, 0x83, 0x06, 0xbf, 0x96, 0xe3,
+	0x03, 0xd4, 0x62, 0xc0, 0x48, 0x2c, 0x6c, 0xf4, 0xdc, 0x02, 0x94, 0xde, 0xea, 0x95, 0x37, 0xe3,
+	0x6b, 0x1d, 0x3d, 0xaa, 0x86, 0x64, 0x90, 0x42, 0x4e, 0x62, 0x3c, 0x42, 0x4d, 0x6e, 0x47, 0x7c,
+	0xfe, 0xe0, 0x48, 0xbb, 0x6b, 0xf3, 0x34, 0x0a, 0x12, 0xea, 0x1f, 0xe7, 0xc1, 0xd9, 0x65, 0x4a,
+	0x5d, 0x21, 0xc6, 0x03, 0xd4, 0x0e, 0x69, 0x14, 0x84, 0x4c, 0x2c, 0xe8, 0xbb, 0x1b, 0xc4, 0xcd,
+	0x64, 0xb0, 0x48, 0x7c, 0xa5, 0x21, 0xe8, 0x02, 0xe0, 0x67, 0x48, 0x4e, 0x21, 0x9e, 0x16, 0x9d,
+	0xa6, 0x2e, 0x99, 0x0d, 0x67, 0x7f, 0xb5, 0xd4, 0xba, 0x27, 0x6f, 0x5e, 0xbb, 0x9c, 0x73, 0xbb,
+	0x29, 0xc4, 0xa2, 0xc2, 0xaf, 0x50, 0x77, 0xc6, 0xe3, 0x9d, 0x46, 0xbe, 0xd2, 0x12, 0xc1, 0x19,
+	0x7f, 0x08, 0x6e, 0x73, 0x09, 0x67, 0x6f, 0xb5, 0xd4, 0x3a, 0x1b, 0xe0, 0x76, 0xc4, 0x80, 0x89,
+	0x8f, 0x1d, 0x24, 0x97, 0x67, 0x54, 0xda, 0x62, 0xd8, 0xd0, 0x2a, 0x0e, 0x6d, 0x6d, 0x0f, 0x6d,
+	0x9d, 0x6d, 0x15, 0x4e, 0x97, 0xe7, 0x7e, 0xf5, 0x4d, 0x93, 0xdc, 0xea, 0x33, 0xfc, 0x14, 0x75,
+	0xbd, 0x90, 0x44, 0x09, 0xf7, 0xd3, 0xd1, 0x25, 0x53, 0x2e, 0x76, 0x8d, 0x39, 0xc7, 0x77, 0x89,
+	0xe6, 0xc4, 0x37, 0x3e, 0xd7, 0x51, 0xaf, 0xb4, 0x75, 0x0e, 0x8c, 0xde, 0x47, 0xae, 0xbb, 0x61,
+	0x35, 0xff, 0x67, 0x58, 0xad, 0x7f, 0x0f, 0xab, 0xfd, 0xfb, 0xb0, 0xf0, 0x13, 0x84, 0xde, 0x03,
+	0xa3, 0x53, 0x0f, 0x7c, 0x9a, 0x2b, 0x1d, 0xbd, 0x61, 0xf6, 0x5d, 0x99, 0x33, 0x63, 0x4e, 0x18,
+	0x9f, 0xd0, 0xe0, 0xa7, 0x28, 0x5f, 0x7c, 0x60, 0x34, 0xc9, 0x23, 0x48, 0xf0, 0x21, 0x92, 0xe9,
+	0x16, 0x6c, 0xfe, 0x76, 0x15, 0xf1, 0x97, 0xe1, 0x3d, 0xde, 0x31, 0xcb, 0xc3, 0x93, 0x4b, 0x7f,
+	0xce, 0xf1, 0xf5, 0x4a, 0x95, 0x6e, 0x56, 0xaa, 0xf4, 0x7d, 0xa5, 0x4a, 0x57, 0x6b, 0xb5, 0x76,
+	0xb3, 0x56, 0x6b, 0x5f, 0xd6, 0x6a, 0xed, 0xed, 0x28, 0x88, 0x58, 0xb8, 0x98, 0x59, 0x1e, 0xcc,
+	0x6d, 0x0f, 0xe6, 0x94, 0xcd, 0x2e, 0x58, 0x55, 0x14, 0x8f, 0xce, 0xed, 0x87, 0x66, 0xd6, 0x16,
+	0xfc, 0xe8, 0xc7, 0x00, 0xe1, 0x01, 0x12, 0xfe, 0xcd, 0x04, 0x00, 0x00,
 }
 
 func (m *CanonicalBlockID) Marshal() (dAtA []byte, err error) {
@@ -592,6 +601,15 @@ func (m *CanonicalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteCodes) > 0 {
+		for iNdEx := len(m.VoteCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.VoteCodes[iNdEx]))
+		}
+		i = encodeVarintCanonical(dAtA, i, uint64(len(m.VoteCodes)*8))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.ChainID) > 0 {
 		i -= len(m.ChainID)
 		copy(dAtA[i:], m.ChainID)
@@ -786,6 +804,9 @@ func (m *CanonicalVote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCanonical(uint64(l))
 	}
+	if len(m.VoteCodes) > 0 {
+		n += 1 + sovCanonical(uint64(len(m.VoteCodes)*8)) + len(m.VoteCodes)*8
+	}
 	return n
 }
 
@@ -1416,6 +1437,58 @@ func (m *CanonicalVote) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.VoteCodes = append(m.VoteCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCanonical
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCanonical
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCanonical
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.VoteCodes) == 0 {
+					m.VoteCodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.VoteCodes = append(m.VoteCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCodes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCanonical(dAtA[iNdEx:])
@@ -393,6 +393,12 @@ type ABCIParams struct {
 	// passed to the application for validation in VerifyVoteExtension and given
 	// to the application to use when proposing a block during PrepareProposal.
 	VoteExtensionsEnableHeight int64 `protobuf:"varint,1,opt,name=vote_extensions_enable_height,json=voteExtensionsEnableHeight,proto3" json:"vote_extensions_enable_height,omitempty"`
+	// vote_code_enable_height configures the first height at which the vote code
+	// is part of the vote sign bytes. From this height on, the code carried by
+	// prevotes, precommits and commit signatures is authenticated and blocks
+	// commit to the decided code of their previous block in last_vote_code.
+	// Prior to this height, vote codes are not covered by vote signatures.
+	VoteCodeEnableHeight int64 `protobuf:"varint,2,opt,name=vote_code_enable_height,json=voteCodeEnableHeight,proto3" json:"vote_code_enable_height,omitempty"`
 }
 
 func (m *ABCIParams) Reset()         { *m = ABCIParams{} }
@@ -435,6 +441,13 @@ func (m *ABCIParams) GetVoteExtensionsEnableHeight() int64 {
 	return 0
 }
 
+func (m *ABCIParams) GetVoteCodeEnableHeight() int64 {
+	if m != nil {
+		return m.VoteCodeEnableHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ConsensusParams)(nil), "tendermint.types.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "tendermint.types.BlockParams")
@@ -448,43 +461,45 @@ func init() {
 func init() { proto.RegisterFile("tendermint/types/params.proto", fileDescriptor_e12598271a686f57) }
 
 var fileDescriptor_e12598271a686f57 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0x75, 0xda, 0xa6, 0x4f, 0x7e, 0x69, 0xa2, 0xd3, 0x4f, 0xc2, 0x14, 0xe2, 0x04,
-	0x0f, 0xa8, 0x52, 0x25, 0x1b, 0x91, 0x09, 0x84, 0x54, 0x25, 0x25, 0x6a, 0x0b, 0x2a, 0x7f, 0x2c,
-	0xc4, 0xd0, 0xc5, 0x3a, 0x27, 0x57, 0xc7, 0x6a, 0xec, 0xb3, 0x7c, 0xe7, 0x28, 0x79, 0x17, 0x8c,
-	0x8c, 0x1d, 0x61, 0x65, 0xe2, 0x25, 0x74, 0xec, 0xc8, 0x04, 0x28, 0x59, 0x78, 0x19, 0xc8, 0x67,
-	0xbb, 0x6e, 0x12, 0xb6, 0xbb, 0x7b, 0x3e, 0x9f, 0xfb, 0xf3, 0x7d, 0x74, 0xd0, 0x14, 0x34, 0x18,
-	0xd2, 0xc8, 0xf7, 0x02, 0x61, 0x8a, 0x59, 0x48, 0xb9, 0x19, 0x92, 0x88, 0xf8, 0xdc, 0x08, 0x23,
-	0x26, 0x18, 0x6e, 0x14, 0x65, 0x43, 0x96, 0xf7, 0xfe, 0x77, 0x99, 0xcb, 0x64, 0xd1, 0x4c, 0x46,
-	0x29, 0xb7, 0xa7, 0xb9, 0x8c, 0xb9, 0x63, 0x6a, 0xca, 0x99, 0x13, 0x5f, 0x98, 0xc3, 0x38, 0x22,
-	0xc2, 0x63, 0x41, 0x5a, 0xd7, 0xbf, 0x6d, 0x40, 0xfd, 0x88, 0x05, 0x9c, 0x06, 0x3c, 0xe6, 0xef,
-	0xe4, 0x09, 0xb8, 0x03, 0x9b, 0xce, 0x98, 0x0d, 0x2e, 0x55, 0xd4, 0x46, 0xfb, 0xd5, 0xa7, 0x4d,
-	0x63, 0xf5, 0x2c, 0xa3, 0x97, 0x94, 0x53, 0xda, 0x4a, 0x59, 0xfc, 0x02, 0x2a, 0x74, 0xe2, 0x0d,
-	0x69, 0x30, 0xa0, 0xea, 0x86, 0xf4, 0xda, 0xeb, 0x5e, 0x3f, 0x23, 0x32, 0xf5, 0xd6, 0xc0, 0x87,
-	0xb0, 0x33, 0x21, 0x63, 0x6f, 0x48, 0x04, 0x8b, 0x54, 0x45, 0xea, 0x8f, 0xd6, 0xf5, 0x8f, 0x39,
-	0x92, 0xf9, 0x85, 0x83, 0x9f, 0xc1, 0xf6, 0x84, 0x46, 0xdc, 0x63, 0x81, 0x5a, 0x96, 0x7a, 0xeb,
-	0x1f, 0x7a, 0x0a, 0x64, 0x72, 0xce, 0xe3, 0x27, 0x50, 0x26, 0xce, 0xc0, 0x53, 0x37, 0xa5, 0xf7,
-	0x70, 0xdd, 0xeb, 0xf6, 0x8e, 0x4e, 0x33, 0x49, 0x92, 0xfa, 0x29, 0x54, 0xef, 0x24, 0x80, 0x1f,
-	0xc0, 0x8e, 0x4f, 0xa6, 0xb6, 0x33, 0x13, 0x94, 0xcb, 0xcc, 0x14, 0xab, 0xe2, 0x93, 0x69, 0x2f,
-	0x99, 0xe3, 0x7b, 0xb0, 0x9d, 0x14, 0x5d, 0xc2, 0x65, 0x2c, 0x8a, 0xb5, 0xe5, 0x93, 0xe9, 0x31,
-	0xe1, 0xaf, 0xca, 0x15, 0xa5, 0x51, 0xd6, 0xbf, 0x22, 0xd8, 0x5d, 0x4e, 0x05, 0x1f, 0x00, 0x4e,
-	0x0c, 0xe2, 0x52, 0x3b, 0x88, 0x7d, 0x5b, 0xc6, 0x9b, 0xef, 0x5b, 0xf7, 0xc9, 0xb4, 0xeb, 0xd2,
-	0x37, 0xb1, 0x2f, 0x2f, 0xc0, 0xf1, 0x19, 0x34, 0x72, 0x38, 0xef, 0x6c, 0x16, 0xff, 0x7d, 0x23,
-	0x6d, 0xbd, 0x91, 0xb7, 0xde, 0x78, 0x99, 0x01, 0xbd, 0xca, 0xf5, 0xcf, 0x56, 0xe9, 0xf3, 0xaf,
-	0x16, 0xb2, 0x76, 0xd3, 0xfd, 0xf2, 0xca, 0xf2, 0x53, 0x94, 0xe5, 0xa7, 0xe8, 0x87, 0x50, 0x5f,
-	0xe9, 0x00, 0xd6, 0xa1, 0x16, 0xc6, 0x8e, 0x7d, 0x49, 0x67, 0xb6, 0xcc, 0x4a, 0x45, 0x6d, 0x65,
-	0x7f, 0xc7, 0xaa, 0x86, 0xb1, 0xf3, 0x9a, 0xce, 0x3e, 0x24, 0x4b, 0xcf, 0x2b, 0xdf, 0xaf, 0x5a,
-	0xe8, 0xcf, 0x55, 0x0b, 0xe9, 0x07, 0x50, 0x5b, 0xea, 0x01, 0x6e, 0x80, 0x42, 0xc2, 0x50, 0xbe,
-	0xad, 0x6c, 0x25, 0xc3, 0x3b, 0xf0, 0x39, 0xfc, 0x77, 0x42, 0xf8, 0x88, 0x0e, 0x33, 0xf6, 0x31,
-	0xd4, 0x65, 0x14, 0xf6, 0x6a, 0xd6, 0x35, 0xb9, 0x7c, 0x96, 0x07, 0xae, 0x43, 0xad, 0xe0, 0x8a,
-	0xd8, 0xab, 0x39, 0x75, 0x4c, 0xb8, 0xfe, 0x16, 0xa0, 0x68, 0x2a, 0xee, 0x42, 0x73, 0xc2, 0x04,
-	0xb5, 0xe9, 0x54, 0xd0, 0x20, 0xb9, 0x1d, 0xb7, 0x69, 0x40, 0x9c, 0x31, 0xb5, 0x47, 0xd4, 0x73,
-	0x47, 0x22, 0x3b, 0x67, 0x2f, 0x81, 0xfa, 0xb7, 0x4c, 0x5f, 0x22, 0x27, 0x92, 0xe8, 0xbd, 0xff,
-	0x32, 0xd7, 0xd0, 0xf5, 0x5c, 0x43, 0x37, 0x73, 0x0d, 0xfd, 0x9e, 0x6b, 0xe8, 0xd3, 0x42, 0x2b,
-	0xdd, 0x2c, 0xb4, 0xd2, 0x8f, 0x85, 0x56, 0x3a, 0xef, 0xb8, 0x9e, 0x18, 0xc5, 0x8e, 0x31, 0x60,
-	0xbe, 0x39, 0x60, 0x3e, 0x15, 0xce, 0x85, 0x28, 0x06, 0xe9, 0x9f, 0x5d, 0xfd, 0xee, 0xce, 0x96,
-	0x5c, 0xef, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xe8, 0xce, 0x9a, 0x09, 0x04, 0x00, 0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x75, 0xda, 0xa6, 0x2f, 0xa4, 0x89, 0x4e, 0x95, 0x1a, 0x0a, 0x75, 0x8a, 0x07,
+	0x54, 0xa9, 0x92, 0x83, 0xa8, 0x18, 0x40, 0x48, 0x55, 0x53, 0xaa, 0xb6, 0xa0, 0x22, 0x88, 0x10,
+	0x43, 0x17, 0xeb, 0x1c, 0xbf, 0x3a, 0x56, 0x63, 0x9f, 0xe5, 0x3b, 0x47, 0xc9, 0x17, 0x60, 0x66,
+	0x64, 0xec, 0x08, 0x2b, 0x13, 0x1f, 0xa1, 0x63, 0x47, 0x26, 0x40, 0xe9, 0xc2, 0xc7, 0x40, 0x3e,
+	0xdb, 0x75, 0x93, 0xb0, 0x9d, 0xef, 0xfd, 0x7e, 0xf7, 0xee, 0xfe, 0x4f, 0x09, 0x6c, 0x4a, 0x0c,
+	0x1c, 0x8c, 0x7c, 0x2f, 0x90, 0x6d, 0x39, 0x0e, 0x51, 0xb4, 0x43, 0x16, 0x31, 0x5f, 0x98, 0x61,
+	0xc4, 0x25, 0xa7, 0x8d, 0xa2, 0x6c, 0xaa, 0xf2, 0xc6, 0x9a, 0xcb, 0x5d, 0xae, 0x8a, 0xed, 0x64,
+	0x95, 0x72, 0x1b, 0xba, 0xcb, 0xb9, 0x3b, 0xc0, 0xb6, 0xfa, 0xb2, 0xe3, 0xf3, 0xb6, 0x13, 0x47,
+	0x4c, 0x7a, 0x3c, 0x48, 0xeb, 0xc6, 0xf7, 0x05, 0xa8, 0x1f, 0xf0, 0x40, 0x60, 0x20, 0x62, 0xf1,
+	0x4e, 0x75, 0xa0, 0xbb, 0xb0, 0x68, 0x0f, 0x78, 0xef, 0xa2, 0x49, 0xb6, 0xc8, 0x76, 0xf5, 0xe9,
+	0xa6, 0x39, 0xdb, 0xcb, 0xec, 0x24, 0xe5, 0x94, 0xee, 0xa6, 0x2c, 0x7d, 0x09, 0x15, 0x1c, 0x7a,
+	0x0e, 0x06, 0x3d, 0x6c, 0x2e, 0x28, 0x6f, 0x6b, 0xde, 0x3b, 0xcc, 0x88, 0x4c, 0xbd, 0x35, 0xe8,
+	0x1e, 0xac, 0x0c, 0xd9, 0xc0, 0x73, 0x98, 0xe4, 0x51, 0x53, 0x53, 0xfa, 0xa3, 0x79, 0xfd, 0x63,
+	0x8e, 0x64, 0x7e, 0xe1, 0xd0, 0xe7, 0xb0, 0x3c, 0xc4, 0x48, 0x78, 0x3c, 0x68, 0x96, 0x95, 0xde,
+	0xfa, 0x8f, 0x9e, 0x02, 0x99, 0x9c, 0xf3, 0xf4, 0x09, 0x94, 0x99, 0xdd, 0xf3, 0x9a, 0x8b, 0xca,
+	0x7b, 0x38, 0xef, 0xed, 0x77, 0x0e, 0x4e, 0x32, 0x49, 0x91, 0xc6, 0x09, 0x54, 0xef, 0x24, 0x40,
+	0x1f, 0xc0, 0x8a, 0xcf, 0x46, 0x96, 0x3d, 0x96, 0x28, 0x54, 0x66, 0x5a, 0xb7, 0xe2, 0xb3, 0x51,
+	0x27, 0xf9, 0xa6, 0xeb, 0xb0, 0x9c, 0x14, 0x5d, 0x26, 0x54, 0x2c, 0x5a, 0x77, 0xc9, 0x67, 0xa3,
+	0x23, 0x26, 0x5e, 0x97, 0x2b, 0x5a, 0xa3, 0x6c, 0x7c, 0x23, 0xb0, 0x3a, 0x9d, 0x0a, 0xdd, 0x01,
+	0x9a, 0x18, 0xcc, 0x45, 0x2b, 0x88, 0x7d, 0x4b, 0xc5, 0x9b, 0x9f, 0x5b, 0xf7, 0xd9, 0x68, 0xdf,
+	0xc5, 0xb7, 0xb1, 0xaf, 0x2e, 0x20, 0xe8, 0x29, 0x34, 0x72, 0x38, 0x9f, 0x6c, 0x16, 0xff, 0x7d,
+	0x33, 0x1d, 0xbd, 0x99, 0x8f, 0xde, 0x7c, 0x95, 0x01, 0x9d, 0xca, 0xd5, 0xaf, 0x56, 0xe9, 0xcb,
+	0xef, 0x16, 0xe9, 0xae, 0xa6, 0xe7, 0xe5, 0x95, 0xe9, 0xa7, 0x68, 0xd3, 0x4f, 0x31, 0xf6, 0xa0,
+	0x3e, 0x33, 0x01, 0x6a, 0x40, 0x2d, 0x8c, 0x6d, 0xeb, 0x02, 0xc7, 0x96, 0xca, 0xaa, 0x49, 0xb6,
+	0xb4, 0xed, 0x95, 0x6e, 0x35, 0x8c, 0xed, 0x37, 0x38, 0xfe, 0x90, 0x6c, 0xbd, 0xa8, 0xfc, 0xb8,
+	0x6c, 0x91, 0xbf, 0x97, 0x2d, 0x62, 0xec, 0x40, 0x6d, 0x6a, 0x06, 0xb4, 0x01, 0x1a, 0x0b, 0x43,
+	0xf5, 0xb6, 0x72, 0x37, 0x59, 0xde, 0x81, 0xcf, 0xe0, 0xde, 0x31, 0x13, 0x7d, 0x74, 0x32, 0xf6,
+	0x31, 0xd4, 0x55, 0x14, 0xd6, 0x6c, 0xd6, 0x35, 0xb5, 0x7d, 0x9a, 0x07, 0x6e, 0x40, 0xad, 0xe0,
+	0x8a, 0xd8, 0xab, 0x39, 0x75, 0xc4, 0x84, 0xf1, 0x89, 0x00, 0x14, 0x53, 0xa5, 0xfb, 0xb0, 0x39,
+	0xe4, 0x12, 0x2d, 0x1c, 0x49, 0x0c, 0x92, 0xeb, 0x09, 0x0b, 0x03, 0x66, 0x0f, 0xd0, 0xea, 0xa3,
+	0xe7, 0xf6, 0x65, 0xd6, 0x68, 0x23, 0x81, 0x0e, 0x6f, 0x99, 0x43, 0x85, 0x1c, 0x2b, 0x82, 0x3e,
+	0x83, 0x75, 0x75, 0x44, 0x8f, 0x3b, 0x38, 0x23, 0xa7, 0xfd, 0xd7, 0x92, 0xf2, 0x01, 0x77, 0xf0,
+	0xae, 0xd6, 0x79, 0xff, 0x75, 0xa2, 0x93, 0xab, 0x89, 0x4e, 0xae, 0x27, 0x3a, 0xf9, 0x33, 0xd1,
+	0xc9, 0xe7, 0x1b, 0xbd, 0x74, 0x7d, 0xa3, 0x97, 0x7e, 0xde, 0xe8, 0xa5, 0xb3, 0x5d, 0xd7, 0x93,
+	0xfd, 0xd8, 0x36, 0x7b, 0xdc, 0x6f, 0xf7, 0xb8, 0x8f, 0xd2, 0x3e, 0x97, 0xc5, 0x22, 0xfd, 0xad,
+	0xcf, 0xfe, 0x4d, 0xd8, 0x4b, 0x6a, 0x7f, 0xf7, 0xdf, 0x00, 0x9e, 0x17, 0x8f, 0xa8, 0x41, 0x04,
+	0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -682,6 +697,9 @@ func (this *ABCIParams) Equal(that interface{}) bool {
 	if this.VoteExtensionsEnableHeight != that1.VoteExtensionsEnableHeight {
 		return false
 	}
+	if this.VoteCodeEnableHeight != that1.VoteCodeEnableHeight {
+		return false
+	}
 	return true
 }
 func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
@@ -954,6 +972,11 @@ func (m *ABCIParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VoteCodeEnableHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteCodeEnableHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.VoteExtensionsEnableHeight != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.VoteExtensionsEnableHeight))
 		i--
@@ -1177,6 +1200,9 @@ func (m *ABCIParams) Size() (n int) {
 	if m.VoteExtensionsEnableHeight != 0 {
 		n += 1 + sovParams(uint64(m.VoteExtensionsEnableHeight))
 	}
+	if m.VoteCodeEnableHeight != 0 {
+		n += 1 + sovParams(uint64(m.VoteCodeEnableHeight))
+	}
 	return n
 }
 
@@ -1912,6 +1938,25 @@ func (m *ABCIParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCodeEnableHeight", wireType)
+			}
+			m.VoteCodeEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteCodeEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -248,9 +248,10 @@ type Header struct {
 	AppHash            []byte `protobuf:"bytes,11,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
 	LastResultsHash    []byte `protobuf:"bytes,12,opt,name=last_results_hash,json=lastResultsHash,proto3" json:"last_results_hash,omitempty"`
 	// consensus info
-	EvidenceHash    []byte `protobuf:"bytes,13,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
-	ProposerAddress []byte `protobuf:"bytes,14,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
-	VoteCode        int64  `protobuf:"varint,15,opt,name=vote_code,json=voteCode,proto3" json:"vote_code,omitempty"`
+	EvidenceHash    []byte  `protobuf:"bytes,13,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
+	ProposerAddress []byte  `protobuf:"bytes,14,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	VoteCodes       []int64 `protobuf:"varint,15,rep,packed,name=vote_codes,json=voteCodes,proto3" json:"vote_codes,omitempty"`
+	LastVoteCodes   []int64 `protobuf:"varint,16,rep,packed,name=last_vote_codes,json=lastVoteCodes,proto3" json:"last_vote_codes,omitempty"`
 }
 
 func (m *Header) Reset()         { *m = Header{} }
@@ -384,11 +385,18 @@ func (m *Header) GetProposerAddress() []byte {
 	return nil
 }
 
-func (m *Header) GetVoteCode() int64 {
+func (m *Header) GetVoteCodes() []int64 {
 	if m != nil {
-		return m.VoteCode
+		return m.VoteCodes
 	}
-	return 0
+	return nil
+}
+
+func (m *Header) GetLastVoteCodes() []int64 {
+	if m != nil {
+		return m.LastVoteCodes
+	}
+	return nil
 }
 
 // Data contains the set of transactions included in the block
@@ -458,8 +466,8 @@ type Vote struct {
 	// Vote extension signature by the validator if they participated in
 	// consensus for the associated block.
 	// Only valid for precommit messages.
-	ExtensionSignature []byte `protobuf:"bytes,10,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
-	VoteCode           int64  `protobuf:"varint,11,opt,name=vote_code,json=voteCode,proto3" json:"vote_code,omitempty"`
+	ExtensionSignature []byte  `protobuf:"bytes,10,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
+	VoteCodes          []int64 `protobuf:"varint,11,rep,packed,name=vote_codes,json=voteCodes,proto3" json:"vote_codes,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -565,11 +573,11 @@ func (m *Vote) GetExtensionSignature() []byte {
 	return nil
 }
 
-func (m *Vote) GetVoteCode() int64 {
+func (m *Vote) GetVoteCodes() []int64 {
 	if m != nil {
-		return m.VoteCode
+		return m.VoteCodes
 	}
-	return 0
+	return nil
 }
 
 // Commit contains the evidence that a block was committed by a set of validators.
@@ -647,7 +655,7 @@ type CommitSig struct {
 	ValidatorAddress []byte      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Timestamp        time.Time   `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 	Signature        []byte      `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
-	VoteCode         int64       `protobuf:"varint,5,opt,name=vote_code,json=voteCode,proto3" json:"vote_code,omitempty"`
+	VoteCodes        []int64     `protobuf:"varint,5,rep,packed,name=vote_codes,json=voteCodes,proto3" json:"vote_codes,omitempty"`
 }
 
 func (m *CommitSig) Reset()         { *m = CommitSig{} }
@@ -711,11 +719,11 @@ func (m *CommitSig) GetSignature() []byte {
 	return nil
 }
 
-func (m *CommitSig) GetVoteCode() int64 {
+func (m *CommitSig) GetVoteCodes() []int64 {
 	if m != nil {
-		return m.VoteCode
+		return m.VoteCodes
 	}
-	return 0
+	return nil
 }
 
 type ExtendedCommit struct {
@@ -1222,91 +1230,92 @@ func init() {
 func init() { proto.RegisterFile("tendermint/types/types.proto", fileDescriptor_d3a6e55e2345de56) }
 
 var fileDescriptor_d3a6e55e2345de56 = []byte{
-	// 1341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xda, 0xeb, 0x5f, 0xcf, 0x76, 0xe2, 0x2c, 0x11, 0x75, 0xdd, 0xc6, 0xb1, 0x5c, 0x01,
-	0xa1, 0xa0, 0x4d, 0x95, 0x22, 0x04, 0x07, 0x0e, 0x89, 0x13, 0xda, 0x88, 0x3a, 0xb1, 0xd6, 0x6e,
-	0x11, 0xbd, 0xac, 0xd6, 0xde, 0x89, 0xbd, 0xd4, 0xde, 0x59, 0xed, 0x8e, 0x83, 0xd3, 0x2b, 0x17,
-	0xd4, 0x53, 0x4f, 0xdc, 0x7a, 0x82, 0x03, 0x77, 0xf8, 0x03, 0x10, 0xa7, 0x1e, 0x7b, 0x40, 0x82,
-	0x53, 0x81, 0xf4, 0xbf, 0xe0, 0x84, 0xe6, 0xcd, 0xec, 0xfa, 0x57, 0x0c, 0x55, 0x54, 0x81, 0xc4,
-	0x65, 0xb5, 0xf3, 0xde, 0xf7, 0xde, 0xbc, 0x79, 0xdf, 0xb7, 0xa3, 0xb7, 0x70, 0x95, 0x11, 0xd7,
-	0x26, 0xfe, 0xc0, 0x71, 0xd9, 0x16, 0x3b, 0xf5, 0x48, 0x20, 0x9e, 0xba, 0xe7, 0x53, 0x46, 0xb5,
-	0xc2, 0xd8, 0xab, 0xa3, 0xbd, 0xb4, 0xd6, 0xa5, 0x5d, 0x8a, 0xce, 0x2d, 0xfe, 0x26, 0x70, 0xa5,
-	0x8d, 0x2e, 0xa5, 0xdd, 0x3e, 0xd9, 0xc2, 0x55, 0x7b, 0x78, 0xbc, 0xc5, 0x9c, 0x01, 0x09, 0x98,
-	0x35, 0xf0, 0x24, 0x60, 0x7d, 0x62, 0x9b, 0x8e, 0x7f, 0xea, 0x31, 0xca, 0xb1, 0xf4, 0x58, 0xba,
-	0xcb, 0x13, 0xee, 0x13, 0xe2, 0x07, 0x0e, 0x75, 0x27, 0xeb, 0x28, 0x55, 0xe6, 0xaa, 0x3c, 0xb1,
-	0xfa, 0x8e, 0x6d, 0x31, 0xea, 0x0b, 0x44, 0xf5, 0x43, 0xc8, 0x37, 0x2c, 0x9f, 0x35, 0x09, 0xbb,
-	0x4d, 0x2c, 0x9b, 0xf8, 0xda, 0x1a, 0x24, 0x18, 0x65, 0x56, 0xbf, 0xa8, 0x54, 0x94, 0xcd, 0xbc,
-	0x21, 0x16, 0x9a, 0x06, 0x6a, 0xcf, 0x0a, 0x7a, 0xc5, 0x58, 0x45, 0xd9, 0xcc, 0x19, 0xf8, 0x5e,
-	0xed, 0x81, 0xca, 0x43, 0x79, 0x84, 0xe3, 0xda, 0x64, 0x14, 0x46, 0xe0, 0x82, 0x5b, 0xdb, 0xa7,
-	0x8c, 0x04, 0x32, 0x44, 0x2c, 0xb4, 0xf7, 0x20, 0x81, 0xf5, 0x17, 0xe3, 0x15, 0x65, 0x33, 0xbb,
-	0x5d, 0xd4, 0x27, 0x1a, 0x25, 0xce, 0xa7, 0x37, 0xb8, 0x7f, 0x57, 0x7d, 0xfa, 0x7c, 0x63, 0xc9,
-	0x10, 0xe0, 0x6a, 0x1f, 0x52, 0xbb, 0x7d, 0xda, 0x79, 0x70, 0xb0, 0x17, 0x15, 0xa2, 0x8c, 0x0b,
-	0xd1, 0xea, 0xb0, 0xe2, 0x59, 0x3e, 0x33, 0x03, 0xc2, 0xcc, 0x1e, 0x9e, 0x02, 0x37, 0xcd, 0x6e,
-	0x6f, 0xe8, 0xb3, 0x3c, 0xe8, 0x53, 0x87, 0x95, 0xbb, 0xe4, 0xbd, 0x49, 0x63, 0xf5, 0xcb, 0x04,
-	0x24, 0x65, 0x33, 0x3e, 0x82, 0x94, 0x6c, 0x2b, 0x6e, 0x98, 0xdd, 0x5e, 0x9f, 0xcc, 0x28, 0x5d,
-	0x7a, 0x8d, 0xba, 0x01, 0x71, 0x83, 0x61, 0x20, 0xf3, 0x85, 0x31, 0xda, 0x9b, 0x90, 0xee, 0xf4,
-	0x2c, 0xc7, 0x35, 0x1d, 0x1b, 0x2b, 0xca, 0xec, 0x66, 0xcf, 0x9e, 0x6f, 0xa4, 0x6a, 0xdc, 0x76,
-	0xb0, 0x67, 0xa4, 0xd0, 0x79, 0x60, 0x6b, 0xaf, 0x43, 0xb2, 0x47, 0x9c, 0x6e, 0x8f, 0x61, 0x5b,
-	0xe2, 0x86, 0x5c, 0x69, 0x1f, 0x80, 0xca, 0x05, 0x51, 0x54, 0x71, 0xef, 0x92, 0x2e, 0xd4, 0xa2,
-	0x87, 0x6a, 0xd1, 0x5b, 0xa1, 0x5a, 0x76, 0xd3, 0x7c, 0xe3, 0xc7, 0xbf, 0x6d, 0x28, 0x06, 0x46,
-	0x68, 0x35, 0xc8, 0xf7, 0xad, 0x80, 0x99, 0x6d, 0xde, 0x36, 0xbe, 0x7d, 0x02, 0x53, 0x5c, 0x9e,
-	0x6f, 0x88, 0x6c, 0xac, 0x2c, 0x3d, 0xcb, 0xa3, 0x84, 0xc9, 0xd6, 0x36, 0xa1, 0x80, 0x49, 0x3a,
-	0x74, 0x30, 0x70, 0x98, 0x89, 0x7d, 0x4f, 0x62, 0xdf, 0x97, 0xb9, 0xbd, 0x86, 0xe6, 0xdb, 0x9c,
-	0x81, 0x2b, 0x90, 0xb1, 0x2d, 0x66, 0x09, 0x48, 0x0a, 0x21, 0x69, 0x6e, 0x40, 0xe7, 0x5b, 0xb0,
-	0x12, 0xa9, 0x2e, 0x10, 0x90, 0xb4, 0xc8, 0x32, 0x36, 0x23, 0xf0, 0x06, 0xac, 0xb9, 0x64, 0xc4,
-	0xcc, 0x59, 0x74, 0x06, 0xd1, 0x1a, 0xf7, 0xdd, 0x9b, 0x8e, 0x78, 0x03, 0x96, 0x3b, 0x61, 0xf3,
-	0x05, 0x16, 0x10, 0x9b, 0x8f, 0xac, 0x08, 0xbb, 0x0c, 0x69, 0xcb, 0xf3, 0x04, 0x20, 0x8b, 0x80,
-	0x94, 0xe5, 0x79, 0xe8, 0xba, 0x0e, 0xab, 0x78, 0x46, 0x9f, 0x04, 0xc3, 0x3e, 0x93, 0x49, 0x72,
-	0x88, 0x59, 0xe1, 0x0e, 0x43, 0xd8, 0x11, 0x7b, 0x0d, 0xf2, 0xe4, 0xc4, 0xb1, 0x89, 0xdb, 0x21,
-	0x02, 0x97, 0x47, 0x5c, 0x2e, 0x34, 0x22, 0xe8, 0x6d, 0x28, 0x78, 0x3e, 0xf5, 0x68, 0x40, 0x7c,
-	0xd3, 0xb2, 0x6d, 0x9f, 0x04, 0x41, 0x71, 0x59, 0xe4, 0x0b, 0xed, 0x3b, 0xc2, 0xcc, 0xbb, 0x76,
-	0x42, 0x19, 0x31, 0x3b, 0xd4, 0x26, 0xc5, 0x15, 0x64, 0x3e, 0xcd, 0x0d, 0x35, 0x6a, 0x93, 0x6a,
-	0x11, 0xd4, 0x3d, 0x8b, 0x59, 0x5a, 0x01, 0xe2, 0x6c, 0x14, 0x14, 0x95, 0x4a, 0x7c, 0x33, 0x67,
-	0xf0, 0xd7, 0xea, 0xcf, 0x71, 0x50, 0xef, 0x51, 0x46, 0xb4, 0x9b, 0xa0, 0x72, 0x0e, 0x51, 0x9a,
-	0xcb, 0xe7, 0x89, 0xbd, 0xe9, 0x74, 0x5d, 0x62, 0xd7, 0x83, 0x6e, 0xeb, 0xd4, 0x23, 0x06, 0x82,
-	0x27, 0xb4, 0x16, 0x9b, 0xd2, 0xda, 0x1a, 0x24, 0x7c, 0x3a, 0x74, 0x6d, 0x94, 0x60, 0xc2, 0x10,
-	0x0b, 0x6d, 0x1f, 0xd2, 0x91, 0x84, 0xd4, 0x7f, 0x92, 0xd0, 0x0a, 0x97, 0x10, 0x17, 0xb8, 0x34,
-	0x18, 0xa9, 0xb6, 0x54, 0xd2, 0x2e, 0x64, 0xa2, 0x9b, 0x4d, 0x4a, 0xf1, 0xe5, 0xd4, 0x3c, 0x0e,
-	0xd3, 0xde, 0x81, 0xd5, 0x48, 0x18, 0x51, 0x67, 0x85, 0x1c, 0x0b, 0x91, 0x23, 0x6c, 0xed, 0xa4,
-	0xe6, 0x4c, 0x71, 0x3b, 0xa5, 0xf0, 0x5c, 0x63, 0xcd, 0x1d, 0xe0, 0x35, 0x75, 0x15, 0x32, 0x81,
-	0xd3, 0x75, 0x2d, 0x36, 0xf4, 0x89, 0x94, 0xe5, 0xd8, 0xc0, 0xbd, 0x64, 0xc4, 0x88, 0x8b, 0x37,
-	0x80, 0x90, 0xe1, 0xd8, 0xa0, 0x6d, 0xc1, 0x6b, 0xd1, 0xc2, 0x1c, 0x67, 0x11, 0x12, 0xd4, 0x22,
-	0x57, 0x33, 0x4a, 0x37, 0x45, 0x78, 0x76, 0x86, 0xf0, 0x1f, 0x15, 0x48, 0x8a, 0x4f, 0x6a, 0x82,
-	0x23, 0xe5, 0x7c, 0x8e, 0x62, 0x8b, 0x38, 0x8a, 0x5f, 0x9c, 0xa3, 0x1d, 0x80, 0xe8, 0x0c, 0x41,
-	0x51, 0xad, 0xc4, 0x37, 0xb3, 0xdb, 0x57, 0xe6, 0x13, 0x89, 0x12, 0x9b, 0x4e, 0x57, 0xde, 0x18,
-	0x13, 0x41, 0xd5, 0x3f, 0x15, 0xc8, 0x44, 0x7e, 0x6d, 0x07, 0xf2, 0x61, 0x5d, 0xe6, 0x71, 0xdf,
-	0xea, 0x4a, 0x9d, 0xae, 0x2f, 0x2c, 0xee, 0xe3, 0xbe, 0xd5, 0x35, 0xb2, 0xb2, 0x1e, 0xbe, 0x38,
-	0x9f, 0xf3, 0xd8, 0x02, 0xce, 0xa7, 0x44, 0x16, 0xbf, 0x98, 0xc8, 0xa6, 0xe4, 0xa0, 0xce, 0xca,
-	0x61, 0x8a, 0xbf, 0xc4, 0x0c, 0x7f, 0x7f, 0x28, 0xb0, 0xbc, 0x3f, 0xc2, 0xb3, 0xd9, 0xff, 0x25,
-	0x8f, 0xf7, 0xa5, 0x2a, 0x6d, 0x62, 0x9b, 0x73, 0x84, 0x5e, 0x9b, 0xcf, 0x38, 0x5d, 0xf3, 0x98,
-	0x58, 0x2d, 0xcc, 0xd2, 0x1c, 0x13, 0xfc, 0x43, 0x0c, 0x56, 0xe7, 0xf0, 0xff, 0x43, 0xa2, 0xa7,
-	0xbe, 0xfb, 0xc4, 0x4b, 0x7e, 0xf7, 0xc9, 0x45, 0xdf, 0x7d, 0xf5, 0xfb, 0x18, 0xa4, 0x1b, 0x78,
-	0xf9, 0x5b, 0xfd, 0x7f, 0xe3, 0xd6, 0xbe, 0x02, 0x19, 0x8f, 0xf6, 0x4d, 0xe1, 0x51, 0xd1, 0x93,
-	0xf6, 0x68, 0xdf, 0x98, 0x93, 0x59, 0xe2, 0x15, 0x5d, 0xe9, 0xc9, 0x57, 0x40, 0x42, 0x6a, 0x86,
-	0x84, 0xaa, 0x0f, 0x39, 0xd1, 0x0a, 0x39, 0x8c, 0xdd, 0xe0, 0x3d, 0xc0, 0xe9, 0x4e, 0x99, 0x1f,
-	0x1e, 0x45, 0xd9, 0x02, 0x69, 0x48, 0x1c, 0x8f, 0x10, 0xb3, 0x8b, 0x9c, 0x07, 0x8b, 0x8b, 0xae,
-	0x33, 0x43, 0xe2, 0xaa, 0x5f, 0x2b, 0x00, 0x77, 0x78, 0x67, 0xf1, 0xbc, 0x7c, 0x8c, 0x0a, 0xb0,
-	0x04, 0x73, 0x6a, 0xe7, 0xf2, 0x22, 0xd2, 0xe4, 0xfe, 0xb9, 0x60, 0xb2, 0xee, 0x1a, 0xe4, 0xc7,
-	0xda, 0x0e, 0x48, 0x58, 0xcc, 0x39, 0x49, 0xa2, 0xe9, 0xa6, 0x49, 0x98, 0x91, 0x3b, 0x99, 0x58,
-	0x55, 0x7f, 0x52, 0x20, 0x83, 0x35, 0xd5, 0x09, 0xb3, 0xa6, 0x38, 0x54, 0x2e, 0xce, 0xe1, 0x3a,
-	0x80, 0x48, 0x13, 0x38, 0x0f, 0x89, 0x54, 0x56, 0x06, 0x2d, 0x4d, 0xe7, 0x21, 0xd1, 0xde, 0x8f,
-	0x1a, 0x1e, 0xff, 0xfb, 0x86, 0xcb, 0x1b, 0x23, 0x6c, 0xfb, 0x25, 0x48, 0xb9, 0xc3, 0x81, 0xc9,
-	0xc7, 0x16, 0x55, 0xa8, 0xd5, 0x1d, 0x0e, 0x5a, 0xa3, 0xa0, 0xfa, 0x39, 0xa4, 0x5a, 0x23, 0x9c,
-	0xef, 0xb9, 0x44, 0x7d, 0x4a, 0xe5, 0x50, 0x29, 0x86, 0xf9, 0x34, 0x37, 0xe0, 0x0c, 0xa5, 0x81,
-	0xca, 0xa7, 0xc7, 0xf0, 0x6f, 0x83, 0xbf, 0x6b, 0xfa, 0x4b, 0xfe, 0x39, 0xc8, 0x7f, 0x86, 0xeb,
-	0xbf, 0x28, 0x90, 0x9f, 0xfa, 0x92, 0xb4, 0x77, 0xe1, 0x52, 0xf3, 0xe0, 0xd6, 0xe1, 0xfe, 0x9e,
-	0x59, 0x6f, 0xde, 0x32, 0x5b, 0x9f, 0x35, 0xf6, 0xcd, 0xbb, 0x87, 0x9f, 0x1c, 0x1e, 0x7d, 0x7a,
-	0x58, 0x58, 0x2a, 0xad, 0x3c, 0x7a, 0x52, 0xc9, 0xde, 0x75, 0x1f, 0xb8, 0xf4, 0x0b, 0x77, 0x11,
-	0xba, 0x61, 0xec, 0xdf, 0x3b, 0x6a, 0xed, 0x17, 0x14, 0x81, 0x6e, 0xf8, 0x84, 0x5f, 0xfe, 0x88,
-	0xbe, 0x01, 0x97, 0xcf, 0x41, 0xd7, 0x8e, 0xea, 0xf5, 0x83, 0x56, 0x21, 0x56, 0x5a, 0x7d, 0xf4,
-	0xa4, 0x92, 0x6f, 0xf8, 0x44, 0xa8, 0x0c, 0x23, 0x74, 0x28, 0xce, 0x47, 0x1c, 0x35, 0x8e, 0x9a,
-	0x3b, 0x77, 0x0a, 0x95, 0x52, 0xe1, 0xd1, 0x93, 0x4a, 0x2e, 0xbc, 0x32, 0x38, 0xbe, 0x94, 0xfe,
-	0xea, 0x9b, 0xf2, 0xd2, 0x77, 0xdf, 0x96, 0x95, 0xdd, 0xfa, 0xd3, 0xb3, 0xb2, 0xf2, 0xec, 0xac,
-	0xac, 0xfc, 0x7e, 0x56, 0x56, 0x1e, 0xbf, 0x28, 0x2f, 0x3d, 0x7b, 0x51, 0x5e, 0xfa, 0xf5, 0x45,
-	0x79, 0xe9, 0xfe, 0xcd, 0xae, 0xc3, 0x7a, 0xc3, 0xb6, 0xde, 0xa1, 0x83, 0xad, 0x0e, 0x1d, 0x10,
-	0xd6, 0x3e, 0x66, 0xe3, 0x17, 0xf1, 0xf7, 0x39, 0xfb, 0x47, 0xd8, 0x4e, 0xa2, 0xfd, 0xe6, 0x5f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x39, 0xce, 0x93, 0xd2, 0x0e, 0x00, 0x00,
+	// 1355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0xeb, 0x7f, 0xcf, 0x76, 0xe2, 0x0c, 0x11, 0xdd, 0xba, 0x8d, 0x63, 0xb9, 0xa2,
+	0x84, 0x82, 0x9c, 0x2a, 0x45, 0x08, 0x0e, 0x1c, 0x12, 0x27, 0xb4, 0x11, 0x75, 0x62, 0xad, 0xdd,
+	0x22, 0x7a, 0x59, 0xad, 0xbd, 0x13, 0x7b, 0xa9, 0xbd, 0xb3, 0xda, 0x1d, 0x07, 0xa7, 0x9f, 0x00,
+	0xf5, 0xd4, 0x13, 0xb7, 0x9e, 0xe0, 0xc0, 0x85, 0x13, 0x7c, 0x00, 0xc4, 0xa9, 0xc7, 0x9e, 0x80,
+	0x53, 0x81, 0xf4, 0x6b, 0x70, 0x40, 0xf3, 0x66, 0x77, 0xfd, 0x2f, 0x86, 0x52, 0x55, 0x20, 0x71,
+	0xb1, 0x66, 0xde, 0xfb, 0xbd, 0x37, 0x6f, 0xde, 0xef, 0x37, 0xe3, 0x59, 0xb8, 0xcc, 0xa9, 0x63,
+	0x51, 0x6f, 0x60, 0x3b, 0x7c, 0x8b, 0x9f, 0xba, 0xd4, 0x97, 0xbf, 0x55, 0xd7, 0x63, 0x9c, 0x91,
+	0xc2, 0xd8, 0x5b, 0x45, 0x7b, 0x71, 0xad, 0xcb, 0xba, 0x0c, 0x9d, 0x5b, 0x62, 0x24, 0x71, 0xc5,
+	0x8d, 0x2e, 0x63, 0xdd, 0x3e, 0xdd, 0xc2, 0x59, 0x7b, 0x78, 0xbc, 0xc5, 0xed, 0x01, 0xf5, 0xb9,
+	0x39, 0x70, 0x03, 0xc0, 0xfa, 0xc4, 0x32, 0x1d, 0xef, 0xd4, 0xe5, 0x4c, 0x60, 0xd9, 0x71, 0xe0,
+	0x2e, 0x4d, 0xb8, 0x4f, 0xa8, 0xe7, 0xdb, 0xcc, 0x99, 0xac, 0xa3, 0x58, 0x9e, 0xab, 0xf2, 0xc4,
+	0xec, 0xdb, 0x96, 0xc9, 0x99, 0x27, 0x11, 0x95, 0x0f, 0x20, 0xdf, 0x30, 0x3d, 0xde, 0xa4, 0xfc,
+	0x16, 0x35, 0x2d, 0xea, 0x91, 0x35, 0x48, 0x70, 0xc6, 0xcd, 0xbe, 0xa6, 0x94, 0x95, 0xcd, 0xbc,
+	0x2e, 0x27, 0x84, 0x80, 0xda, 0x33, 0xfd, 0x9e, 0x16, 0x2b, 0x2b, 0x9b, 0x39, 0x1d, 0xc7, 0x95,
+	0x1e, 0xa8, 0x22, 0x54, 0x44, 0xd8, 0x8e, 0x45, 0x47, 0x61, 0x04, 0x4e, 0x84, 0xb5, 0x7d, 0xca,
+	0xa9, 0x1f, 0x84, 0xc8, 0x09, 0x79, 0x17, 0x12, 0x58, 0xbf, 0x16, 0x2f, 0x2b, 0x9b, 0xd9, 0x6d,
+	0xad, 0x3a, 0xd1, 0x28, 0xb9, 0xbf, 0x6a, 0x43, 0xf8, 0x77, 0xd5, 0x27, 0xcf, 0x36, 0x96, 0x74,
+	0x09, 0xae, 0xf4, 0x21, 0xb5, 0xdb, 0x67, 0x9d, 0xfb, 0x07, 0x7b, 0x51, 0x21, 0xca, 0xb8, 0x10,
+	0x52, 0x87, 0x15, 0xd7, 0xf4, 0xb8, 0xe1, 0x53, 0x6e, 0xf4, 0x70, 0x17, 0xb8, 0x68, 0x76, 0x7b,
+	0xa3, 0x3a, 0xcb, 0x43, 0x75, 0x6a, 0xb3, 0xc1, 0x2a, 0x79, 0x77, 0xd2, 0x58, 0xf9, 0x36, 0x01,
+	0x49, 0x39, 0x24, 0x1f, 0x42, 0x2a, 0x68, 0x2b, 0x2e, 0x98, 0xdd, 0x5e, 0x9f, 0xcc, 0x18, 0xb8,
+	0xaa, 0x35, 0xe6, 0xf8, 0xd4, 0xf1, 0x87, 0x7e, 0x90, 0x2f, 0x8c, 0x21, 0x57, 0x21, 0xdd, 0xe9,
+	0x99, 0xb6, 0x63, 0xd8, 0x16, 0x56, 0x94, 0xd9, 0xcd, 0x9e, 0x3d, 0xdb, 0x48, 0xd5, 0x84, 0xed,
+	0x60, 0x4f, 0x4f, 0xa1, 0xf3, 0xc0, 0x22, 0xaf, 0x43, 0xb2, 0x47, 0xed, 0x6e, 0x8f, 0x63, 0x5b,
+	0xe2, 0x7a, 0x30, 0x23, 0xef, 0x83, 0x2a, 0x04, 0xa1, 0xa9, 0xb8, 0x76, 0xb1, 0x2a, 0xd5, 0x52,
+	0x0d, 0xd5, 0x52, 0x6d, 0x85, 0x6a, 0xd9, 0x4d, 0x8b, 0x85, 0x1f, 0xfd, 0xba, 0xa1, 0xe8, 0x18,
+	0x41, 0x6a, 0x90, 0xef, 0x9b, 0x3e, 0x37, 0xda, 0xa2, 0x6d, 0x62, 0xf9, 0x04, 0xa6, 0xb8, 0x38,
+	0xdf, 0x90, 0xa0, 0xb1, 0x41, 0xe9, 0x59, 0x11, 0x25, 0x4d, 0x16, 0xd9, 0x84, 0x02, 0x26, 0xe9,
+	0xb0, 0xc1, 0xc0, 0xe6, 0x06, 0xf6, 0x3d, 0x89, 0x7d, 0x5f, 0x16, 0xf6, 0x1a, 0x9a, 0x6f, 0x09,
+	0x06, 0x2e, 0x41, 0xc6, 0x32, 0xb9, 0x29, 0x21, 0x29, 0x84, 0xa4, 0x85, 0x01, 0x9d, 0x6f, 0xc2,
+	0x4a, 0xa4, 0x3a, 0x5f, 0x42, 0xd2, 0x32, 0xcb, 0xd8, 0x8c, 0xc0, 0xeb, 0xb0, 0xe6, 0xd0, 0x11,
+	0x37, 0x66, 0xd1, 0x19, 0x44, 0x13, 0xe1, 0xbb, 0x3b, 0x1d, 0xf1, 0x06, 0x2c, 0x77, 0xc2, 0xe6,
+	0x4b, 0x2c, 0x20, 0x36, 0x1f, 0x59, 0x11, 0x76, 0x11, 0xd2, 0xa6, 0xeb, 0x4a, 0x40, 0x16, 0x01,
+	0x29, 0xd3, 0x75, 0xd1, 0x75, 0x0d, 0x56, 0x71, 0x8f, 0x1e, 0xf5, 0x87, 0x7d, 0x1e, 0x24, 0xc9,
+	0x21, 0x66, 0x45, 0x38, 0x74, 0x69, 0x47, 0xec, 0x15, 0xc8, 0xd3, 0x13, 0xdb, 0xa2, 0x4e, 0x87,
+	0x4a, 0x5c, 0x1e, 0x71, 0xb9, 0xd0, 0x88, 0xa0, 0xb7, 0xa0, 0xe0, 0x7a, 0xcc, 0x65, 0x3e, 0xf5,
+	0x0c, 0xd3, 0xb2, 0x3c, 0xea, 0xfb, 0xda, 0xb2, 0xcc, 0x17, 0xda, 0x77, 0xa4, 0x99, 0xac, 0x03,
+	0x9c, 0x30, 0x4e, 0x8d, 0x0e, 0xb3, 0xa8, 0xaf, 0xad, 0x94, 0xe3, 0x9b, 0x71, 0x3d, 0x23, 0x2c,
+	0x35, 0x61, 0x20, 0x57, 0x01, 0x2b, 0x30, 0x26, 0x30, 0x05, 0xc4, 0x20, 0xb5, 0x77, 0x43, 0x5c,
+	0x45, 0x03, 0x75, 0xcf, 0xe4, 0x26, 0x29, 0x40, 0x9c, 0x8f, 0x7c, 0x4d, 0x29, 0xc7, 0x37, 0x73,
+	0xba, 0x18, 0x56, 0x7e, 0x8a, 0x83, 0x2a, 0x70, 0xe4, 0x06, 0xa8, 0x82, 0x6d, 0x14, 0xf1, 0xf2,
+	0x79, 0xc7, 0xa2, 0x69, 0x77, 0x1d, 0x6a, 0xd5, 0xfd, 0x6e, 0xeb, 0xd4, 0xa5, 0x3a, 0x82, 0x27,
+	0x54, 0x19, 0x9b, 0x52, 0xe5, 0x1a, 0x24, 0x3c, 0x36, 0x74, 0x2c, 0x14, 0x6b, 0x42, 0x97, 0x13,
+	0xb2, 0x0f, 0xe9, 0x48, 0x6c, 0xea, 0xdf, 0x89, 0x6d, 0x45, 0x88, 0x4d, 0x1c, 0x85, 0xc0, 0xa0,
+	0xa7, 0xda, 0x81, 0xe6, 0x76, 0x21, 0x13, 0xdd, 0x81, 0x5a, 0xe2, 0x1f, 0xe8, 0x7e, 0x1c, 0x46,
+	0xde, 0x86, 0xd5, 0x48, 0x42, 0x11, 0x07, 0x52, 0xb8, 0x85, 0xc8, 0x11, 0x92, 0x30, 0xa9, 0x4e,
+	0x43, 0xde, 0x63, 0x29, 0xdc, 0xd7, 0x58, 0x9d, 0x07, 0xc2, 0x4a, 0x2e, 0x43, 0xc6, 0xb7, 0xbb,
+	0x8e, 0xc9, 0x87, 0x1e, 0x0d, 0x04, 0x3c, 0x36, 0x08, 0x2f, 0x1d, 0x71, 0xea, 0xe0, 0x5d, 0x21,
+	0x05, 0x3b, 0x36, 0x90, 0x2d, 0x78, 0x2d, 0x9a, 0x18, 0xe3, 0x2c, 0x52, 0xac, 0x24, 0x72, 0x35,
+	0xa3, 0x74, 0xd3, 0xd2, 0xc8, 0xce, 0x48, 0xa3, 0xf2, 0x83, 0x02, 0x49, 0x79, 0xfc, 0x26, 0x58,
+	0x52, 0xce, 0x67, 0x29, 0xb6, 0x88, 0xa5, 0xf8, 0xcb, 0xb3, 0xb4, 0x03, 0x10, 0xed, 0xc2, 0xd7,
+	0xd4, 0x72, 0x7c, 0x33, 0xbb, 0x7d, 0x69, 0x3e, 0x91, 0x2c, 0xb1, 0x69, 0x77, 0x83, 0xdb, 0x65,
+	0x22, 0xa8, 0xf2, 0x87, 0x02, 0x99, 0xc8, 0x4f, 0x76, 0x20, 0x1f, 0xd6, 0x65, 0x1c, 0xf7, 0xcd,
+	0x6e, 0xa0, 0xd4, 0xf5, 0x85, 0xc5, 0x7d, 0xd4, 0x37, 0xbb, 0x7a, 0x36, 0xa8, 0x47, 0x4c, 0xce,
+	0x67, 0x3d, 0xb6, 0x80, 0xf5, 0x29, 0x99, 0xc5, 0x5f, 0x4e, 0x66, 0x53, 0x82, 0x50, 0x67, 0x05,
+	0x31, 0xcd, 0x60, 0x62, 0x96, 0xc1, 0xdf, 0x15, 0x58, 0xde, 0x1f, 0xe1, 0xee, 0xac, 0xff, 0x92,
+	0xc9, 0x7b, 0x81, 0x32, 0x2d, 0x6a, 0x19, 0x73, 0x94, 0x5e, 0x99, 0xcf, 0x38, 0x5d, 0xf3, 0x98,
+	0x5a, 0x12, 0x66, 0x69, 0x8e, 0x29, 0xfe, 0x3e, 0x06, 0xab, 0x73, 0xf8, 0xff, 0x21, 0xd5, 0x53,
+	0x67, 0x3f, 0xf1, 0x82, 0x67, 0x3f, 0xb9, 0xe8, 0xec, 0x57, 0xbe, 0x8b, 0x41, 0xba, 0x81, 0x7f,
+	0x15, 0x66, 0xff, 0xdf, 0xb8, 0xb9, 0x2f, 0x41, 0xc6, 0x65, 0x7d, 0x43, 0x7a, 0x54, 0xf4, 0xa4,
+	0x5d, 0xd6, 0xd7, 0xe7, 0x64, 0x96, 0x78, 0x45, 0xd7, 0x7a, 0xf2, 0x15, 0x90, 0x90, 0x9a, 0x21,
+	0xa1, 0xe2, 0x41, 0x4e, 0xb6, 0x22, 0x78, 0xba, 0x5d, 0x17, 0x3d, 0x10, 0x23, 0x4d, 0x99, 0x7f,
+	0x6a, 0xca, 0xb2, 0x25, 0x52, 0x4f, 0xf6, 0xa2, 0x08, 0xf9, 0xd2, 0xd1, 0x62, 0x8b, 0x22, 0xa4,
+	0x8a, 0xf5, 0x00, 0x57, 0xf9, 0x52, 0x01, 0xb8, 0x2d, 0x3a, 0x8b, 0xfb, 0x15, 0x8f, 0x2e, 0x1f,
+	0x4b, 0x30, 0xa6, 0x56, 0x2e, 0x2d, 0x22, 0x2d, 0x58, 0x3f, 0xe7, 0x4f, 0xd6, 0x5d, 0x83, 0xfc,
+	0x58, 0xdb, 0x3e, 0x0d, 0x8b, 0x39, 0x27, 0x49, 0xf4, 0x16, 0x6a, 0x52, 0xae, 0xe7, 0x4e, 0x26,
+	0x66, 0x95, 0x1f, 0x15, 0xc8, 0x60, 0x4d, 0x75, 0xca, 0xcd, 0x29, 0x0e, 0x95, 0x97, 0xe7, 0x70,
+	0x1d, 0x40, 0xa6, 0xf1, 0xed, 0x07, 0x34, 0x50, 0x56, 0x06, 0x2d, 0x4d, 0xfb, 0x01, 0x25, 0xef,
+	0x45, 0x0d, 0x8f, 0xff, 0x75, 0xc3, 0x83, 0x1b, 0x23, 0x6c, 0xfb, 0x05, 0x48, 0x39, 0xc3, 0x81,
+	0x21, 0x9e, 0x2e, 0xaa, 0x54, 0xab, 0x33, 0x1c, 0xb4, 0x46, 0x7e, 0xe5, 0x33, 0x48, 0xb5, 0x46,
+	0xf8, 0x35, 0x20, 0x24, 0xea, 0x31, 0x16, 0x3c, 0x41, 0xe5, 0xd3, 0x3f, 0x2d, 0x0c, 0xf8, 0xe2,
+	0x22, 0xa0, 0x8a, 0xb7, 0x66, 0xf8, 0x6d, 0x22, 0xc6, 0xa4, 0xfa, 0x82, 0xdf, 0x19, 0xc1, 0x17,
+	0xc6, 0xb5, 0x9f, 0x15, 0xc8, 0x4f, 0x9d, 0x24, 0xf2, 0x0e, 0x5c, 0x68, 0x1e, 0xdc, 0x3c, 0xdc,
+	0xdf, 0x33, 0xea, 0xcd, 0x9b, 0x46, 0xeb, 0xd3, 0xc6, 0xbe, 0x71, 0xe7, 0xf0, 0xe3, 0xc3, 0xa3,
+	0x4f, 0x0e, 0x0b, 0x4b, 0xc5, 0x95, 0x87, 0x8f, 0xcb, 0xd9, 0x3b, 0xce, 0x7d, 0x87, 0x7d, 0xee,
+	0x2c, 0x42, 0x37, 0xf4, 0xfd, 0xbb, 0x47, 0xad, 0xfd, 0x82, 0x22, 0xd1, 0x0d, 0x8f, 0x8a, 0xdb,
+	0x1f, 0xd1, 0xd7, 0xe1, 0xe2, 0x39, 0xe8, 0xda, 0x51, 0xbd, 0x7e, 0xd0, 0x2a, 0xc4, 0x8a, 0xab,
+	0x0f, 0x1f, 0x97, 0xf3, 0x0d, 0x8f, 0x4a, 0x95, 0x61, 0x44, 0x15, 0xb4, 0xf9, 0x88, 0xa3, 0xc6,
+	0x51, 0x73, 0xe7, 0x76, 0xa1, 0x5c, 0x2c, 0x3c, 0x7c, 0x5c, 0xce, 0x85, 0x57, 0x86, 0xc0, 0x17,
+	0xd3, 0x5f, 0x7c, 0x55, 0x5a, 0xfa, 0xe6, 0xeb, 0x92, 0xb2, 0x5b, 0x7f, 0x72, 0x56, 0x52, 0x9e,
+	0x9e, 0x95, 0x94, 0xdf, 0xce, 0x4a, 0xca, 0xa3, 0xe7, 0xa5, 0xa5, 0xa7, 0xcf, 0x4b, 0x4b, 0xbf,
+	0x3c, 0x2f, 0x2d, 0xdd, 0xbb, 0xd1, 0xb5, 0x79, 0x6f, 0xd8, 0xae, 0x76, 0xd8, 0x60, 0xab, 0xc3,
+	0x06, 0x94, 0xb7, 0x8f, 0xf9, 0x78, 0x20, 0xbf, 0x55, 0x67, 0xbf, 0x1f, 0xdb, 0x49, 0xb4, 0xdf,
+	0xf8, 0x73, 0x00, 0xe4, 0x2b, 0xdb, 0x02, 0x00, 0x0f, 0x00, 0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1449,10 +1458,45 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.VoteCode != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VoteCode))
+	if len(m.LastVoteCodes) > 0 {
+		dAtA16 := make([]byte, len(m.LastVoteCodes)*10)
+		var j16 int
+		for _, num1 := range m.LastVoteCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA16[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA16[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA16[:j16])
+		i = encodeVarintTypes(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.VoteCodes) > 0 {
+		dAtA15 := make([]byte, len(m.VoteCodes)*10)
+		var j15 int
+		for _, num1 := range m.VoteCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA15[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA15[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA15[:j15])
+		i = encodeVarintTypes(dAtA, i, uint64(j15))
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x7a
 	}
 	if len(m.ProposerAddress) > 0 {
 		i -= len(m.ProposerAddress)
@@ -1612,10 +1656,24 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.VoteCode != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VoteCode))
+	if len(m.VoteCodes) > 0 {
+		dAtA11 := make([]byte, len(m.VoteCodes)*10)
+		var j11 int
+		for _, num1 := range m.VoteCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA11[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA11[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA11[:j11])
+		i = encodeVarintTypes(dAtA, i, uint64(j11))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x5a
 	}
 	if len(m.ExtensionSignature) > 0 {
 		i -= len(m.ExtensionSignature)
@@ -1763,10 +1821,24 @@ func (m *CommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.VoteCode != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VoteCode))
+	if len(m.VoteCodes) > 0 {
+		dAtA5 := make([]byte, len(m.VoteCodes)*10)
+		var j5 int
+		for _, num1 := range m.VoteCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA5[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA5[:j5])
+		i = encodeVarintTypes(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
@@ -2298,8 +2370,19 @@ func (m *Header) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.VoteCode != 0 {
-		n += 1 + sovTypes(uint64(m.VoteCode))
+	if len(m.VoteCodes) > 0 {
+		l = 0
+		for _, e := range m.VoteCodes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.LastVoteCodes) > 0 {
+		l = 0
+		for _, e := range m.LastVoteCodes {
+			l += sovTypes(uint64(e))
+		}
+		n += 2 + sovTypes(uint64(l)) + l
 	}
 	return n
 }
@@ -2357,8 +2440,12 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.VoteCode != 0 {
-		n += 1 + sovTypes(uint64(m.VoteCode))
+	if len(m.VoteCodes) > 0 {
+		l = 0
+		for _, e := range m.VoteCodes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
 	}
 	return n
 }
@@ -2405,8 +2492,12 @@ func (m *CommitSig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.VoteCode != 0 {
-		n += 1 + sovTypes(uint64(m.VoteCode))
+	if len(m.VoteCodes) > 0 {
+		l = 0
+		for _, e := range m.VoteCodes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
 	}
 	return n
 }
@@ -3415,23 +3506,156 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteCode", wireType)
-			}
-			m.VoteCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VoteCodes = append(m.VoteCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoteCode |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VoteCodes) == 0 {
+					m.VoteCodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VoteCodes = append(m.VoteCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCodes", wireType)
+			}
+		case 16:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LastVoteCodes = append(m.LastVoteCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
 				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LastVoteCodes) == 0 {
+					m.LastVoteCodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LastVoteCodes = append(m.LastVoteCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVoteCodes", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -3844,23 +4068,80 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteCode", wireType)
-			}
-			m.VoteCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VoteCodes = append(m.VoteCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoteCode |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VoteCodes) == 0 {
+					m.VoteCodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VoteCodes = append(m.VoteCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCodes", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -4188,23 +4469,80 @@ func (m *CommitSig) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteCode", wireType)
-			}
-			m.VoteCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VoteCodes = append(m.VoteCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoteCode |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VoteCodes) == 0 {
+					m.VoteCodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VoteCodes = append(m.VoteCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCodes", wireType)
 			}
 		default:
 			iNdEx = preIndex
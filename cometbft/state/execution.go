@@ -101,6 +101,7 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	ctx context.Context,
 	height int64,
+	round int32,
 	state State,
 	lastExtCommit *types.ExtendedCommit,
 	proposerAddr []byte,
@@ -137,6 +138,7 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 			Time:               block.Time,
 			NextValidatorsHash: block.NextValidatorsHash,
 			ProposerAddress:    block.ProposerAddress,
+			Round:              round,
 		},
 	)
 	if err != nil {
@@ -166,8 +168,9 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 func (blockExec *BlockExecutor) ProcessProposal(
 	block *types.Block,
+	round int32,
 	state State,
-) (bool, int64, error) {
+) (bool, []int64, error) {
 	resp, err := blockExec.proxyApp.ProcessProposal(context.TODO(), &abci.RequestProcessProposal{
 		Hash:               block.Header.Hash(),
 		Height:             block.Header.Height,
@@ -177,15 +180,16 @@ func (blockExec *BlockExecutor) ProcessProposal(
 		Misbehavior:        block.Evidence.Evidence.ToABCI(),
 		ProposerAddress:    block.ProposerAddress,
 		NextValidatorsHash: block.NextValidatorsHash,
+		Round:              round,
 	})
 	if err != nil {
-		return false, 0, err
+		return false, nil, err
 	}
 	if resp.IsStatusUnknown() {
 		panic(fmt.Sprintf("ProcessProposal responded with status %s", resp.Status.String()))
 	}
 
-	return resp.IsAccepted(), resp.VoteCode, nil
+	return resp.IsAccepted(), resp.VoteCodes, nil
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -235,7 +239,7 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 		DecidedLastCommit:  buildLastCommitInfoFromStore(block, blockExec.store, state.InitialHeight),
 		Misbehavior:        block.Evidence.Evidence.ToABCI(),
 		Txs:                block.Txs.ToSliceOfBytes(),
-		VoteCode:           block.VoteCode,
+		VoteCodes:          block.VoteCodes,
 	})
 	endTime := time.Now().UnixNano()
 	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
@@ -359,13 +363,20 @@ func (blockExec *BlockExecutor) ExtendVote(
 	return resp.VoteExtension, nil
 }
 
-func (blockExec *BlockExecutor) VerifyVoteExtension(ctx context.Context, vote *types.Vote) error {
+// VerifyVoteExtension asks the application to verify the extension of a
+// precommit. A precommit carries the tally of the vote codes, the
+// validator's own codes are those of its prevote for the block, which is
+// nil if we have not received it.
+func (blockExec *BlockExecutor) VerifyVoteExtension(ctx context.Context, vote *types.Vote, prevote *types.Vote) error {
 	req := abci.RequestVerifyVoteExtension{
 		Hash:             vote.BlockID.Hash,
 		ValidatorAddress: vote.ValidatorAddress,
 		Height:           vote.Height,
 		VoteExtension:    vote.Extension,
 	}
+	if prevote != nil {
+		req.VoteCodes = prevote.VoteCodes
+	}
 
 	resp, err := blockExec.proxyApp.VerifyVoteExtension(ctx, &req)
 	if err != nil {
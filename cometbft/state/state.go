@@ -258,10 +258,21 @@ func (state State) MakeBlock(
 		state.ConsensusParams.Hash(), state.AppHash, state.LastResultsHash,
 		proposerAddress,
 	)
+	block.LastVoteCodes = state.lastVoteCodes(height, lastCommit)
 
 	return block
 }
 
+// lastVoteCodes returns the vote codes a block at the given height commits to
+// in its header: the codes decided by lastCommit if vote codes were
+// authenticated at the previous height, and none otherwise.
+func (state State) lastVoteCodes(height int64, lastCommit *types.Commit) []int64 {
+	if height == state.InitialHeight || !state.ConsensusParams.ABCI.VoteCodeEnabled(height-1) {
+		return nil
+	}
+	return lastCommit.DecidedVoteCodes(state.LastValidators)
+}
+
 // MedianTime computes a median time for a given Commit (based on Timestamp field of votes messages) and the
 // corresponding validator set. The computed time is always between timestamps of
 // the votes sent by honest processes, i.e., a faulty processes can not arbitrarily increase or decrease the
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/types"
@@ -82,11 +83,21 @@ func validateBlock(state State, block *types.Block) error {
 		}
 	} else {
 		// LastCommit.Signatures length is checked in VerifyCommit.
+		lastCommit := block.LastCommit
+		if !state.ConsensusParams.ABCI.VoteCodeEnabled(block.Height - 1) {
+			lastCommit = lastCommit.WithoutVoteCodes()
+		}
 		if err := state.LastValidators.VerifyCommit(
-			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit); err != nil {
+			state.ChainID, state.LastBlockID, block.Height-1, lastCommit); err != nil {
 			return err
 		}
 	}
+	if lastVoteCodes := state.lastVoteCodes(block.Height, block.LastCommit); !slices.Equal(block.LastVoteCodes, lastVoteCodes) {
+		return fmt.Errorf("wrong Block.Header.LastVoteCodes. Expected %v, got %v",
+			lastVoteCodes,
+			block.LastVoteCodes,
+		)
+	}
 
 	// NOTE: We can't actually verify it's the right proposer because we don't
 	// know what round the block was first proposed. So just check that it's
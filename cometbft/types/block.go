@@ -354,8 +354,12 @@ type Header struct {
 	EvidenceHash    cmtbytes.HexBytes `json:"evidence_hash"`    // evidence included in the block
 	ProposerAddress Address           `json:"proposer_address"` // original proposer of the block
 
-	// vote code
-	VoteCode int64 `json:"vote_code"`
+	// vote codes, one per governance tx of the block. VoteCodes are decided
+	// from the precommits once the block hash has been voted on, so they are
+	// not part of the hash: the codes applied at this height are only
+	// authenticated by the LastVoteCodes of the next block.
+	VoteCodes     []int64 `json:"vote_codes"`      // decided for this block, not part of the hash
+	LastVoteCodes []int64 `json:"last_vote_codes"` // decided for the last block, see Commit.DecidedVoteCodes
 }
 
 // Populate the Header with state-derived data.
@@ -464,7 +468,7 @@ func (h *Header) Hash() cmtbytes.HexBytes {
 	if err != nil {
 		return nil
 	}
-	return merkle.HashFromByteSlices([][]byte{
+	fields := [][]byte{
 		hbz,
 		cdcEncode(h.ChainID),
 		cdcEncode(h.Height),
@@ -479,8 +483,14 @@ func (h *Header) Hash() cmtbytes.HexBytes {
 		cdcEncode(h.LastResultsHash),
 		cdcEncode(h.EvidenceHash),
 		cdcEncode(h.ProposerAddress),
-		// cdcEncode(h.VoteCode),
-	})
+	}
+	// The vote codes of this block are only decided once the block hash has
+	// been voted on, so the header commits to the codes decided for the last
+	// block. They are omitted when empty, which keeps the hash of legacy headers.
+	if len(h.LastVoteCodes) != 0 {
+		fields = append(fields, cdcEncode(h.LastVoteCodes))
+	}
+	return merkle.HashFromByteSlices(fields)
 }
 
 // StringIndented returns an indented string representation of the header.
@@ -503,7 +513,8 @@ func (h *Header) StringIndented(indent string) string {
 %s  Results:        %v
 %s  Evidence:       %v
 %s  Proposer:       %v
-%s  VoteCode:       %v
+%s  VoteCodes:      %v
+%s  LastVoteCodes:  %v
 %s}#%v`,
 		indent, h.Version,
 		indent, h.ChainID,
@@ -519,7 +530,8 @@ func (h *Header) StringIndented(indent string) string {
 		indent, h.LastResultsHash,
 		indent, h.EvidenceHash,
 		indent, h.ProposerAddress,
-		indent, h.VoteCode,
+		indent, h.VoteCodes,
+		indent, h.LastVoteCodes,
 		indent, h.Hash(),
 	)
 }
@@ -545,7 +557,8 @@ func (h *Header) ToProto() *cmtproto.Header {
 		LastResultsHash:    h.LastResultsHash,
 		LastCommitHash:     h.LastCommitHash,
 		ProposerAddress:    h.ProposerAddress,
-		VoteCode:           h.VoteCode,
+		VoteCodes:          h.VoteCodes,
+		LastVoteCodes:      h.LastVoteCodes,
 	}
 }
 
@@ -578,7 +591,8 @@ func HeaderFromProto(ph *cmtproto.Header) (Header, error) {
 	h.LastResultsHash = ph.LastResultsHash
 	h.LastCommitHash = ph.LastCommitHash
 	h.ProposerAddress = ph.ProposerAddress
-	h.VoteCode = ph.VoteCode
+	h.VoteCodes = ph.VoteCodes
+	h.LastVoteCodes = ph.LastVoteCodes
 
 	return *h, h.ValidateBasic()
 }
@@ -610,7 +624,7 @@ type CommitSig struct {
 	BlockIDFlag      BlockIDFlag `json:"block_id_flag"`
 	ValidatorAddress Address     `json:"validator_address"`
 	Timestamp        time.Time   `json:"timestamp"`
-	VoteCode         int64       `json:"vote_code"`
+	VoteCodes        []int64     `json:"vote_codes"`
 	Signature        []byte      `json:"signature"`
 }
 
@@ -640,7 +654,7 @@ func (cs CommitSig) String() string {
 		cmtbytes.Fingerprint(cs.ValidatorAddress),
 		cs.BlockIDFlag,
 		CanonicalTime(cs.Timestamp),
-		cs.VoteCode,
+		cs.VoteCodes,
 	)
 }
 
@@ -697,6 +711,9 @@ func (cs CommitSig) ValidateBasic() error {
 			return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
 		}
 	}
+	if len(cs.VoteCodes) > MaxVoteCodes {
+		return fmt.Errorf("too many vote codes (max: %d)", MaxVoteCodes)
+	}
 
 	return nil
 }
@@ -712,7 +729,7 @@ func (cs *CommitSig) ToProto() *cmtproto.CommitSig {
 		ValidatorAddress: cs.ValidatorAddress,
 		Timestamp:        cs.Timestamp,
 		Signature:        cs.Signature,
-		VoteCode:         cs.VoteCode,
+		VoteCodes:        cs.VoteCodes,
 	}
 }
 
@@ -723,7 +740,7 @@ func (cs *CommitSig) FromProto(csp cmtproto.CommitSig) error {
 	cs.ValidatorAddress = csp.ValidatorAddress
 	cs.Timestamp = csp.Timestamp
 	cs.Signature = csp.Signature
-	cs.VoteCode = csp.VoteCode
+	cs.VoteCodes = csp.VoteCodes
 
 	return cs.ValidateBasic()
 }
@@ -892,6 +909,7 @@ func (commit *Commit) GetVote(valIdx int32) *Vote {
 		Timestamp:        commitSig.Timestamp,
 		ValidatorAddress: commitSig.ValidatorAddress,
 		ValidatorIndex:   valIdx,
+		VoteCodes:        commitSig.VoteCodes,
 		Signature:        commitSig.Signature,
 	}
 }
@@ -899,8 +917,8 @@ func (commit *Commit) GetVote(valIdx int32) *Vote {
 // VoteSignBytes returns the bytes of the Vote corresponding to valIdx for
 // signing.
 //
-// The only unique part is the Timestamp - all other fields signed over are
-// otherwise the same for all validators.
+// The only unique parts are the Timestamp and the VoteCodes - all other fields
+// signed over are otherwise the same for all validators.
 //
 // Panics if valIdx >= commit.Size().
 //
@@ -910,6 +928,39 @@ func (commit *Commit) VoteSignBytes(chainID string, valIdx int32) []byte {
 	return VoteSignBytes(chainID, v)
 }
 
+// WithoutVoteCodes returns a copy of the commit with the vote codes of its
+// signatures cleared. Commits for heights at which vote codes are not part of
+// the sign bytes (see ABCIParams.VoteCodeEnableHeight) must be verified in
+// this form.
+func (commit *Commit) WithoutVoteCodes() *Commit {
+	commCopy := commit.Clone()
+	for i := range commCopy.Signatures {
+		commCopy.Signatures[i].VoteCodes = nil
+	}
+	commCopy.hash = nil
+	return commCopy
+}
+
+// DecidedVoteCodes returns, for each governance tx of the committed block, the
+// vote code signed by more than 2/3 of the voting power of vals, or
+// VoteCodeNoQuorum if no code reaches that quorum. vals must be the validator
+// set the commit was signed by.
+func (commit *Commit) DecidedVoteCodes(vals *ValidatorSet) []int64 {
+	quorum := vals.TotalVotingPower()*2/3 + 1
+	var tally voteCodeTally
+	for idx, commitSig := range commit.Signatures {
+		if commitSig.BlockIDFlag != BlockIDFlagCommit {
+			continue
+		}
+		_, val := vals.GetByIndex(int32(idx))
+		if val == nil {
+			continue
+		}
+		tally.add(commitSig.VoteCodes, val.VotingPower)
+	}
+	return tally.decide(quorum)
+}
+
 // Size returns the number of signatures in the commit.
 func (commit *Commit) Size() int {
 	if commit == nil {
@@ -1082,11 +1133,14 @@ func (ec *ExtendedCommit) Clone() *ExtendedCommit {
 }
 
 // ToExtendedVoteSet constructs a VoteSet from the Commit and validator set.
+// Vote codes are verified as part of the signatures if ap enables them at
+// the commit height.
 // Panics if signatures from the ExtendedCommit can't be added to the voteset.
 // Panics if any of the votes have invalid or absent vote extension data.
 // Inverse of VoteSet.MakeExtendedCommit().
-func (ec *ExtendedCommit) ToExtendedVoteSet(chainID string, vals *ValidatorSet) *VoteSet {
+func (ec *ExtendedCommit) ToExtendedVoteSet(chainID string, vals *ValidatorSet, ap ABCIParams) *VoteSet {
 	voteSet := NewExtendedVoteSet(chainID, ec.Height, ec.Round, cmtproto.PrecommitType, vals)
+	voteSet.SetVoteCodeEnabled(ap.VoteCodeEnabled(ec.Height))
 	ec.addSigsToVoteSet(voteSet)
 	return voteSet
 }
@@ -1109,10 +1163,13 @@ func (ec *ExtendedCommit) addSigsToVoteSet(voteSet *VoteSet) {
 }
 
 // ToVoteSet constructs a VoteSet from the Commit and validator set.
+// Vote codes are verified as part of the signatures if ap enables them at
+// the commit height.
 // Panics if signatures from the commit can't be added to the voteset.
 // Inverse of VoteSet.MakeCommit().
-func (commit *Commit) ToVoteSet(chainID string, vals *ValidatorSet) *VoteSet {
+func (commit *Commit) ToVoteSet(chainID string, vals *ValidatorSet, ap ABCIParams) *VoteSet {
 	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, cmtproto.PrecommitType, vals)
+	voteSet.SetVoteCodeEnabled(ap.VoteCodeEnabled(commit.Height))
 	for idx, cs := range commit.Signatures {
 		if cs.BlockIDFlag == BlockIDFlagAbsent {
 			continue // OK, some precommits can be missing.
@@ -1168,6 +1225,7 @@ func (ec *ExtendedCommit) GetExtendedVote(valIndex int32) *Vote {
 		Timestamp:          ecs.Timestamp,
 		ValidatorAddress:   ecs.ValidatorAddress,
 		ValidatorIndex:     valIndex,
+		VoteCodes:          ecs.VoteCodes,
 		Signature:          ecs.Signature,
 		Extension:          ecs.Extension,
 		ExtensionSignature: ecs.ExtensionSignature,
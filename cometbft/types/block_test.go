@@ -249,6 +249,36 @@ func TestCommit(t *testing.T) {
 	assert.True(t, extCommit.IsCommit())
 }
 
+func TestCommitDecidedVoteCodes(t *testing.T) {
+	lastID := makeBlockIDRandom()
+	h := int64(3)
+	voteSet, valSet, vals := randVoteSet(h-1, 1, cmtproto.PrecommitType, 10, 1, false)
+	extCommit, err := MakeExtCommit(lastID, h-1, 1, voteSet, vals, time.Now(), false)
+	require.NoError(t, err)
+	commit := extCommit.ToCommit()
+	assert.Empty(t, commit.DecidedVoteCodes(valSet))
+
+	// 7 of 10 is more than 2/3 of the voting power.
+	for i := 0; i < 7; i++ {
+		commit.Signatures[i].VoteCodes = []int64{201, 1000}
+	}
+	assert.Equal(t, []int64{201, 1000}, commit.DecidedVoteCodes(valSet))
+
+	// 6 of 10 is not, which only leaves the first tx undecided.
+	commit.Signatures[6].VoteCodes = []int64{203, 1000}
+	assert.Equal(t, []int64{VoteCodeNoQuorum, 1000}, commit.DecidedVoteCodes(valSet))
+
+	// a minority voting on more txs than the block has does not count.
+	commit.Signatures[7].VoteCodes = []int64{203, 1000, 202}
+	assert.Equal(t, []int64{VoteCodeNoQuorum, 1000}, commit.DecidedVoteCodes(valSet))
+
+	stripped := commit.WithoutVoteCodes()
+	for i := range stripped.Signatures {
+		assert.Empty(t, stripped.Signatures[i].VoteCodes)
+	}
+	assert.Equal(t, []int64{201, 1000}, commit.Signatures[0].VoteCodes)
+}
+
 func TestCommitValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName       string
@@ -337,6 +367,24 @@ func TestHeaderHash(t *testing.T) {
 			EvidenceHash:       tmhash.Sum([]byte("evidence_hash")),
 			ProposerAddress:    crypto.AddressHash([]byte("proposer_address")),
 		}, hexBytesFromString("F740121F553B5418C3EFBD343C2DBFE9E007BB67B0D020A0741374BAB65242A4")},
+		{"Generates expected hash with last vote codes", &Header{
+			Version:            cmtversion.Consensus{Block: 1, App: 2},
+			ChainID:            "chainId",
+			Height:             3,
+			Time:               time.Date(2019, 10, 13, 16, 14, 44, 0, time.UTC),
+			LastBlockID:        makeBlockID(make([]byte, tmhash.Size), 6, make([]byte, tmhash.Size)),
+			LastCommitHash:     tmhash.Sum([]byte("last_commit_hash")),
+			DataHash:           tmhash.Sum([]byte("data_hash")),
+			ValidatorsHash:     tmhash.Sum([]byte("validators_hash")),
+			NextValidatorsHash: tmhash.Sum([]byte("next_validators_hash")),
+			ConsensusHash:      tmhash.Sum([]byte("consensus_hash")),
+			AppHash:            tmhash.Sum([]byte("app_hash")),
+			LastResultsHash:    tmhash.Sum([]byte("last_results_hash")),
+			EvidenceHash:       tmhash.Sum([]byte("evidence_hash")),
+			ProposerAddress:    crypto.AddressHash([]byte("proposer_address")),
+			VoteCodes:          []int64{202},
+			LastVoteCodes:      []int64{201, 1000},
+		}, hexBytesFromString("BE940A73A5BAF1F09A58591A8C21679576BA63519A3E976998B97581F71510CF")},
 		{"nil header yields nil", nil, nil},
 		{"nil ValidatorsHash yields nil", &Header{
 			Version:            cmtversion.Consensus{Block: 1, App: 2},
@@ -369,11 +417,16 @@ func TestHeaderHash(t *testing.T) {
 				for i := 0; i < s.NumField(); i++ {
 					f := s.Field(i)
 
-					assert.False(t, f.IsZero(), "Found zero-valued field %v",
-						s.Type().Field(i).Name)
+					// VoteCodes are decided after the header is hashed, and
+					// LastVoteCodes are only hashed when set.
+					name := s.Type().Field(i).Name
+					if name == "VoteCodes" || (name == "LastVoteCodes" && f.IsZero()) {
+						continue
+					}
+					assert.False(t, f.IsZero(), "Found zero-valued field %v", name)
 
 					switch f := f.Interface().(type) {
-					case int64, bytes.HexBytes, string:
+					case int64, []int64, bytes.HexBytes, string:
 						byteSlices = append(byteSlices, cdcEncode(f))
 					case time.Time:
 						bz, err := gogotypes.StdTimeMarshal(f)
@@ -399,6 +452,17 @@ func TestHeaderHash(t *testing.T) {
 	}
 }
 
+func TestCdcEncodeVoteCodes(t *testing.T) {
+	// the count keeps vectors apart when encodings are concatenated.
+	assert.NotEqual(t,
+		append(cdcEncode([]int64{1}), cdcEncode([]int64{2})...),
+		append(cdcEncode([]int64{1, 2}), cdcEncode([]int64{})...))
+	// each code takes 8 bytes, negative ones included.
+	assert.Equal(t, []byte{2, 0xc9, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		cdcEncode([]int64{201, VoteCodeNoQuorum}))
+	assert.Nil(t, cdcEncode([]int64{}))
+}
+
 func TestMaxHeaderBytes(t *testing.T) {
 	// Construct a UTF-8 string of MaxChainIDLen length using the supplementary
 	// characters.
@@ -636,7 +700,7 @@ func TestExtendedCommitToVoteSet(t *testing.T) {
 			chainID := voteSet.ChainID()
 			var voteSet2 *VoteSet
 			if testCase.includeExtension {
-				voteSet2 = extCommit.ToExtendedVoteSet(chainID, valSet)
+				voteSet2 = extCommit.ToExtendedVoteSet(chainID, valSet, DefaultABCIParams())
 			} else {
 				voteSet2 = toVoteSet(extCommit, chainID, valSet)
 			}
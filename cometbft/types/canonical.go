@@ -53,7 +53,9 @@ func CanonicalizeProposal(chainID string, proposal *cmtproto.Proposal) cmtproto.
 
 // CanonicalizeVote transforms the given Vote to a CanonicalVote, which does
 // not contain ValidatorIndex and ValidatorAddress fields, or any fields
-// relating to vote extensions.
+// relating to vote extensions. The vote codes are part of the canonical vote,
+// packed as sfixed64; an empty vector is not encoded, so votes without codes
+// keep their legacy sign bytes.
 func CanonicalizeVote(chainID string, vote *cmtproto.Vote) cmtproto.CanonicalVote {
 	return cmtproto.CanonicalVote{
 		Type:      vote.Type,
@@ -62,6 +64,7 @@ func CanonicalizeVote(chainID string, vote *cmtproto.Vote) cmtproto.CanonicalVot
 		BlockID:   CanonicalizeBlockID(vote.BlockID),
 		Timestamp: vote.Timestamp,
 		ChainID:   chainID,
+		VoteCodes: vote.VoteCodes, // encoded as sfixed64
 	}
 }
 
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	gogotypes "github.com/cosmos/gogoproto/types"
 
 	"github.com/cometbft/cometbft/libs/bytes"
@@ -29,6 +31,14 @@ func cdcEncode(item interface{}) []byte {
 				return nil
 			}
 			return bz
+		case []int64:
+			// the element count, then each value as a little endian sfixed64
+			bz := make([]byte, 0, binary.MaxVarintLen64+len(item)*8)
+			bz = binary.AppendUvarint(bz, uint64(len(item)))
+			for _, v := range item {
+				bz = binary.LittleEndian.AppendUint64(bz, uint64(v))
+			}
+			return bz
 		case bytes.HexBytes:
 			i := gogotypes.BytesValue{
 				Value: item,
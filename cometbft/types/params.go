@@ -68,6 +68,7 @@ type VersionParams struct {
 // Interface.
 type ABCIParams struct {
 	VoteExtensionsEnableHeight int64 `json:"vote_extensions_enable_height"`
+	VoteCodeEnableHeight       int64 `json:"vote_code_enable_height"`
 }
 
 // VoteExtensionsEnabled returns true if vote extensions are enabled at height h
@@ -82,6 +83,18 @@ func (a ABCIParams) VoteExtensionsEnabled(h int64) bool {
 	return a.VoteExtensionsEnableHeight <= h
 }
 
+// VoteCodeEnabled returns true if vote codes are part of the vote sign bytes
+// at height h and false otherwise.
+func (a ABCIParams) VoteCodeEnabled(h int64) bool {
+	if h < 1 {
+		panic(fmt.Errorf("cannot check if vote codes enabled for height %d (< 1)", h))
+	}
+	if a.VoteCodeEnableHeight == 0 {
+		return false
+	}
+	return a.VoteCodeEnableHeight <= h
+}
+
 // DefaultConsensusParams returns a default ConsensusParams.
 func DefaultConsensusParams() *ConsensusParams {
 	return &ConsensusParams{
@@ -128,6 +141,8 @@ func DefaultABCIParams() ABCIParams {
 	return ABCIParams{
 		// When set to 0, vote extensions are not required.
 		VoteExtensionsEnableHeight: 0,
+		// New chains authenticate vote codes from the first height.
+		VoteCodeEnableHeight: 1,
 	}
 }
 
@@ -189,6 +204,10 @@ func (params ConsensusParams) ValidateBasic() error {
 		return fmt.Errorf("ABCI.VoteExtensionsEnableHeight cannot be negative. Got: %d", params.ABCI.VoteExtensionsEnableHeight)
 	}
 
+	if params.ABCI.VoteCodeEnableHeight < 0 {
+		return fmt.Errorf("ABCI.VoteCodeEnableHeight cannot be negative. Got: %d", params.ABCI.VoteCodeEnableHeight)
+	}
+
 	if len(params.Validator.PubKeyTypes) == 0 {
 		return errors.New("len(Validator.PubKeyTypes) must be greater than 0")
 	}
@@ -222,6 +241,9 @@ func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams,
 	if updated == nil || updated.Abci == nil {
 		return nil
 	}
+	if err := params.validateVoteCodeUpdate(updated.Abci.VoteCodeEnableHeight, h); err != nil {
+		return err
+	}
 	// 2
 	if updated.Abci.VoteExtensionsEnableHeight < 0 {
 		return errors.New("VoteExtensionsEnableHeight must be positive")
@@ -265,6 +287,33 @@ func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams,
 	return nil
 }
 
+// validateVoteCodeUpdate validates the updated VoteCodeEnableHeight. Unlike
+// vote extensions, vote code authentication cannot be switched off: an update
+// of 0 leaves the current value in place.
+// | r | params...EnableHeight | updated...EnableHeight | result (nil == pass)
+// | 1 | *                    | < 0                    | VoteCodeEnableHeight must be positive
+// | 2 | *                    | 0                      | nil (unchanged)
+// | 3 | X                    | X                      | nil
+// | 4 | *                    | <=height               | vote codes cannot be enabled at a past height
+// | 5 | (> 0) <=height       | > height               | vote codes cannot be modified once enabled
+// | 6 | <=0 or > height      | > height               | nil
+func (params ConsensusParams) validateVoteCodeUpdate(updated int64, h int64) error {
+	current := params.ABCI.VoteCodeEnableHeight
+	switch {
+	case updated < 0:
+		return errors.New("VoteCodeEnableHeight must be positive")
+	case updated == 0, updated == current:
+		return nil
+	case updated <= h:
+		return fmt.Errorf("vote codes cannot be enabled at a past or current height, "+
+			"enable height: %d, current height %d", updated, h)
+	case current > 0 && current <= h:
+		return fmt.Errorf("vote codes cannot be modified once enabled, "+
+			"enable height: %d, current height %d", current, h)
+	}
+	return nil
+}
+
 // Hash returns a hash of a subset of the parameters to store in the block header.
 // Only the Block.MaxBytes and Block.MaxGas are included in the hash.
 // This allows the ConsensusParams to evolve more without breaking the block
@@ -318,6 +367,9 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 	}
 	if params2.Abci != nil {
 		res.ABCI.VoteExtensionsEnableHeight = params2.Abci.GetVoteExtensionsEnableHeight()
+		if params2.Abci.GetVoteCodeEnableHeight() != 0 {
+			res.ABCI.VoteCodeEnableHeight = params2.Abci.GetVoteCodeEnableHeight()
+		}
 	}
 	return res
 }
@@ -341,6 +393,7 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 		},
 		Abci: &cmtproto.ABCIParams{
 			VoteExtensionsEnableHeight: params.ABCI.VoteExtensionsEnableHeight,
+			VoteCodeEnableHeight:       params.ABCI.VoteCodeEnableHeight,
 		},
 	}
 }
@@ -365,6 +418,7 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 	}
 	if pbParams.Abci != nil {
 		c.ABCI.VoteExtensionsEnableHeight = pbParams.Abci.GetVoteExtensionsEnableHeight()
+		c.ABCI.VoteCodeEnableHeight = pbParams.Abci.GetVoteCodeEnableHeight()
 	}
 	return c
 }
@@ -17,6 +17,10 @@ const (
 
 	// The maximum supported number of bytes in a vote extension.
 	MaxVoteExtensionSize int = 1024 * 1024
+
+	// MaxVoteCodes is the maximum number of vote codes a vote may carry, one
+	// per governance tx of the block voted on.
+	MaxVoteCodes int = 64
 )
 
 var (
@@ -69,7 +73,7 @@ type Vote struct {
 	Timestamp          time.Time              `json:"timestamp"`
 	ValidatorAddress   Address                `json:"validator_address"`
 	ValidatorIndex     int32                  `json:"validator_index"`
-	VoteCode           int64                  `json:"vote_code"`
+	VoteCodes          []int64                `json:"vote_codes"`
 	Signature          []byte                 `json:"signature"`
 	Extension          []byte                 `json:"extension"`
 	ExtensionSignature []byte                 `json:"extension_signature"`
@@ -96,7 +100,7 @@ func VoteFromProto(pv *cmtproto.Vote) (*Vote, error) {
 		Signature:          pv.Signature,
 		Extension:          pv.Extension,
 		ExtensionSignature: pv.ExtensionSignature,
-		VoteCode:           pv.VoteCode,
+		VoteCodes:          pv.VoteCodes,
 	}, nil
 }
 
@@ -121,7 +125,7 @@ func (vote *Vote) CommitSig() CommitSig {
 		ValidatorAddress: vote.ValidatorAddress,
 		Timestamp:        vote.Timestamp,
 		Signature:        vote.Signature,
-		VoteCode:         vote.VoteCode,
+		VoteCodes:        vote.VoteCodes,
 	}
 }
 
@@ -178,6 +182,15 @@ func (vote *Vote) Copy() *Vote {
 	return &voteCopy
 }
 
+// WithoutVoteCodes returns a copy of the vote with its vote codes cleared.
+// Votes for heights at which vote codes are not part of the sign bytes (see
+// ABCIParams.VoteCodeEnableHeight) are signed and verified in this form.
+func (vote *Vote) WithoutVoteCodes() *Vote {
+	voteCopy := vote.Copy()
+	voteCopy.VoteCodes = nil
+	return voteCopy
+}
+
 // String returns a string representation of Vote.
 //
 // 1. validator index
@@ -217,7 +230,7 @@ func (vote *Vote) String() string {
 		cmtbytes.Fingerprint(vote.Signature),
 		cmtbytes.Fingerprint(vote.Extension),
 		CanonicalTime(vote.Timestamp),
-		vote.VoteCode,
+		vote.VoteCodes,
 	)
 }
 
@@ -322,6 +335,10 @@ func (vote *Vote) ValidateBasic() error {
 		return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
 	}
 
+	if len(vote.VoteCodes) > MaxVoteCodes {
+		return fmt.Errorf("too many vote codes (max: %d)", MaxVoteCodes)
+	}
+
 	// We should only ever see vote extensions in non-nil precommits, otherwise
 	// this is a violation of the specification.
 	// https://github.com/tendermint/tendermint/issues/8487
@@ -391,7 +408,7 @@ func (vote *Vote) ToProto() *cmtproto.Vote {
 		Signature:          vote.Signature,
 		Extension:          vote.Extension,
 		ExtensionSignature: vote.ExtensionSignature,
-		VoteCode:           vote.VoteCode,
+		VoteCodes:          vote.VoteCodes,
 	}
 }
 
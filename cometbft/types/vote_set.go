@@ -65,6 +65,7 @@ type VoteSet struct {
 	signedMsgType     cmtproto.SignedMsgType
 	valSet            *ValidatorSet
 	extensionsEnabled bool
+	voteCodeEnabled   bool
 
 	mtx           cmtsync.Mutex
 	votesBitArray *bits.BitArray
@@ -75,9 +76,15 @@ type VoteSet struct {
 	peerMaj23s    map[P2PID]BlockID      // Maj23 for each peer
 }
 
+// VoteCodeNoQuorum is the deterministic outcome of a tally in which no single
+// vote code is backed by +2/3 of the voting power. Validators precommit it for
+// a governance tx on which their prevotes split, so that the block still
+// commits and the application learns that no decision was reached.
+const VoteCodeNoQuorum int64 = -1
+
 type Maj23Vote struct {
 	*BlockID
-	VoteCode int64
+	VoteCodes []int64
 }
 
 // NewVoteSet instantiates all fields of a new vote set. This constructor requires
@@ -88,17 +95,18 @@ func NewVoteSet(chainID string, height int64, round int32,
 		panic("Cannot make VoteSet for height == 0, doesn't make sense.")
 	}
 	return &VoteSet{
-		chainID:       chainID,
-		height:        height,
-		round:         round,
-		signedMsgType: signedMsgType,
-		valSet:        valSet,
-		votesBitArray: bits.NewBitArray(valSet.Size()),
-		votes:         make([]*Vote, valSet.Size()),
-		sum:           0,
-		maj23:         nil,
-		votesByBlock:  make(map[string]*blockVotes, valSet.Size()),
-		peerMaj23s:    make(map[P2PID]BlockID),
+		chainID:         chainID,
+		height:          height,
+		round:           round,
+		signedMsgType:   signedMsgType,
+		valSet:          valSet,
+		voteCodeEnabled: true,
+		votesBitArray:   bits.NewBitArray(valSet.Size()),
+		votes:           make([]*Vote, valSet.Size()),
+		sum:             0,
+		maj23:           nil,
+		votesByBlock:    make(map[string]*blockVotes, valSet.Size()),
+		peerMaj23s:      make(map[P2PID]BlockID),
 	}
 }
 
@@ -112,6 +120,16 @@ func NewExtendedVoteSet(chainID string, height int64, round int32,
 	return vs
 }
 
+// SetVoteCodeEnabled sets whether the vote codes of the votes in the set are
+// part of their sign bytes. Vote sets authenticate vote codes by default; it
+// is disabled for heights before ABCIParams.VoteCodeEnableHeight. It must be
+// called before any vote is added to the set.
+func (voteSet *VoteSet) SetVoteCodeEnabled(enabled bool) {
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+	voteSet.voteCodeEnabled = enabled
+}
+
 func (voteSet *VoteSet) ChainID() string {
 	return voteSet.chainID
 }
@@ -218,13 +236,18 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 		return false, fmt.Errorf("existing vote: %v; new vote: %v: %w", existing, vote, ErrVoteNonDeterministicSignature)
 	}
 
-	// Check signature.
+	// Check signature. Before vote codes are authenticated, the signature
+	// covers the vote without its code.
+	signed := vote
+	if !voteSet.voteCodeEnabled {
+		signed = vote.WithoutVoteCodes()
+	}
 	if voteSet.extensionsEnabled {
-		if err := vote.VerifyVoteAndExtension(voteSet.chainID, val.PubKey); err != nil {
+		if err := signed.VerifyVoteAndExtension(voteSet.chainID, val.PubKey); err != nil {
 			return false, fmt.Errorf("failed to verify extended vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
 		}
 	} else {
-		if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
+		if err := signed.Verify(voteSet.chainID, val.PubKey); err != nil {
 			return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
 		}
 		if len(vote.ExtensionSignature) > 0 || len(vote.Extension) > 0 {
@@ -314,29 +337,25 @@ func (voteSet *VoteSet) addVerifiedVote(
 
 	// If we just crossed the quorum threshold and have 2/3 majority...
 	if quorum <= votesByBlock.sum {
-		voteCodePower := make(map[int64]int64, 0)
-		for _, vote := range votesByBlock.votes {
-			if vote == nil {
-				continue
+		codes := votesByBlock.voteCodes(quorum)
+		switch {
+		case voteSet.maj23 != nil:
+			// Only consider the first quorum reached, but keep the tally
+			// current as more votes arrive.
+			if voteSet.maj23.Key() == blockKey {
+				voteSet.maj23.VoteCodes = codes
 			}
-			if _, ok = voteCodePower[vote.VoteCode]; !ok {
-				voteCodePower[vote.VoteCode] = vote.Power
-			} else {
-				voteCodePower[vote.VoteCode] += vote.Power
+		// +2/3 for the block is a polka or a commit whatever codes the votes
+		// carry; an index without quorum on one code is VoteCodeNoQuorum.
+		default:
+			voteSet.maj23 = &Maj23Vote{
+				BlockID:   &vote.BlockID,
+				VoteCodes: codes,
 			}
-			if voteCodePower[vote.VoteCode] >= quorum {
-				// Only consider the first quorum reached
-				if voteSet.maj23 == nil {
-					voteSet.maj23 = &Maj23Vote{
-						BlockID:  &vote.BlockID,
-						VoteCode: vote.VoteCode,
-					}
-					// And also copy votes over to voteSet.votes
-					for i, vote := range votesByBlock.votes {
-						if vote != nil {
-							voteSet.votes[i] = vote.Vote
-						}
-					}
+			// And also copy votes over to voteSet.votes
+			for i, vote := range votesByBlock.votes {
+				if vote != nil {
+					voteSet.votes[i] = vote.Vote
 				}
 			}
 		}
@@ -488,16 +507,16 @@ func (voteSet *VoteSet) HasAll() bool {
 
 // If there was a +2/3 majority for blockID, return blockID and true.
 // Else, return the empty BlockID{} and false.
-func (voteSet *VoteSet) TwoThirdsMajority() (blockID BlockID, ok bool, code int64) {
+func (voteSet *VoteSet) TwoThirdsMajority() (blockID BlockID, ok bool, codes []int64) {
 	if voteSet == nil {
-		return BlockID{}, false, 0
+		return BlockID{}, false, nil
 	}
 	voteSet.mtx.Lock()
 	defer voteSet.mtx.Unlock()
 	if voteSet.maj23 != nil {
-		return *voteSet.maj23.BlockID, true, voteSet.maj23.VoteCode
+		return *voteSet.maj23.BlockID, true, voteSet.maj23.VoteCodes
 	}
-	return BlockID{}, false, 0
+	return BlockID{}, false, nil
 }
 
 //--------------------------------------------------------------------------------
@@ -730,6 +749,70 @@ func (vs *blockVotes) addVerifiedVote(vote *Vote, votingPower int64) {
 	}
 }
 
+// voteCodes returns the outcome of the tally of the vote codes of the votes
+// for the block, see voteCodeTally.decide.
+func (vs *blockVotes) voteCodes(quorum int64) []int64 {
+	var tally voteCodeTally
+	for _, vote := range vs.votes {
+		if vote == nil {
+			continue
+		}
+		tally.add(vote.VoteCodes, vote.Power)
+	}
+	return tally.decide(quorum)
+}
+
+// voteCodeTally tallies vote codes per governance tx index.
+type voteCodeTally struct {
+	codes  [][]int64
+	powers []int64
+}
+
+func (t *voteCodeTally) add(codes []int64, power int64) {
+	t.codes = append(t.codes, codes)
+	t.powers = append(t.powers, power)
+}
+
+// decide returns, for each governance tx index, the vote code backed by at
+// least quorum voting power, or VoteCodeNoQuorum if no single code reaches
+// it. Only the indexes voted on with
+// quorum voting power are tallied, so that a minority can not lengthen the
+// outcome by voting on more txs than the block has.
+func (t *voteCodeTally) decide(quorum int64) []int64 {
+	size := 0
+	for {
+		var power int64
+		for i, codes := range t.codes {
+			if len(codes) > size {
+				power += t.powers[i]
+			}
+		}
+		if power < quorum {
+			break
+		}
+		size++
+	}
+	if size == 0 {
+		return nil
+	}
+	outcome := make([]int64, size)
+	for idx := range outcome {
+		outcome[idx] = VoteCodeNoQuorum
+		codePower := make(map[int64]int64)
+		for i, codes := range t.codes {
+			if idx >= len(codes) {
+				continue
+			}
+			codePower[codes[idx]] += t.powers[i]
+			if codePower[codes[idx]] >= quorum {
+				outcome[idx] = codes[idx]
+				break
+			}
+		}
+	}
+	return outcome
+}
+
 func (vs *blockVotes) getByIndex(index int32) *Vote {
 	if vs == nil {
 		return nil
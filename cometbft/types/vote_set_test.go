@@ -24,7 +24,7 @@ func TestVoteSet_AddVote_Good(t *testing.T) {
 
 	assert.Nil(t, voteSet.GetByAddress(val0Addr))
 	assert.False(t, voteSet.BitArray().GetIndex(0))
-	blockID, ok := voteSet.TwoThirdsMajority()
+	blockID, ok, _ := voteSet.TwoThirdsMajority()
 	assert.False(t, ok || !blockID.IsZero(), "there should be no 2/3 majority")
 
 	vote := &Vote{
@@ -41,7 +41,7 @@ func TestVoteSet_AddVote_Good(t *testing.T) {
 
 	assert.NotNil(t, voteSet.GetByAddress(val0Addr))
 	assert.True(t, voteSet.BitArray().GetIndex(0))
-	blockID, ok = voteSet.TwoThirdsMajority()
+	blockID, ok, _ = voteSet.TwoThirdsMajority()
 	assert.False(t, ok || !blockID.IsZero(), "there should be no 2/3 majority")
 }
 
@@ -143,7 +143,7 @@ func TestVoteSet_2_3Majority(t *testing.T) {
 		_, err = signAddVote(privValidators[i], vote, voteSet)
 		require.NoError(t, err)
 	}
-	blockID, ok := voteSet.TwoThirdsMajority()
+	blockID, ok, _ := voteSet.TwoThirdsMajority()
 	assert.False(t, ok || !blockID.IsZero(), "there should be no 2/3 majority")
 
 	// 7th validator voted for some blockhash
@@ -154,7 +154,7 @@ func TestVoteSet_2_3Majority(t *testing.T) {
 		vote := withValidator(voteProto, addr, 6)
 		_, err = signAddVote(privValidators[6], withBlockHash(vote, cmtrand.Bytes(32)), voteSet)
 		require.NoError(t, err)
-		blockID, ok = voteSet.TwoThirdsMajority()
+		blockID, ok, _ = voteSet.TwoThirdsMajority()
 		assert.False(t, ok || !blockID.IsZero(), "there should be no 2/3 majority")
 	}
 
@@ -166,7 +166,7 @@ func TestVoteSet_2_3Majority(t *testing.T) {
 		vote := withValidator(voteProto, addr, 7)
 		_, err = signAddVote(privValidators[7], vote, voteSet)
 		require.NoError(t, err)
-		blockID, ok = voteSet.TwoThirdsMajority()
+		blockID, ok, _ = voteSet.TwoThirdsMajority()
 		assert.True(t, ok || blockID.IsZero(), "there should be 2/3 majority for nil")
 	}
 }
@@ -198,7 +198,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 		_, err = signAddVote(privValidators[i], vote, voteSet)
 		require.NoError(t, err)
 	}
-	blockID, ok := voteSet.TwoThirdsMajority()
+	blockID, ok, _ := voteSet.TwoThirdsMajority()
 	assert.False(t, ok || !blockID.IsZero(),
 		"there should be no 2/3 majority")
 
@@ -210,7 +210,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 		vote := withValidator(voteProto, adrr, 66)
 		_, err = signAddVote(privValidators[66], withBlockHash(vote, nil), voteSet)
 		require.NoError(t, err)
-		blockID, ok = voteSet.TwoThirdsMajority()
+		blockID, ok, _ = voteSet.TwoThirdsMajority()
 		assert.False(t, ok || !blockID.IsZero(),
 			"there should be no 2/3 majority: last vote added was nil")
 	}
@@ -224,7 +224,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 		blockPartsHeader := PartSetHeader{blockPartsTotal, crypto.CRandBytes(32)}
 		_, err = signAddVote(privValidators[67], withBlockPartSetHeader(vote, blockPartsHeader), voteSet)
 		require.NoError(t, err)
-		blockID, ok = voteSet.TwoThirdsMajority()
+		blockID, ok, _ = voteSet.TwoThirdsMajority()
 		assert.False(t, ok || !blockID.IsZero(),
 			"there should be no 2/3 majority: last vote added had different PartSetHeader Hash")
 	}
@@ -238,7 +238,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 		blockPartsHeader := PartSetHeader{blockPartsTotal + 1, blockPartSetHeader.Hash}
 		_, err = signAddVote(privValidators[68], withBlockPartSetHeader(vote, blockPartsHeader), voteSet)
 		require.NoError(t, err)
-		blockID, ok = voteSet.TwoThirdsMajority()
+		blockID, ok, _ = voteSet.TwoThirdsMajority()
 		assert.False(t, ok || !blockID.IsZero(),
 			"there should be no 2/3 majority: last vote added had different PartSetHeader Total")
 	}
@@ -251,7 +251,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 		vote := withValidator(voteProto, addr, 69)
 		_, err = signAddVote(privValidators[69], withBlockHash(vote, cmtrand.Bytes(32)), voteSet)
 		require.NoError(t, err)
-		blockID, ok = voteSet.TwoThirdsMajority()
+		blockID, ok, _ = voteSet.TwoThirdsMajority()
 		assert.False(t, ok || !blockID.IsZero(),
 			"there should be no 2/3 majority: last vote added had different BlockHash")
 	}
@@ -264,7 +264,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 		vote := withValidator(voteProto, addr, 70)
 		_, err = signAddVote(privValidators[70], vote, voteSet)
 		require.NoError(t, err)
-		blockID, ok = voteSet.TwoThirdsMajority()
+		blockID, ok, _ = voteSet.TwoThirdsMajority()
 		assert.True(t, ok && blockID.Equals(BlockID{blockHash, blockPartSetHeader}),
 			"there should be 2/3 majority")
 	}
@@ -390,7 +390,7 @@ func TestVoteSet_Conflicts(t *testing.T) {
 	if !voteSet.HasTwoThirdsMajority() {
 		t.Errorf("we should have 2/3 majority for blockHash1")
 	}
-	blockIDMaj23, _ := voteSet.TwoThirdsMajority()
+	blockIDMaj23, _, _ := voteSet.TwoThirdsMajority()
 	if !bytes.Equal(blockIDMaj23.Hash, blockHash1) {
 		t.Errorf("got the wrong 2/3 majority blockhash")
 	}
@@ -559,6 +559,190 @@ func TestVoteSet_VoteExtensionsEnabled(t *testing.T) {
 	}
 }
 
+func TestVoteSet_VoteCodeEnabled(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		voteCodeEnabled bool
+		signCode        bool
+		exepectError    bool
+	}{
+		{
+			name:            "signed code and enabled",
+			voteCodeEnabled: true,
+			signCode:        true,
+			exepectError:    false,
+		},
+		{
+			name:            "tampered code and enabled",
+			voteCodeEnabled: true,
+			signCode:        false,
+			exepectError:    true,
+		},
+		{
+			name:            "unsigned code and not enabled",
+			voteCodeEnabled: false,
+			signCode:        false,
+			exepectError:    false,
+		},
+		{
+			name:            "signed code but not enabled",
+			voteCodeEnabled: false,
+			signCode:        true,
+			exepectError:    true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			height, round := int64(1), int32(0)
+			voteSet, _, privValidators := randVoteSet(height, round, cmtproto.PrecommitType, 5, 10, false)
+			voteSet.SetVoteCodeEnabled(tc.voteCodeEnabled)
+
+			val0 := privValidators[0]
+			val0p, err := val0.GetPubKey()
+			require.NoError(t, err)
+
+			vote := &Vote{
+				ValidatorAddress: val0p.Address(),
+				ValidatorIndex:   0,
+				Height:           height,
+				Round:            round,
+				Type:             cmtproto.PrecommitType,
+				Timestamp:        cmttime.Now(),
+				BlockID:          BlockID{crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}},
+				VoteCodes:        []int64{201},
+			}
+			signed := vote
+			if !tc.signCode {
+				signed = vote.WithoutVoteCodes()
+			}
+			v := signed.ToProto()
+			require.NoError(t, val0.SignVote(voteSet.ChainID(), v))
+			vote.Signature = v.Signature
+
+			added, err := voteSet.AddVote(vote)
+			if tc.exepectError {
+				require.Error(t, err)
+				require.False(t, added)
+			} else {
+				require.NoError(t, err)
+				require.True(t, added)
+				require.Equal(t, []int64{201}, voteSet.GetByIndex(0).VoteCodes)
+			}
+		})
+	}
+}
+
+func TestVoteSet_VoteCodeSplit(t *testing.T) {
+	height, round := int64(1), int32(0)
+	blockID := BlockID{crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}}
+
+	// votes returns the vote codes of 10 validators, the first n of which
+	// vote a and the others b.
+	votes := func(n int, a, b []int64) [][]int64 {
+		codes := make([][]int64, 10)
+		for i := range codes {
+			codes[i] = b
+			if i < n {
+				codes[i] = a
+			}
+		}
+		return codes
+	}
+	for _, tc := range []struct {
+		name        string
+		msgType     cmtproto.SignedMsgType
+		codes       [][]int64
+		expectMaj   bool
+		expectCodes []int64
+	}{
+		{"prevotes agree", cmtproto.PrevoteType, votes(7, []int64{202}, []int64{203}), true, []int64{202}},
+		{"prevotes split", cmtproto.PrevoteType, votes(5, []int64{202}, []int64{203}), true, []int64{VoteCodeNoQuorum}},
+		{"prevotes split on one tx", cmtproto.PrevoteType, votes(5, []int64{202, 1000}, []int64{203, 1000}), true, []int64{VoteCodeNoQuorum, 1000}},
+		{"prevotes without txs", cmtproto.PrevoteType, votes(10, nil, nil), true, nil},
+		{"prevotes minority on extra tx", cmtproto.PrevoteType, votes(3, []int64{202, 202}, []int64{202}), true, []int64{202}},
+		{"precommits agree", cmtproto.PrecommitType, votes(7, []int64{202, 1001}, []int64{203, 1001}), true, []int64{202, 1001}},
+		{"precommits split", cmtproto.PrecommitType, votes(5, []int64{202}, []int64{203}), true, []int64{VoteCodeNoQuorum}},
+		{"precommits split on one tx", cmtproto.PrecommitType, votes(5, []int64{202, 1000}, []int64{202, 1001}), true, []int64{202, VoteCodeNoQuorum}},
+		{"precommits no quorum", cmtproto.PrecommitType, votes(10, []int64{-1, 201}, nil), true, []int64{VoteCodeNoQuorum, 201}},
+		{"precommits minority on extra tx", cmtproto.PrecommitType, votes(3, []int64{202, 202}, []int64{202}), true, []int64{202}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			voteSet, _, privValidators := randVoteSet(height, round, tc.msgType, 10, 1, false)
+			for i, code := range tc.codes {
+				pubKey, err := privValidators[i].GetPubKey()
+				require.NoError(t, err)
+				vote := &Vote{
+					ValidatorAddress: pubKey.Address(),
+					ValidatorIndex:   int32(i),
+					Height:           height,
+					Round:            round,
+					Type:             tc.msgType,
+					Timestamp:        cmttime.Now(),
+					BlockID:          blockID,
+					VoteCodes:        code,
+				}
+				_, err = signAddVote(privValidators[i], vote, voteSet)
+				require.NoError(t, err)
+			}
+			maj23, ok, codes := voteSet.TwoThirdsMajority()
+			require.Equal(t, tc.expectMaj, ok)
+			require.Equal(t, tc.expectCodes, codes)
+			if ok {
+				require.Equal(t, blockID, maj23)
+			}
+		})
+	}
+}
+
+func TestVoteSet_MakeCommitSplitVoteCodes(t *testing.T) {
+	height, round := int64(1), int32(0)
+	voteSet, valSet, privValidators := randVoteSet(height, round, cmtproto.PrecommitType, 10, 1, false)
+	blockID := BlockID{crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}}
+
+	addVote := func(i int32, codes []int64) {
+		pubKey, err := privValidators[i].GetPubKey()
+		require.NoError(t, err)
+		vote := &Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   i,
+			Height:           height,
+			Round:            round,
+			Type:             cmtproto.PrecommitType,
+			Timestamp:        cmttime.Now(),
+			BlockID:          blockID,
+			VoteCodes:        codes,
+		}
+		_, err = signAddVote(privValidators[i], vote, voteSet)
+		require.NoError(t, err)
+	}
+
+	// 7 out of 10 precommit the block, split on the code of the first tx.
+	for i := int32(0); i < 7; i++ {
+		code := int64(202)
+		if i%2 == 1 {
+			code = 203
+		}
+		addVote(i, []int64{code, 1000})
+	}
+	maj23, ok, codes := voteSet.TwoThirdsMajority()
+	require.True(t, ok)
+	assert.Equal(t, blockID, maj23)
+	assert.Equal(t, []int64{VoteCodeNoQuorum, 1000}, codes)
+
+	commit := voteSet.MakeExtendedCommit(ABCIParams{}).ToCommit()
+	require.NoError(t, commit.ValidateBasic())
+	assert.Equal(t, blockID, commit.BlockID)
+	assert.Equal(t, []int64{VoteCodeNoQuorum, 1000}, commit.DecidedVoteCodes(valSet))
+
+	// Late precommits bring the first tx to quorum.
+	addVote(7, []int64{202, 1000})
+	addVote(8, []int64{202, 1000})
+	addVote(9, []int64{202, 1000})
+	_, _, codes = voteSet.TwoThirdsMajority()
+	assert.Equal(t, []int64{202, 1000}, codes)
+	commit = voteSet.MakeExtendedCommit(ABCIParams{}).ToCommit()
+	assert.Equal(t, []int64{202, 1000}, commit.DecidedVoteCodes(valSet))
+}
+
 // NOTE: privValidators are in order
 func randVoteSet(
 	height int64,
@@ -61,6 +61,26 @@ const ProposalDetail: React.FC = () => {
             </Col>
           ))}
         </Row>
+        {agentDetailData?.disqualifications?.length ? (
+          <>
+            <Flex className={'proposal-history'}>
+              Expulsion History
+            </Flex>
+            {agentDetailData.disqualifications.map((item, index) => (
+              <Flex key={`agent-dq-${index}`} vertical style={{ padding: '15px 0' }}>
+                <Flex align={'center'} justify={'space-between'} style={{ maxWidth: '70%' }}>
+                  <Typography.Text className={'history-desc'} ellipsis>
+                    {item.expelled ? 'Expelled' : 'Kept'} at block {item.height}, accused by {item.accuser_name || item.accuser_address}
+                  </Typography.Text>
+                </Flex>
+                <Typography.Text type={'secondary'}>Clause: {item.clause}</Typography.Text>
+                <Typography.Paragraph type={'secondary'} ellipsis={{ rows: 2, expandable: true }}>
+                  Evidence: {item.evidence}
+                </Typography.Paragraph>
+              </Flex>
+            ))}
+          </>
+        ) : null}
       </div>
     </PageContainer>
   );
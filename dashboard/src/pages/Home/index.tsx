@@ -21,7 +21,7 @@ import BottomProgressBar from '@/pages/Home/components/bottomProgressBar/BottomP
 import { history } from 'umi';
 import ApplyProposal from '@/pages/Home/components/applyProposal';
 import ProList from '@ant-design/pro-list/lib';
-import { agents, latestBlocks, manifesto, networkStatus, proposals } from '@/services/api/AgentController';
+import { agents, latestBlocks, manifesto, manifestoHistory, networkStatus, proposals } from '@/services/api/AgentController';
 import moment from 'moment';
 import image32 from '@/assets/image32.png';
 
@@ -45,7 +45,8 @@ const avatarContext = require.context(
 const avatarList = avatarContext.keys().map(key => avatarContext(key));
 const HomePage: React.FC = () => {
   const actionRef = useRef<ActionType>();
-  const [manifest, setManifest] = useState<string | null>();
+  const [manifest, setManifest] = useState<AGENTAPI.ManifestoRes | null>();
+  const [manifestVersions, setManifestVersions] = useState<AGENTAPI.ManifestVersion[]>([]);
   const [agentsList, setAgentsList] = useState<AGENTAPI.AgentInfo[]>([]);
   const [networkStatusData, setNetworkStatusData] = useState<AGENTAPI.NetworkStatusRes | null>();
   const [latestBlocksData, setLatestBlocksData] = useState<AGENTAPI.BlockInfo | null>();
@@ -58,7 +59,9 @@ const HomePage: React.FC = () => {
   };
   const getManifest = async () => {
     const res = await manifesto();
-    setManifest(res?.manifesto || '');
+    setManifest(res || null);
+    const historyRes = await manifestoHistory();
+    setManifestVersions(historyRes?.versions || []);
   };
   const getAgents = async () => {
     const res = await agents();
@@ -80,9 +83,24 @@ const HomePage: React.FC = () => {
 
   const manifestoAction = () => {
     Modal.info({
-      title: 'Manifesto',
+      title: `Manifesto v${manifest?.version || 1}`,
       icon: null,
-      content: manifest,
+      content: (
+        <>
+          <Typography.Paragraph>{manifest?.manifesto}</Typography.Paragraph>
+          {manifestVersions.length > 1 && (
+            <>
+              <Divider>History</Divider>
+              {[...manifestVersions].reverse().slice(1).map((item) => (
+                <Typography.Paragraph key={`manifest-v${item.version}`} type={'secondary'}
+                                      ellipsis={{ rows: 3, expandable: true }}>
+                  v{item.version} (block {item.height}){item.reason ? ` - ${item.reason}` : ''}: {item.text}
+                </Typography.Paragraph>
+              ))}
+            </>
+          )}
+        </>
+      ),
       closable: true,
       footer: null,
     });
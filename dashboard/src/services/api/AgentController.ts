@@ -38,6 +38,15 @@ export async function manifesto(
   });
 }
 
+export async function manifestoHistory(
+  options?: { [key: string]: any },
+) {
+  return request<AGENTAPI.ManifestoHistoryRes>('/api/manifesto-history', {
+    method: 'GET',
+    ...(options || {}),
+  });
+}
+
 export async function agents(
   options?: { [key: string]: any },
 ) {
@@ -83,3 +92,17 @@ export async function agentDetail(
     ...(options || {}),
   });
 }
+
+export async function disqualifications(
+  body: AGENTAPI.DisqualificationsReq,
+  options?: { [key: string]: any },
+) {
+  return request<AGENTAPI.DisqualificationsRes>('/api/disqualifications', {
+    method: 'POST',
+    headers: {
+      'Content-Type': 'application/json',
+    },
+    data: body,
+    ...(options || {}),
+  });
+}
@@ -77,7 +77,23 @@ declare namespace AGENTAPI {
 
   // manifesto  获取宣言
   interface ManifestoRes {
-    manifesto?: string
+    manifesto?: string,
+    version?: number, //当前宣言版本
+    height?: number, //生效高度
+  }
+
+  // 宣言历史版本
+  interface ManifestVersion {
+    version: number,
+    text: string,
+    height: number, //生效高度
+    proposer: number, //修正案发起人id，创世版本为0
+    proposer_address: string,
+    reason: string,
+  }
+
+  interface ManifestoHistoryRes {
+    versions?: ManifestVersion[],
   }
 
   // 获取agent列表
@@ -128,6 +144,36 @@ declare namespace AGENTAPI {
   interface AgentDetail {
     agent:AgentInfo,
     proposals:ProposalInfo[],
+    disqualifications?:Disqualification[],
+  }
+
+  // 获取驱逐记录
+  interface DisqualificationsReq {
+    targetAddress?: string, //可选项
+    page: number,
+    pageSize: number
+  }
+
+  interface Disqualification {
+    id: number,
+    target_index: number, //被驱逐人id
+    target_address: string, //被驱逐人地址
+    target_name: string,
+    accuser_index: number, //发起人id
+    accuser_address: string, //发起人地址
+    accuser_name: string,
+    clause: string, //违反的宣言条款
+    evidence: string, //证据
+    stake: number, //被清零的投票权重
+    expelled: boolean, //是否驱逐
+    vote_code: number, //投票码
+    height: number,
+    create_timestamp: number,
+  }
+
+  interface DisqualificationsRes {
+    disqualifications?: Disqualification[],
+    total?: number,
   }
 
 
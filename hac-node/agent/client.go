@@ -20,9 +20,13 @@ var DiscussionRate = 0
 var DiscussionTrigger = 0
 
 type Client interface {
-	IfProcessProposal(ctx context.Context, proposal string, title string) (bool, error)
-	IfAcceptProposal(ctx context.Context, proposal uint64, voter string) (bool, error)
-	IfGrantNewMember(ctx context.Context, validator uint64, proposer string, amount uint64, statement string) (bool, error)
+	IfProcessProposal(ctx context.Context, proposal string, title string) (*VoteResponse, error)
+	IfAcceptProposal(ctx context.Context, proposal uint64, voter string, options []string) (*VoteResponse, error)
+	IfGrantNewMember(ctx context.Context, validator uint64, proposer string, amount uint64, statement string) (*VoteResponse, error)
+	IfDisqualifyMember(ctx context.Context, target string, accuser string, clause string, evidence string) (*VoteResponse, error)
+	IfAmendManifest(ctx context.Context, proposer string, manifest string, amended string, reason string) (*VoteResponse, error)
+	IfAmendParams(ctx context.Context, proposer string, params string, amended string, reason string) (*VoteResponse, error)
+	UpdateManifest(ctx context.Context, version uint64, manifest string) error
 	CommentPropoal(ctx context.Context, proposal uint64, speaker string) (string, error)
 	AddProposal(ctx context.Context, proposal uint64, proposer string, text string) error
 	AddDiscussion(ctx context.Context, proposal uint64, speaker string, text string) error
@@ -127,13 +131,32 @@ func (e *ElizaClient) GetAgentIds(ctx context.Context) ([]string, error) {
 	return ids, nil
 }
 
+// postJSON is http.Post bound to ctx, so an agent call gives up at the
+// caller's deadline instead of holding up consensus.
+func postJSON(ctx context.Context, url string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("agent response status %v", res.Status)
+	}
+	return res, nil
+}
+
 type VoteGrantReq struct {
 	GrantId          uint64 `json:"grantId"`
 	ValidatorAddress string `json:"validatorAddress"`
 	Text             string `json:"text"`
 }
 
-func (e *ElizaClient) IfGrantNewMember(ctx context.Context, validator uint64, proposer string, amount uint64, statement string) (bool, error) {
+func (e *ElizaClient) IfGrantNewMember(ctx context.Context, validator uint64, proposer string, amount uint64, statement string) (*VoteResponse, error) {
 	e.logger.Info("IfGrantNewMember", "validator", validator, "proposer", proposer, "amount", amount, "statement", statement)
 	url := fmt.Sprintf("%s/%s/votegrant", e.Url, e.AgentId)
 	req := VoteGrantReq{
@@ -142,27 +165,152 @@ func (e *ElizaClient) IfGrantNewMember(ctx context.Context, validator uint64, pr
 		Text:             statement,
 	}
 	data, _ := json.Marshal(req)
-	res, err := http.Post(url, "application/json", bytes.NewBuffer([]byte(data)))
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	defer res.Body.Close()
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
 		e.logger.Error("read response body fail", "err", err)
-		return false, err
+		return nil, err
 	}
 	var vote VoteResponse
 	err = json.Unmarshal(bodyBytes, &vote)
 	if err != nil {
 		e.logger.Error("unmarshal response body fail", "err", err)
-		return false, err
+		return nil, err
 	}
 	e.logger.Info("vote grant", "validator", validator, "proposer", proposer, "vote", vote.Vote, "reason", vote.Reason)
-	if vote.Vote == "yes" {
-		return true, nil
+	return &vote, nil
+}
+
+type VoteDisqualifyReq struct {
+	TargetAddress  string `json:"targetAddress"`
+	AccuserAddress string `json:"accuserAddress"`
+	Clause         string `json:"clause"`
+	Evidence       string `json:"evidence"`
+}
+
+func (e *ElizaClient) IfDisqualifyMember(ctx context.Context, target string, accuser string, clause string, evidence string) (*VoteResponse, error) {
+	e.logger.Info("IfDisqualifyMember", "target", target, "accuser", accuser, "clause", clause)
+	url := fmt.Sprintf("%s/%s/votedisqualify", e.Url, e.AgentId)
+	req := VoteDisqualifyReq{
+		TargetAddress:  target,
+		AccuserAddress: accuser,
+		Clause:         clause,
+		Evidence:       evidence,
+	}
+	data, _ := json.Marshal(req)
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		e.logger.Error("read response body fail", "err", err)
+		return nil, err
 	}
-	return false, nil
+	var vote VoteResponse
+	err = json.Unmarshal(bodyBytes, &vote)
+	if err != nil {
+		e.logger.Error("unmarshal response body fail", "err", err)
+		return nil, err
+	}
+	e.logger.Info("vote disqualify", "target", target, "accuser", accuser, "vote", vote.Vote, "reason", vote.Reason)
+	return &vote, nil
+}
+
+type VoteAmendManifestReq struct {
+	ProposerAddress string `json:"proposerAddress"`
+	Manifest        string `json:"manifest"`
+	Amended         string `json:"amended"`
+	Reason          string `json:"reason"`
+}
+
+func (e *ElizaClient) IfAmendManifest(ctx context.Context, proposer string, manifest string, amended string, reason string) (*VoteResponse, error) {
+	e.logger.Info("IfAmendManifest", "proposer", proposer, "reason", reason)
+	url := fmt.Sprintf("%s/%s/voteamendment", e.Url, e.AgentId)
+	req := VoteAmendManifestReq{
+		ProposerAddress: proposer,
+		Manifest:        manifest,
+		Amended:         amended,
+		Reason:          reason,
+	}
+	data, _ := json.Marshal(req)
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		e.logger.Error("read response body fail", "err", err)
+		return nil, err
+	}
+	var vote VoteResponse
+	err = json.Unmarshal(bodyBytes, &vote)
+	if err != nil {
+		e.logger.Error("unmarshal response body fail", "err", err)
+		return nil, err
+	}
+	e.logger.Info("vote amendment", "proposer", proposer, "vote", vote.Vote, "reason", vote.Reason)
+	return &vote, nil
+}
+
+type VoteAmendParamsReq struct {
+	ProposerAddress string `json:"proposerAddress"`
+	Params          string `json:"params"`
+	Amended         string `json:"amended"`
+	Reason          string `json:"reason"`
+}
+
+func (e *ElizaClient) IfAmendParams(ctx context.Context, proposer string, params string, amended string, reason string) (*VoteResponse, error) {
+	e.logger.Info("IfAmendParams", "proposer", proposer, "reason", reason)
+	url := fmt.Sprintf("%s/%s/voteparams", e.Url, e.AgentId)
+	req := VoteAmendParamsReq{
+		ProposerAddress: proposer,
+		Params:          params,
+		Amended:         amended,
+		Reason:          reason,
+	}
+	data, _ := json.Marshal(req)
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		e.logger.Error("read response body fail", "err", err)
+		return nil, err
+	}
+	var vote VoteResponse
+	err = json.Unmarshal(bodyBytes, &vote)
+	if err != nil {
+		e.logger.Error("unmarshal response body fail", "err", err)
+		return nil, err
+	}
+	e.logger.Info("vote params amendment", "proposer", proposer, "vote", vote.Vote, "reason", vote.Reason)
+	return &vote, nil
+}
+
+type UpdateManifestReq struct {
+	Version  uint64 `json:"version"`
+	Manifest string `json:"manifest"`
+}
+
+func (e *ElizaClient) UpdateManifest(ctx context.Context, version uint64, manifest string) error {
+	e.logger.Info("UpdateManifest", "version", version)
+	url := fmt.Sprintf("%s/%s/manifest", e.Url, e.AgentId)
+	data, _ := json.Marshal(UpdateManifestReq{Version: version, Manifest: manifest})
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return nil
 }
 
 func (e *ElizaClient) CommentPropoal(ctx context.Context, proposal uint64, speaker string) (string, error) {
@@ -236,40 +384,58 @@ func (e *ElizaClient) AddProposal(ctx context.Context, proposal uint64, proposer
 	return nil
 }
 
+// Votes an agent may answer with. On a multi-option proposal the agent
+// answers with the label of the option it supports instead of VoteYes.
+const (
+	VoteYes     = "yes"
+	VoteNo      = "no"
+	VoteAbstain = "abstain"
+)
+
 type VoteResponse struct {
 	Vote   string `json:"vote"`
 	Reason string `json:"reason"`
 }
 
-func (e *ElizaClient) IfAcceptProposal(ctx context.Context, proposal uint64, voter string) (bool, error) {
-	e.logger.Info("IfAcceptProposal", "proposal", proposal, "voter", voter)
+type VoteProposalReq struct {
+	ProposalId       string   `json:"proposalId"`
+	ValidatorAddress string   `json:"validatorAddress"`
+	Text             string   `json:"text"`
+	Options          []string `json:"options,omitempty"`
+}
+
+func (e *ElizaClient) IfAcceptProposal(ctx context.Context, proposal uint64, voter string, options []string) (*VoteResponse, error) {
+	e.logger.Info("IfAcceptProposal", "proposal", proposal, "voter", voter, "options", options)
 	url := fmt.Sprintf("%s/%s/voteproposal", e.Url, e.AgentId)
-	body := fmt.Sprintf(`{"proposalId":"%d","validatorAddress":"%s","text":"analyze proposal"}`, proposal, voter)
-	res, err := http.Post(url, "application/json", bytes.NewBuffer([]byte(body)))
+	req := VoteProposalReq{
+		ProposalId:       fmt.Sprintf("%d", proposal),
+		ValidatorAddress: voter,
+		Text:             "analyze proposal",
+		Options:          options,
+	}
+	data, _ := json.Marshal(req)
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	defer res.Body.Close()
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
 		e.logger.Error("read response body fail", "err", err)
-		return false, err
+		return nil, err
 	}
 	var vote VoteResponse
 	err = json.Unmarshal(bodyBytes, &vote)
 	if err != nil {
 		e.logger.Error("unmarshal response body fail", "err", err)
-		return false, err
+		return nil, err
 	}
 	e.logger.Info("vote proposal", "proposal", proposal, "voter", voter, "vote", vote.Vote, "reason", vote.Reason)
-	if vote.Vote == "yes" {
-		return true, nil
-	}
-	return false, nil
+	return &vote, nil
 }
 
-func (e *ElizaClient) IfProcessProposal(ctx context.Context, proposal,title string) (bool, error) {
-	return true, nil
+func (e *ElizaClient) IfProcessProposal(ctx context.Context, proposal, title string) (*VoteResponse, error) {
+	return &VoteResponse{Vote: VoteYes}, nil
 }
 
 type MockClient struct {
@@ -299,14 +465,33 @@ func NewMockClient() *MockClient {
 	return &MockClient{}
 }
 
-func (m *MockClient) IfAcceptProposal(ctx context.Context, proposal uint64, voter string) (bool, error) {
-	return true, nil
+func (m *MockClient) IfAcceptProposal(ctx context.Context, proposal uint64, voter string, options []string) (*VoteResponse, error) {
+	if len(options) != 0 {
+		return &VoteResponse{Vote: options[0]}, nil
+	}
+	return &VoteResponse{Vote: VoteYes}, nil
 }
 
-func (m *MockClient) IfGrantNewMember(ctx context.Context, validator uint64, proposer string, amount uint64, statement string) (bool, error) {
-	return true, nil
+func (m *MockClient) IfGrantNewMember(ctx context.Context, validator uint64, proposer string, amount uint64, statement string) (*VoteResponse, error) {
+	return &VoteResponse{Vote: VoteYes}, nil
+}
+
+func (m *MockClient) IfDisqualifyMember(ctx context.Context, target string, accuser string, clause string, evidence string) (*VoteResponse, error) {
+	return &VoteResponse{Vote: VoteYes}, nil
+}
+
+func (m *MockClient) IfAmendManifest(ctx context.Context, proposer string, manifest string, amended string, reason string) (*VoteResponse, error) {
+	return &VoteResponse{Vote: VoteYes}, nil
+}
+
+func (m *MockClient) IfAmendParams(ctx context.Context, proposer string, params string, amended string, reason string) (*VoteResponse, error) {
+	return &VoteResponse{Vote: VoteYes}, nil
+}
+
+func (m *MockClient) UpdateManifest(ctx context.Context, version uint64, manifest string) error {
+	return nil
 }
 
-func (m *MockClient) IfProcessProposal(ctx context.Context, proposal,title string) (bool, error) {
-	return true, nil
+func (m *MockClient) IfProcessProposal(ctx context.Context, proposal, title string) (*VoteResponse, error) {
+	return &VoteResponse{Vote: VoteYes}, nil
 }
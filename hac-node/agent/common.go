@@ -1,4 +1,3 @@
 package agent
 
-const MANIFESTO = `GO TO MARS!`
 const DEFAULT_PROPOSAL_EXPIRE_DUR = 1000
@@ -1,7 +1,9 @@
 package agent
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -9,12 +11,15 @@ import (
 	"log"
 	"math/rand"
 	"path"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtlog "github.com/cometbft/cometbft/libs/log"
 	comethttp "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 	app_config "github.com/hetu-project/hetu-chaoschain/config"
 	"github.com/hetu-project/hetu-chaoschain/crypto"
 	"github.com/hetu-project/hetu-chaoschain/state"
@@ -39,6 +44,7 @@ type ChainIndexer struct {
 	BlockStore    *store.BlockStore
 	appConfig     *app_config.Config
 	pv            *crypto.PV
+	session       *crypto.PV
 	LocalAddress  string
 	ChainId       string
 	chainUrl      string
@@ -54,7 +60,7 @@ func NewChainIndexer(logger cmtlog.Logger, dbPath string, chainUrl string, bs *s
 	if err != nil {
 		return nil, err
 	}
-	if err := db.AutoMigrate(&Grant{}, &Discussion{}, &Proposal{}, &Height{}, &GrantVote{}, &ProposalVote{}, &ValidatorAgent{}).Error; err != nil {
+	if err := db.AutoMigrate(&Grant{}, &Discussion{}, &Proposal{}, &Height{}, &GrantVote{}, &ProposalVote{}, &ValidatorAgent{}, &Disqualification{}, &Application{}, &GenericEvent{}, &Reward{}).Error; err != nil {
 		return nil, err
 	}
 	h := Height{Id: 1}
@@ -71,6 +77,11 @@ func NewChainIndexer(logger cmtlog.Logger, dbPath string, chainUrl string, bs *s
 	println("privkeyPath:", privKeyPath)
 	pv := crypto.LoadFilePV(privKeyPath)
 	localAddress := pv.Address()
+	var session *crypto.PV
+	if appConfig.App.SessionKey != "" {
+		session = crypto.LoadFilePV(path.Join(appConfig.RootDir, appConfig.App.SessionKey))
+		logger.Info("agent signs with session key", "address", session.Address())
+	}
 
 	ctx := context.Background()
 	gres, err := cli.Genesis(ctx)
@@ -91,16 +102,31 @@ func NewChainIndexer(logger cmtlog.Logger, dbPath string, chainUrl string, bs *s
 		BlockStore:    bs,
 		appConfig:     appConfig,
 		pv:            pv,
+		session:       session,
 		LocalAddress:  localAddress,
 		chainUrl:      chainUrl,
 		ChainId:       chainId,
 	}
 
 	c.eventHandlers = map[string]eventHandler{
-		hac_types.EventGrantType:          c.handleEventGrant,
-		hac_types.EventDiscussionType:     c.handleEventDiscussion,
-		hac_types.EventSettleProposalType: c.handleEventSettleProposal,
-		hac_types.EventProposalType:       c.handleEventProposal,
+		hac_types.EventGrantType:           c.handleEventGrant,
+		hac_types.EventDiscussionType:      c.handleEventDiscussion,
+		hac_types.EventSettleProposalType:  c.handleEventSettleProposal,
+		hac_types.EventProposalType:        c.handleEventProposal,
+		hac_types.EventRationaleType:       c.handleEventRationale,
+		hac_types.EventProposalExpiredType: c.handleEventProposalExpired,
+		hac_types.EventDepositRefundType:   c.handleEventDepositRefund,
+		hac_types.EventRewardType:          c.handleEventReward,
+		hac_types.EventDisqualifyType:      c.handleEventDisqualify,
+		hac_types.EventAmendManifestType:   c.handleEventAmendManifest,
+		hac_types.EventUnStakeType:         c.handleEventUnStake,
+		hac_types.EventStakeType:           c.handleEventStake,
+		hac_types.EventSlashType:           c.handleEventSlash,
+		hac_types.EventApplyType:           c.handleEventApply,
+		hac_types.EventApplyExpiredType:    c.handleEventApplicationExpired,
+		hac_types.EventUpdateProfileType:   c.handleEventUpdateProfile,
+		hac_types.EventRotateKeyType:       c.handleEventRotateKey,
+		hac_types.EventRevokeSessionType:   c.handleEventRevokeSession,
 	}
 	return &c, nil
 }
@@ -110,6 +136,33 @@ type eventHandler func(ctx context.Context, event abci.Event, height int64)
 func (c *ChainIndexer) handleEvent(ctx context.Context, event abci.Event, height int64) {
 	if h, ok := c.eventHandlers[event.Type]; ok {
 		h(ctx, event, height)
+	} else if strings.HasPrefix(event.Type, hac_types.EventGenericPrefix) {
+		c.handleEventGeneric(ctx, event, height)
+	}
+}
+
+// handleEventGeneric records an event of a generic tx with its attributes
+// as a JSON object, for the module to read back.
+func (c *ChainIndexer) handleEventGeneric(ctx context.Context, event abci.Event, height int64) {
+	subtype, typ, ok := hac_types.ParseGenericEventType(event.Type)
+	if !ok {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	dat, _ := json.Marshal(attrs)
+	ev := GenericEvent{
+		Subtype:         subtype,
+		Type:            typ,
+		Attributes:      string(dat),
+		Height:          uint64(height),
+		CreateTimestamp: time.Now().Unix(),
+	}
+	if err := c.db.Save(&ev).Error; err != nil {
+		c.logger.Error("save generic event fail", "err", err)
 	}
 }
 
@@ -119,6 +172,15 @@ func (c *ChainIndexer) handleEventGrant(ctx context.Context, event abci.Event, h
 		c.logger.Error("decode event fail", "event", event)
 		return
 	}
+	status := ApplicationStatusRejected
+	if ev.Grant {
+		status = ApplicationStatusGranted
+	}
+	c.setApplicationStatus(ev.Address, status)
+	// a rejected grant makes no account to record.
+	if !ev.Grant {
+		return
+	}
 	grant := Grant{
 		Id:              ev.Validator,
 		Address:         ev.Address,
@@ -145,6 +207,100 @@ func (c *ChainIndexer) handleEventGrant(ctx context.Context, event abci.Event, h
 	}
 }
 
+func (c *ChainIndexer) handleEventApply(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventApply(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	application := Application{
+		Address:         ev.Address,
+		Pubkey:          ev.Pubkey,
+		Name:            ev.Name,
+		AgentUrl:        ev.AgentUrl,
+		Statement:       ev.Statement,
+		ManifestVersion: ev.ManifestVersion,
+		Status:          ApplicationStatusPending,
+		Height:          uint64(height),
+		ExpireHeight:    ev.ExpireHeight,
+		CreateTimestamp: time.Now().Unix(),
+	}
+	if err := c.db.Save(&application).Error; err != nil {
+		c.logger.Error("save application fail", "err", err)
+	}
+}
+
+func (c *ChainIndexer) handleEventApplicationExpired(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventApplicationExpired(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	c.setApplicationStatus(ev.Address, ApplicationStatusExpired)
+}
+
+// setApplicationStatus settles the pending application of address, if any.
+func (c *ChainIndexer) setApplicationStatus(address string, status string) {
+	err := c.db.Model(&Application{}).Where("address = ? And status = ?", address, ApplicationStatusPending).Update("status", status).Error
+	if err != nil {
+		c.logger.Error("update application fail", "err", err)
+	}
+}
+
+func (c *ChainIndexer) handleEventUpdateProfile(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventUpdateProfile(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	val, err := c.getValidator(ev.Validator)
+	if err != nil {
+		c.logger.Error("get validator fail", "err", err)
+		return
+	}
+	val.Name = ev.Name
+	val.AgentUrl = ev.AgentUrl
+	val.SelfIntro = ev.SelfIntro
+	if err := c.updateValidator(val); err != nil {
+		c.logger.Error("save validator fail", "err", err)
+	}
+}
+
+// handleEventRotateKey moves the validator to its new address. The rows
+// recorded under the old one are history and keep it.
+func (c *ChainIndexer) handleEventRotateKey(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventRotateKey(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	if ev.OldAddress == c.LocalAddress {
+		c.logger.Error("local validator key rotated, replace the private validator key and restart", "address", ev.Address)
+	}
+	val, err := c.getValidator(ev.Validator)
+	if err != nil {
+		c.logger.Error("get validator fail", "err", err)
+		return
+	}
+	val.Address = ev.Address
+	if err := c.updateValidator(val); err != nil {
+		c.logger.Error("save validator fail", "err", err)
+	}
+}
+
+// handleEventRevokeSession warns when the session key the agent signs with
+// is revoked, its txs failing until the node is configured with another.
+func (c *ChainIndexer) handleEventRevokeSession(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventRevokeSession(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	if c.session != nil && ev.Address == c.session.Address() {
+		c.logger.Error("local session key revoked, register another or unset session_key and restart", "address", ev.Address)
+	}
+}
+
 func (c *ChainIndexer) handleEventDiscussion(ctx context.Context, event abci.Event, height int64) {
 	ev := hac_types.DecodeEventDiscussion(event)
 	if ev == nil {
@@ -197,6 +353,164 @@ func (c *ChainIndexer) handleEventSettleProposal(ctx context.Context, event abci
 	}
 }
 
+func (c *ChainIndexer) handleEventProposalExpired(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventProposalExpired(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	var proposal Proposal
+	if err := c.db.First(&proposal, ev.Proposal).Error; err != nil {
+		c.logger.Error("get proposal fail", "err", err)
+		return
+	}
+	proposal.Status = uint64(hac_types.ProposalStatusExpired)
+	proposal.SettleHeight = uint64(height)
+	if err := c.db.Save(&proposal).Error; err != nil {
+		c.logger.Error("save proposal fail", "err", err)
+	}
+}
+
+func (c *ChainIndexer) handleEventDepositRefund(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventDepositRefund(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	val, err := c.getValidator(ev.Proposer)
+	if err != nil {
+		c.logger.Error("get validator fail", "err", err)
+		return
+	}
+	val.Stake = ev.Stake
+	if err := c.updateValidator(val); err != nil {
+		c.logger.Error("save validator fail", "err", err)
+	}
+}
+
+func (c *ChainIndexer) handleEventReward(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventReward(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	reward := Reward{
+		AccountIndex:    ev.Account,
+		Address:         ev.Address,
+		Kind:            ev.Kind,
+		Proposal:        ev.Proposal,
+		Amount:          ev.Amount,
+		Height:          uint64(height),
+		CreateTimestamp: time.Now().Unix(),
+	}
+	if err := c.db.Save(&reward).Error; err != nil {
+		c.logger.Error("save reward fail", "err", err)
+	}
+	c.setValidatorStake(ev.Address, ev.Stake)
+}
+
+func (c *ChainIndexer) handleEventDisqualify(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventDisqualify(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	dq := Disqualification{
+		TargetIndex:     ev.Target,
+		TargetAddress:   ev.TargetAddress,
+		AccuserIndex:    ev.Accuser,
+		AccuserAddress:  ev.AccuserAddress,
+		Clause:          ev.Clause,
+		Evidence:        ev.Evidence,
+		Stake:           ev.Stake,
+		Expelled:        ev.Expelled,
+		VoteCode:        ev.VoteCode,
+		Height:          uint64(height),
+		CreateTimestamp: time.Now().Unix(),
+	}
+	if accuser, err := c.getValidatorByAddress(ev.AccuserAddress); err == nil {
+		dq.AccuserName = accuser.Name
+	}
+	target, err := c.getValidatorByAddress(ev.TargetAddress)
+	if err != nil {
+		c.logger.Error("get validator fail", "err", err)
+	} else {
+		dq.TargetName = target.Name
+		if ev.Expelled {
+			target.Stake = 0
+			if err := c.db.Save(target).Error; err != nil {
+				c.logger.Error("save validator fail", "err", err)
+			}
+		}
+	}
+	if err := c.db.Save(&dq).Error; err != nil {
+		c.logger.Error("save disqualification fail", "err", err)
+	}
+}
+
+func (c *ChainIndexer) handleEventUnStake(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.ParseEventUnStake(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	c.setValidatorStake(ev.Address, ev.Stake)
+}
+
+func (c *ChainIndexer) handleEventStake(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventStake(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	c.setValidatorStake(ev.Address, ev.Stake)
+}
+
+func (c *ChainIndexer) handleEventSlash(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventSlash(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	c.logger.Info("validator slashed", "validator", ev.Address, "stake", ev.Stake, "unbonding", ev.Unbonding, "reason", ev.Reason)
+	c.setValidatorStake(ev.Address, 0)
+}
+
+func (c *ChainIndexer) setValidatorStake(address string, stake uint64) {
+	val, err := c.getValidatorByAddress(address)
+	if err != nil {
+		c.logger.Error("get validator fail", "err", err)
+		return
+	}
+	val.Stake = stake
+	if err := c.updateValidator(val); err != nil {
+		c.logger.Error("save validator fail", "err", err)
+	}
+}
+
+// handleEventAmendManifest hands an accepted manifest version to the agent,
+// so that it judges by the active manifest from then on.
+func (c *ChainIndexer) handleEventAmendManifest(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventAmendManifest(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	if !ev.Accepted {
+		c.logger.Info("manifest amendment rejected", "proposer", ev.ProposerAddress, "height", height)
+		return
+	}
+	manifest, err := c.queryManifest(ctx, ev.Version)
+	if err != nil {
+		c.logger.Error("query manifest fail", "version", ev.Version, "err", err)
+		return
+	}
+	err = ElizaCli.UpdateManifest(ctx, manifest.Version, manifest.Text)
+	if err != nil {
+		c.logger.Error("update manifest fail", "version", manifest.Version, "err", err)
+	}
+}
+
 func (c *ChainIndexer) handleEventProposal(ctx context.Context, event abci.Event, height int64) {
 	ev := hac_types.DecodeEventProposal(event)
 	if ev == nil {
@@ -229,6 +543,9 @@ func (c *ChainIndexer) handleEventProposal(ctx context.Context, event abci.Event
 	if err := c.db.Save(&proposal).Error; err != nil {
 		c.logger.Error("save proposal fail", "err", err)
 	}
+	if ev.Deposit != 0 && ev.DepositStatus != hac_types.DepositRefunded {
+		c.setValidatorStake(ev.ProposerAddress, ev.Stake)
+	}
 	err = ElizaCli.AddProposal(ctx, ev.ProposalIndex, ev.ProposerAddress, string(ev.Data))
 	if err != nil {
 		c.logger.Error("add proposal fail", "err", err)
@@ -241,6 +558,32 @@ func (c *ChainIndexer) handleEventProposal(ctx context.Context, event abci.Event
 	}
 }
 
+func (c *ChainIndexer) handleEventRationale(ctx context.Context, event abci.Event, height int64) {
+	ev := hac_types.DecodeEventRationale(event)
+	if ev == nil {
+		c.logger.Error("decode event fail", "event", event)
+		return
+	}
+	vote := ProposalVote{}
+	if err := c.db.Where("height = ? And voter_index = ? And proposal = ?", ev.Height, ev.Validator, ev.Proposal).First(&vote).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			c.logger.Error("get proposal vote fail", "err", err)
+			return
+		}
+		vote = ProposalVote{
+			Proposal:     ev.Proposal,
+			VoterIndex:   ev.Validator,
+			VoterAddress: ev.ValidatorAddress,
+			Height:       ev.Height,
+			Vote:         uint64(ev.VoteCode),
+		}
+	}
+	vote.Reason = ev.Reason
+	if err := c.db.Save(&vote).Error; err != nil {
+		c.logger.Error("save proposal vote fail", "err", err)
+	}
+}
+
 func (c *ChainIndexer) handleVote(ctx context.Context, height int64) error {
 	res, err := c.cli.Commit(ctx, &height)
 	if err != nil {
@@ -253,112 +596,132 @@ func (c *ChainIndexer) handleVote(ctx context.Context, height int64) error {
 				return err
 			}
 		}
+		return err
 	}
 	voteHeight := res.Height
-	// new proposal
-	newProposel := Proposal{}
-	if err := c.db.Where("new_height = ?", voteHeight).First(&newProposel).Error; err != nil {
-		if err != gorm.ErrRecordNotFound {
-			return err
-		}
+	blk, err := c.cli.Block(ctx, &voteHeight)
+	if err != nil {
+		c.logger.Error("get Block fail", "err", err)
+		return err
 	}
-	if newProposel.Id != 0 {
-		for _, v := range res.Commit.Signatures {
-			acc, err := c.queryAccount(ctx, 0, v.ValidatorAddress.String())
-			if err != nil {
+	// the commit carries one vote code for each governance tx of the block,
+	// in block order.
+	gov, proposals, grants := 0, 0, 0
+	for _, rawTx := range blk.Block.Txs {
+		btx, err := tx.UnmarshalHACTx(rawTx)
+		if err != nil || !btx.Governance() {
+			continue
+		}
+		idx := gov
+		gov++
+		switch stx := btx.Tx.(type) {
+		case *tx.ProposalTx:
+			// new proposals of the block are indexed in block order.
+			newProposals := make([]Proposal, 0)
+			if err := c.db.Where("new_height = ?", voteHeight).Order("id").Find(&newProposals).Error; err != nil {
 				return err
 			}
-			if acc == nil {
-				return fmt.Errorf("commit sig address not exist address:%s", v.ValidatorAddress.String())
+			if proposals >= len(newProposals) {
+				proposals++
+				continue
 			}
-			if err := c.db.Where("height = ? And voter_index = ?", voteHeight, acc.Index).First(&ProposalVote{}).Error; err != nil {
-				if err != gorm.ErrRecordNotFound {
-					return err
-				}
-				vote := ProposalVote{
-					Proposal:     newProposel.Id,
-					VoterIndex:   acc.Index,
-					VoterAddress: v.ValidatorAddress.String(),
-					Height:       uint64(voteHeight),
-					Vote:         uint64(v.VoteCode),
-				}
-				if err := c.db.Create(&vote).Error; err != nil {
-					return err
-				}
+			if err := c.saveProposalVotes(ctx, res.Commit.Signatures, newProposals[proposals].Id, uint64(voteHeight), idx); err != nil {
+				return err
+			}
+			proposals++
+		case *tx.SettleProposalTx:
+			if err := c.saveProposalVotes(ctx, res.Commit.Signatures, stx.Proposal, uint64(voteHeight), idx); err != nil {
+				return err
+			}
+		case *tx.GrantTx:
+			newGrants := make([]Grant, 0)
+			if err := c.db.Where("height = ?", voteHeight).Order("id").Find(&newGrants).Error; err != nil {
+				return err
+			}
+			if grants >= len(newGrants) {
+				grants++
+				continue
+			}
+			if err := c.saveGrantVotes(ctx, res.Commit.Signatures, &newGrants[grants], uint64(voteHeight), idx); err != nil {
+				return err
 			}
+			grants++
 		}
-		return nil
 	}
-	// settle proposal
-	settleProposel := Proposal{}
-	if err := c.db.Where("settle_height = ?", voteHeight).First(&settleProposel).Error; err != nil {
-		if err != gorm.ErrRecordNotFound {
+	return nil
+}
+
+// sigVoteCode returns the code a commit sig voted on the governance tx at
+// index idx, or VoteNoQuorum if the validator did not vote on it.
+func sigVoteCode(v cmttypes.CommitSig, idx int) uint64 {
+	code := int64(tx.VoteNoQuorum)
+	if idx < len(v.VoteCodes) {
+		code = v.VoteCodes[idx]
+	}
+	return uint64(code)
+}
+
+func (c *ChainIndexer) saveProposalVotes(ctx context.Context, sigs []cmttypes.CommitSig, proposal uint64, height uint64, idx int) error {
+	for _, v := range sigs {
+		if v.BlockIDFlag != cmttypes.BlockIDFlagCommit {
+			continue
+		}
+		acc, err := c.queryAccount(ctx, 0, v.ValidatorAddress.String())
+		if err != nil {
 			return err
 		}
-	}
-	if settleProposel.Id != 0 {
-		for _, v := range res.Commit.Signatures {
-			acc, err := c.queryAccount(ctx, 0, v.ValidatorAddress.String())
-			if err != nil {
+		if acc == nil {
+			return fmt.Errorf("commit sig address not exist address:%s", v.ValidatorAddress.String())
+		}
+		if err := c.db.Where("height = ? And voter_index = ? And proposal = ?", height, acc.Index, proposal).First(&ProposalVote{}).Error; err != nil {
+			if err != gorm.ErrRecordNotFound {
 				return err
 			}
-			if acc == nil {
-				return fmt.Errorf("commit sig address not exist address:%s", v.ValidatorAddress.String())
+			vote := ProposalVote{
+				Proposal:     proposal,
+				VoterIndex:   acc.Index,
+				VoterAddress: v.ValidatorAddress.String(),
+				Height:       height,
+				Vote:         sigVoteCode(v, idx),
 			}
-			if err := c.db.Where("height = ? And voter_index = ?", voteHeight, acc.Index).First(&ProposalVote{}).Error; err != nil {
-				if err != gorm.ErrRecordNotFound {
-					return err
-				}
-				vote := ProposalVote{
-					Proposal:     settleProposel.Id,
-					VoterIndex:   acc.Index,
-					VoterAddress: v.ValidatorAddress.String(),
-					Height:       uint64(voteHeight),
-					Vote:         uint64(v.VoteCode),
-				}
-				if err := c.db.Create(&vote).Error; err != nil {
-					return err
-				}
+			if err := c.db.Create(&vote).Error; err != nil {
+				return err
 			}
 		}
-		return nil
 	}
-	// grant grant
-	grant := Grant{}
-	if err := c.db.Where("height = ?", voteHeight).First(&grant).Error; err != nil {
-		if err != gorm.ErrRecordNotFound {
+	return nil
+}
+
+func (c *ChainIndexer) saveGrantVotes(ctx context.Context, sigs []cmttypes.CommitSig, grant *Grant, height uint64, idx int) error {
+	for _, v := range sigs {
+		if v.BlockIDFlag != cmttypes.BlockIDFlagCommit {
+			continue
+		}
+		acc, err := c.queryAccount(ctx, 0, v.ValidatorAddress.String())
+		if err != nil {
 			return err
 		}
-	}
-	if grant.Id != 0 {
-		for _, v := range res.Commit.Signatures {
-			acc, err := c.queryAccount(ctx, 0, v.ValidatorAddress.String())
-			if err != nil {
+		if acc == nil {
+			return fmt.Errorf("commit sig address not exist address:%s", v.ValidatorAddress.String())
+		}
+		if err := c.db.Where("height = ? And voter_index = ? And account_index = ?", height, acc.Index, grant.Id).First(&GrantVote{}).Error; err != nil {
+			if err != gorm.ErrRecordNotFound {
 				return err
 			}
-			if acc == nil {
-				return fmt.Errorf("commit sig address not exist address:%s", v.ValidatorAddress.String())
+			vote := GrantVote{
+				ProposerIndex:   grant.Proposer,
+				ProposerAddress: grant.ProposerAddress,
+				AccountIndex:    grant.Id,
+				AccountAddr:     grant.Address,
+				VoterIndex:      acc.Index,
+				VoterAddress:    acc.Address(),
+				Height:          height,
+				Vote:            sigVoteCode(v, idx),
 			}
-			if err := c.db.Where("height = ? And voter_index = ?", voteHeight, acc.Index).First(&GrantVote{}).Error; err != nil {
-				if err != gorm.ErrRecordNotFound {
-					return err
-				}
-				vote := GrantVote{
-					ProposerIndex:   grant.Proposer,
-					ProposerAddress: grant.ProposerAddress,
-					AccountIndex:    grant.Id,
-					AccountAddr:     grant.Address,
-					VoterIndex:      acc.Index,
-					VoterAddress:    acc.Address(),
-					Height:          uint64(voteHeight),
-					Vote:            uint64(v.VoteCode),
-				}
-				if err := c.db.Create(&vote).Error; err != nil {
-					return err
-				}
+			if err := c.db.Create(&vote).Error; err != nil {
+				return err
 			}
 		}
-		return nil
 	}
 	return nil
 }
@@ -386,10 +749,12 @@ func (c *ChainIndexer) Start(ctx context.Context) {
 		}
 
 		val := ValidatorAgent{
-			Id:      acc.Index,
-			Address: acc.Address(),
-			Stake:   acc.Stake,
-			Name:    acc.Name,
+			Id:        acc.Index,
+			Address:   acc.Address(),
+			Stake:     acc.Stake,
+			Name:      acc.Name,
+			AgentUrl:  acc.AgentUrl,
+			SelfIntro: acc.SelfIntro,
 		}
 		if err := c.db.Save(val).Error; err != nil {
 			panic(err)
@@ -441,6 +806,9 @@ func (c *ChainIndexer) Start(ctx context.Context) {
 						c.handleEvent(ctx, event, c.Height)
 					}
 				}
+				for _, event := range events.FinalizeBlockEvents {
+					c.handleEvent(ctx, event, c.Height)
+				}
 				err = c.handleVote(ctx, c.Height)
 				if err != nil {
 					c.logger.Error("handleVote fail", "height", c.Height, "err", err)
@@ -474,30 +842,39 @@ func (c *ChainIndexer) sendDiscussion(proposal uint64, text string) error {
 		return err
 	}
 	btx := tx.HACTx{
-		Version:   tx.HACTxVersion1,
+		Version:   tx.HACTxVersion2,
 		Nonce:     act.Nonce,
 		Validator: act.Index,
 	}
+	params, err := c.queryParams(context.Background())
+	if err != nil {
+		c.logger.Error("query params fail", "err", err)
+		return err
+	}
+	// the chain takes no discussion over the length limit; cut it at a
+	// rune boundary so it stays valid UTF-8.
+	if maxLen := int(params.MaxDiscussionLen); len(text) > maxLen {
+		for maxLen > 0 && !utf8.RuneStart(text[maxLen]) {
+			maxLen--
+		}
+		text = text[:maxLen]
+	}
 	discussion := &tx.DiscussionTx{
 		Proposal: proposal,
 		Data:     []byte(text),
 	}
 	btx.Tx = discussion
 	btx.Type = tx.HACTxTypeDiscussion
-	dat, err := btx.SigData([]byte(c.ChainId))
+	err = c.signTx(act, &btx)
 	if err != nil {
 		c.logger.Error("sign tx fail", "err", err)
 		return err
 	}
-	sigs := [][]byte{}
-	sig, err := c.pv.Sign(dat)
+	dat, err := tx.MarshalHACTx(&btx)
 	if err != nil {
-		c.logger.Error("sign tx fail", "err", err)
+		c.logger.Error("encode tx fail", "err", err)
 		return err
 	}
-	sigs = append(sigs, sig)
-	btx.Sig = sigs
-	dat, _ = json.Marshal(btx)
 	_, err = cli.BroadcastTxSync(context.Background(), dat)
 	if err != nil {
 		c.logger.Error("broadcast tx fail", "err", err)
@@ -507,6 +884,91 @@ func (c *ChainIndexer) sendDiscussion(proposal uint64, text string) error {
 	return nil
 }
 
+// sendUpdateProfile publishes the profile of the local validator on chain.
+func (c *ChainIndexer) sendUpdateProfile(name, agentUrl, selfIntro string) error {
+	cli, err := comethttp.New(c.chainUrl, "/websocket")
+	if err != nil {
+		c.logger.Error("new client fail", "err", err)
+		return err
+	}
+	act, err := queryAccount(cli, 0, c.LocalAddress)
+	if err != nil {
+		return err
+	}
+	btx := tx.HACTx{
+		Version:   tx.HACTxVersion2,
+		Nonce:     act.Nonce,
+		Validator: act.Index,
+		Type:      tx.HACTxTypeUpdateProfile,
+		Tx: &tx.UpdateProfileTx{
+			Name:      name,
+			AgentUrl:  agentUrl,
+			SelfIntro: selfIntro,
+		},
+	}
+	err = c.signTx(act, &btx)
+	if err != nil {
+		c.logger.Error("sign tx fail", "err", err)
+		return err
+	}
+	dat, err := tx.MarshalHACTx(&btx)
+	if err != nil {
+		c.logger.Error("encode tx fail", "err", err)
+		return err
+	}
+	res, err := cli.BroadcastTxSync(context.Background(), dat)
+	if err != nil {
+		c.logger.Error("broadcast tx fail", "err", err)
+		return err
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("update profile tx rejected: %v", res.Log)
+	}
+	c.logger.Info("send update profile", "name", name, "agentUrl", agentUrl)
+	return nil
+}
+
+// signTx signs btx for the account act of the local validator: with the
+// session key if there is one and the tx is of a type it may sign, so
+// that the validator key is not used for the agent's routine txs, else
+// with the validator key.
+func (c *ChainIndexer) signTx(act *state.Account, btx *tx.HACTx) error {
+	dat, err := btx.SigData([]byte(c.ChainId))
+	if err != nil {
+		return err
+	}
+	if c.session != nil && btx.Type.Delegable() {
+		sig, err := c.session.Sign(dat)
+		if err != nil {
+			return err
+		}
+		btx.Sig = [][]byte{sig}
+		return nil
+	}
+	sig, err := c.pv.Sign(dat)
+	if err != nil {
+		return err
+	}
+	btx.Sig = c.agentSigs(act, sig)
+	return nil
+}
+
+// agentSigs returns the signatures of a tx the agent's key signed alone as
+// sig, in the place of the key among the signers of a multi-signature
+// account act.
+func (c *ChainIndexer) agentSigs(act *state.Account, sig []byte) [][]byte {
+	if act.Threshold == 0 {
+		return [][]byte{sig}
+	}
+	sigs := make([][]byte, len(act.Signers))
+	for i, signer := range act.Signers {
+		if bytes.Equal(signer, c.pv.PublicKey()) {
+			sigs[i] = sig
+		}
+	}
+	return sigs
+}
+
 func (c *ChainIndexer) postPR(data, title string) error {
 	cli, err := comethttp.New(c.chainUrl, "/websocket")
 	if err != nil {
@@ -517,35 +979,36 @@ func (c *ChainIndexer) postPR(data, title string) error {
 	if err != nil {
 		return err
 	}
+	now, err := ChainTime(cli)
+	if err != nil {
+		c.logger.Error("get chain time fail", "err", err)
+		return err
+	}
 	btx := tx.HACTx{
-		Version:   tx.HACTxVersion1,
+		Version:   tx.HACTxVersion2,
 		Nonce:     act.Nonce,
 		Validator: act.Index,
 	}
 	pr := &tx.ProposalTx{
-		EndHeight:       1000000,
+		EndHeight:       0,
 		ImageUrl:        "",
 		Title:           title,
 		Link:            "",
 		Data:            []byte(data),
-		ExpireTimestamp: uint(time.Now().Add(time.Minute * 3).Unix()),
+		ExpireTimestamp: uint(now.Add(time.Minute * 3).Unix()),
 	}
 	btx.Tx = pr
 	btx.Type = tx.HACTxTypeProposal
-	dat, err := btx.SigData([]byte(c.ChainId))
+	err = c.signTx(act, &btx)
 	if err != nil {
 		c.logger.Error("sign tx fail", "err", err)
 		return err
 	}
-	sigs := [][]byte{}
-	sig, err := c.pv.Sign(dat)
+	dat, err := tx.MarshalHACTx(&btx)
 	if err != nil {
-		c.logger.Error("sign tx fail", "err", err)
+		c.logger.Error("encode tx fail", "err", err)
 		return err
 	}
-	sigs = append(sigs, sig)
-	btx.Sig = sigs
-	dat, _ = json.Marshal(btx)
 	_, err = cli.BroadcastTxSync(context.Background(), dat)
 	if err != nil {
 		c.logger.Error("broadcast tx fail", "err", err)
@@ -581,31 +1044,32 @@ func (c *ChainIndexer) settlePR(currentHeight uint64) {
 	if err != nil {
 		return
 	}
+	now, err := ChainTime(cli)
+	if err != nil {
+		c.logger.Error("get chain time fail", "err", err)
+		return
+	}
 	btx := tx.HACTx{
-		Version:   tx.HACTxVersion1,
+		Version:   tx.HACTxVersion2,
 		Nonce:     act.Nonce,
 		Validator: act.Index,
 	}
 	stx := &tx.SettleProposalTx{
 		Proposal:        p.Id,
-		ExpireTimestamp: uint(time.Now().Unix() + 60*3),
+		ExpireTimestamp: uint(now.Unix() + 60*3),
 	}
 	btx.Tx = stx
 	btx.Type = tx.HACTxTypeSettleProposal
-	dat, err := btx.SigData([]byte(c.ChainId))
+	err = c.signTx(act, &btx)
 	if err != nil {
 		c.logger.Error("sign tx fail", "err", err)
 		return
 	}
-	sigs := [][]byte{}
-	sig, err := c.pv.Sign(dat)
+	dat, err := tx.MarshalHACTx(&btx)
 	if err != nil {
-		c.logger.Error("sign tx fail", "err", err)
+		c.logger.Error("encode tx fail", "err", err)
 		return
 	}
-	sigs = append(sigs, sig)
-	btx.Sig = sigs
-	dat, _ = json.Marshal(btx)
 	_, err = cli.BroadcastTxSync(context.Background(), dat)
 	if err != nil {
 		c.logger.Error("broadcast tx fail", "err", err)
@@ -647,6 +1111,62 @@ func (c *ChainIndexer) randomDiscuss() {
 	c.logger.Info("comment proposal", "proposal", randProposal.Id, "comment", comment)
 }
 
+// queryManifest queries a version of the manifest, the active one if version
+// is 0.
+func (c *ChainIndexer) queryManifest(ctx context.Context, version uint64) (*hac_types.Manifest, error) {
+	var dat []byte
+	if version != 0 {
+		dat = binary.BigEndian.AppendUint64(nil, version)
+	}
+	res, err := c.cli.ABCIQuery(ctx, "/manifest/", dat)
+	if err != nil {
+		return nil, err
+	}
+	if res.Response.Code != 0 {
+		return nil, fmt.Errorf("query manifest response code %d: %s", res.Response.Code, res.Response.Log)
+	}
+	manifest := new(hac_types.Manifest)
+	err = json.Unmarshal(res.Response.Value, manifest)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// queryParams queries the chain params.
+func (c *ChainIndexer) queryParams(ctx context.Context) (*hac_types.Params, error) {
+	res, err := c.cli.ABCIQuery(ctx, "/params/", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.Response.Code != 0 {
+		return nil, fmt.Errorf("query params response code %d: %s", res.Response.Code, res.Response.Log)
+	}
+	params := new(hac_types.Params)
+	err = json.Unmarshal(res.Response.Value, params)
+	if err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// queryManifestHistory queries every version of the manifest, oldest first.
+func (c *ChainIndexer) queryManifestHistory(ctx context.Context) ([]*hac_types.Manifest, error) {
+	res, err := c.cli.ABCIQuery(ctx, "/manifest_history/", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.Response.Code != 0 {
+		return nil, fmt.Errorf("query manifest history response code %d: %s", res.Response.Code, res.Response.Log)
+	}
+	var history []*hac_types.Manifest
+	err = json.Unmarshal(res.Response.Value, &history)
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
 func (c *ChainIndexer) queryAccount(ctx context.Context, index uint64, address string) (*state.Account, error) {
 	var err error
 	var dat []byte
@@ -792,6 +1312,15 @@ func (c *ChainIndexer) getValidators() ([]ValidatorAgent, error) {
 	return validators, nil
 }
 
+func (c *ChainIndexer) getValidator(index uint64) (*ValidatorAgent, error) {
+	var val ValidatorAgent
+	err := c.db.Where("id = ?", index).First(&val).Error
+	if err != nil {
+		return nil, err
+	}
+	return &val, nil
+}
+
 func (c *ChainIndexer) GetValidatorByAddress(address string) (*ValidatorAgent, error) {
 	return c.getValidatorByAddress(address)
 }
@@ -823,6 +1352,91 @@ func (c *ChainIndexer) getGrants(page int, pageSize int) ([]Grant, uint64, error
 	return grants, total, nil
 }
 
+func (c *ChainIndexer) getDisqualifications(page int, pageSize int) ([]Disqualification, uint64, error) {
+	var dqs []Disqualification
+	err := c.db.Order("id desc").Offset(page * pageSize).Limit(pageSize).Find(&dqs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	var total uint64
+	err = c.db.Model(&Disqualification{}).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return dqs, total, nil
+}
+
+func (c *ChainIndexer) getApplications(status string, page int, pageSize int) ([]Application, uint64, error) {
+	var applications []Application
+	query := c.db.Model(&Application{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("id desc").Offset(page * pageSize).Limit(pageSize).Find(&applications).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	var total uint64
+	err = query.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return applications, total, nil
+}
+
+func (c *ChainIndexer) getGenericEvents(subtype string, page int, pageSize int) ([]GenericEvent, uint64, error) {
+	var events []GenericEvent
+	query := c.db.Model(&GenericEvent{})
+	if subtype != "" {
+		query = query.Where("subtype = ?", subtype)
+	}
+	err := query.Order("id desc").Offset(page * pageSize).Limit(pageSize).Find(&events).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	var total uint64
+	err = query.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+func (c *ChainIndexer) getRewards(address string, kind string, page int, pageSize int) ([]Reward, uint64, error) {
+	var rewards []Reward
+	query := c.db.Model(&Reward{})
+	if address != "" {
+		query = query.Where("address = ?", address)
+	}
+	if kind != "" {
+		query = query.Where("kind = ?", kind)
+	}
+	err := query.Order("id desc").Offset(page * pageSize).Limit(pageSize).Find(&rewards).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	var total uint64
+	err = query.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return rewards, total, nil
+}
+
+func (c *ChainIndexer) getDisqualificationsByTarget(targetAddr string, page int, pageSize int) ([]Disqualification, uint64, error) {
+	var dqs []Disqualification
+	err := c.db.Where("target_address = ?", targetAddr).Order("id desc").Offset(page * pageSize).Limit(pageSize).Find(&dqs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	var total uint64
+	err = c.db.Model(&Disqualification{}).Where("target_address = ?", targetAddr).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return dqs, total, nil
+}
+
 func (c *ChainIndexer) getProposalByHeight(height uint64) (*Proposal, error) {
 	var proposal Proposal
 	err := c.db.Where("new_height = ?", height).First(&proposal).Error
@@ -868,6 +1482,16 @@ func (c *ChainIndexer) getGrantVotesByVoter(voter string, page int, pageSize int
 	return votes, nil
 }
 
+// ChainTime returns the time of the latest block. Deadlines are set against
+// it rather than the local clock, as expiry is judged on block time.
+func ChainTime(cli *comethttp.HTTP) (time.Time, error) {
+	res, err := cli.Status(context.Background())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return res.SyncInfo.LatestBlockTime, nil
+}
+
 func queryAccount(cli *comethttp.HTTP, index uint64, address string) (*state.Account, error) {
 	ctx := context.Background()
 	var dat []byte
@@ -51,6 +51,7 @@ type ProposalVote struct {
 	VoterAddress string `json:"voter_address"`
 	Height       uint64 `json:"height"`
 	Vote         uint64 `json:"vote"`
+	Reason       string `json:"reason"`
 }
 
 type GrantVote struct {
@@ -76,3 +77,65 @@ type Discussion struct {
 	Height          uint64 `json:"height"`
 	CreateTimestamp int64  `json:"create_timestamp"`
 }
+
+type Disqualification struct {
+	Id              uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	TargetIndex     uint64 `json:"target_index"`
+	TargetAddress   string `json:"target_address"`
+	TargetName      string `json:"target_name"`
+	AccuserIndex    uint64 `json:"accuser_index"`
+	AccuserAddress  string `json:"accuser_address"`
+	AccuserName     string `json:"accuser_name"`
+	Clause          string `json:"clause"`
+	Evidence        string `json:"evidence"`
+	Stake           uint64 `json:"stake"`
+	Expelled        bool   `json:"expelled"`
+	VoteCode        int64  `json:"vote_code"`
+	Height          uint64 `json:"height"`
+	CreateTimestamp int64  `json:"create_timestamp"`
+}
+
+const (
+	ApplicationStatusPending  = "pending"
+	ApplicationStatusGranted  = "granted"
+	ApplicationStatusRejected = "rejected"
+	ApplicationStatusExpired  = "expired"
+)
+
+type Application struct {
+	Id              uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	Address         string `json:"address"`
+	Pubkey          string `json:"pubkey"`
+	Name            string `json:"name"`
+	AgentUrl        string `json:"agent_url"`
+	Statement       string `json:"statement"`
+	ManifestVersion uint64 `json:"manifest_version"`
+	Status          string `json:"status"`
+	Height          uint64 `json:"height"`
+	ExpireHeight    uint64 `json:"expire_height"`
+	CreateTimestamp int64  `json:"create_timestamp"`
+}
+
+// GenericEvent is an event of a generic tx, recorded as is for the module
+// of Subtype.
+type GenericEvent struct {
+	Id              uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	Subtype         string `gorm:"index" json:"subtype"`
+	Type            string `json:"type"`
+	Attributes      string `json:"attributes"`
+	Height          uint64 `json:"height"`
+	CreateTimestamp int64  `json:"create_timestamp"`
+}
+
+// Reward is stake issued to a member for its participation, of Kind
+// commit, proposal or discussion.
+type Reward struct {
+	Id              uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	AccountIndex    uint64 `json:"account_index"`
+	Address         string `gorm:"index" json:"address"`
+	Kind            string `json:"kind"`
+	Proposal        uint64 `json:"proposal"`
+	Amount          uint64 `json:"amount"`
+	Height          uint64 `json:"height"`
+	CreateTimestamp int64  `json:"create_timestamp"`
+}
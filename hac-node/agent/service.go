@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
 )
 
 type Service struct {
@@ -26,10 +27,15 @@ func NewService(ListenAddr string, indexer *ChainIndexer) *Service {
 	g.POST("/proposals", s.handleGetProposals)
 	g.POST("/discussions", s.handleGetDiscussions)
 	g.POST("/grants", s.handleGetGrants)
+	g.POST("/disqualifications", s.handleGetDisqualifications)
+	g.POST("/applications", s.handleGetApplications)
+	g.POST("/generic-events", s.handleGetGenericEvents)
+	g.POST("/rewards", s.handleGetRewards)
 	g.POST("/agents", s.handleGetAgents)
 	g.POST("/agent-detail", s.handleGetAgentDetail)
 	g.POST("/proposal-detail", s.handleGetProposalDetail)
 	g.GET("/manifesto", s.handleGetManifesto)
+	g.GET("/manifesto-history", s.handleGetManifestoHistory)
 	g.GET("/network-status", s.handleGetNetworkStatus)
 	g.GET("/latest-blocks", s.handleGetLatestBlocks)
 	g.POST("/register-agent", s.handleRegisterAgent)
@@ -50,6 +56,7 @@ type VoteInfo struct {
 	VoterAddress string `json:"voter_address"`
 	Height       uint64 `json:"height"`
 	VoteCode     uint64 `json:"voteCode"`
+	Reason       string `json:"reason"`
 }
 type ProposalInfo struct {
 	Proposal       Proposal   `json:"proposal"`
@@ -80,8 +87,9 @@ type GrantInfo struct {
 }
 
 type AgentInfo struct {
-	Agent     ValidatorAgent `json:"agent"`
-	Proposals []ProposalInfo `json:"proposals"`
+	Agent             ValidatorAgent     `json:"agent"`
+	Proposals         []ProposalInfo     `json:"proposals"`
+	Disqualifications []Disqualification `json:"disqualifications"`
 }
 
 type GetGrantsReq struct {
@@ -135,15 +143,45 @@ func (s *Service) handleGetAgentDetail(c *gin.Context) {
 		}
 		response.AgentInfo.Proposals = append(response.AgentInfo.Proposals, proposalInfo)
 	}
+	dqs, _, err := s.indexer.getDisqualificationsByTarget(requestData.Address, 0, 1000)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	response.AgentInfo.Disqualifications = dqs
 	c.JSON(http.StatusOK, response)
 }
 
 type GetManifestoResponse struct {
 	Manifesto string `json:"manifesto"`
+	Version   uint64 `json:"version"`
+	Height    uint64 `json:"height"`
 }
 
 func (s *Service) handleGetManifesto(c *gin.Context) {
-	c.JSON(http.StatusOK, GetManifestoResponse{Manifesto: MANIFESTO})
+	manifest, err := s.indexer.queryManifest(c.Request.Context(), 0)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, GetManifestoResponse{
+		Manifesto: manifest.Text,
+		Version:   manifest.Version,
+		Height:    manifest.Height,
+	})
+}
+
+type GetManifestoHistoryResponse struct {
+	Versions []*hac_types.Manifest `json:"versions"`
+}
+
+func (s *Service) handleGetManifestoHistory(c *gin.Context) {
+	history, err := s.indexer.queryManifestHistory(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, GetManifestoHistoryResponse{Versions: history})
 }
 
 type GetNetworkStatusResponse struct {
@@ -235,6 +273,12 @@ func (s *Service) handleRegisterAgent(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "local validator not found"})
 		return
 	}
+	// the profile is published on chain for the other nodes to follow.
+	err = s.indexer.sendUpdateProfile(requestData.Name, requestData.AgentUrl, requestData.SelfIntro)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 	validator.AgentUrl = requestData.AgentUrl
 	validator.SelfIntro = requestData.SelfIntro
 	validator.Name = requestData.Name
@@ -360,6 +404,136 @@ func (s *Service) handleGetGrants(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+type GetDisqualificationsReq struct {
+	TargetAddress string `json:"targetAddress"`
+	Page          int    `json:"page"`
+	PageSize      int    `json:"pageSize"`
+}
+
+type GetDisqualificationsResponse struct {
+	Disqualifications []Disqualification `json:"disqualifications"`
+	Total             uint64             `json:"total"`
+}
+
+func (s *Service) handleGetDisqualifications(c *gin.Context) {
+	var response GetDisqualificationsResponse
+	response.Disqualifications = make([]Disqualification, 0)
+	var requestData GetDisqualificationsReq
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	requestData.Page -= 1
+	var (
+		dqs   []Disqualification
+		total uint64
+		err   error
+	)
+	if requestData.TargetAddress != "" {
+		dqs, total, err = s.indexer.getDisqualificationsByTarget(requestData.TargetAddress, requestData.Page, requestData.PageSize)
+	} else {
+		dqs, total, err = s.indexer.getDisqualifications(requestData.Page, requestData.PageSize)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	response.Disqualifications = append(response.Disqualifications, dqs...)
+	response.Total = total
+	c.JSON(http.StatusOK, response)
+}
+
+type GetApplicationsReq struct {
+	Status   string `json:"status"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"pageSize"`
+}
+
+type GetApplicationsResponse struct {
+	Applications []Application `json:"applications"`
+	Total        uint64        `json:"total"`
+}
+
+func (s *Service) handleGetApplications(c *gin.Context) {
+	var response GetApplicationsResponse
+	response.Applications = make([]Application, 0)
+	var requestData GetApplicationsReq
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	requestData.Page -= 1
+	applications, total, err := s.indexer.getApplications(requestData.Status, requestData.Page, requestData.PageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	response.Applications = append(response.Applications, applications...)
+	response.Total = total
+	c.JSON(http.StatusOK, response)
+}
+
+type GetGenericEventsReq struct {
+	Subtype  string `json:"subtype"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"pageSize"`
+}
+
+type GetGenericEventsResponse struct {
+	Events []GenericEvent `json:"events"`
+	Total  uint64         `json:"total"`
+}
+
+func (s *Service) handleGetGenericEvents(c *gin.Context) {
+	var response GetGenericEventsResponse
+	response.Events = make([]GenericEvent, 0)
+	var requestData GetGenericEventsReq
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	requestData.Page -= 1
+	events, total, err := s.indexer.getGenericEvents(requestData.Subtype, requestData.Page, requestData.PageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	response.Events = append(response.Events, events...)
+	response.Total = total
+	c.JSON(http.StatusOK, response)
+}
+
+type GetRewardsReq struct {
+	Address  string `json:"address"`
+	Kind     string `json:"kind"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"pageSize"`
+}
+
+type GetRewardsResponse struct {
+	Rewards []Reward `json:"rewards"`
+	Total   uint64   `json:"total"`
+}
+
+func (s *Service) handleGetRewards(c *gin.Context) {
+	var response GetRewardsResponse
+	response.Rewards = make([]Reward, 0)
+	var requestData GetRewardsReq
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	requestData.Page -= 1
+	rewards, total, err := s.indexer.getRewards(requestData.Address, requestData.Kind, requestData.Page, requestData.PageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	response.Rewards = append(response.Rewards, rewards...)
+	response.Total = total
+	c.JSON(http.StatusOK, response)
+}
+
 type GetDiscussionReq struct {
 	ProposalId uint64 `json:"proposalId"`
 	Page       int    `json:"page"`
@@ -652,6 +826,7 @@ func ProposalVotesToVoteInfo(votes []ProposalVote) ([]VoteInfo, []VoteInfo) {
 				VoterAddress: vote.VoterAddress,
 				Height:       vote.Height,
 				VoteCode:     vote.Vote,
+				Reason:       vote.Reason,
 			})
 		case uint64(tx.VoteProcessProposal):
 			proposalInfo.DraftVotes = append(proposalInfo.DraftVotes, VoteInfo{
@@ -660,6 +835,7 @@ func ProposalVotesToVoteInfo(votes []ProposalVote) ([]VoteInfo, []VoteInfo) {
 				VoterAddress: vote.VoterAddress,
 				Height:       vote.Height,
 				VoteCode:     vote.Vote,
+				Reason:       vote.Reason,
 			})
 		case uint64(tx.VoteRejectProposal):
 			proposalInfo.DecisionVote = append(proposalInfo.DecisionVote, VoteInfo{
@@ -668,6 +844,7 @@ func ProposalVotesToVoteInfo(votes []ProposalVote) ([]VoteInfo, []VoteInfo) {
 				VoterAddress: vote.VoterAddress,
 				Height:       vote.Height,
 				VoteCode:     vote.Vote,
+				Reason:       vote.Reason,
 			})
 		case uint64(tx.VoteAcceptProposal):
 			proposalInfo.DecisionVote = append(proposalInfo.DecisionVote, VoteInfo{
@@ -676,6 +853,7 @@ func ProposalVotesToVoteInfo(votes []ProposalVote) ([]VoteInfo, []VoteInfo) {
 				VoterAddress: vote.VoterAddress,
 				Height:       vote.Height,
 				VoteCode:     vote.Vote,
+				Reason:       vote.Reason,
 			})
 		}
 	}
@@ -107,36 +107,139 @@ func (e *AgentClient) GetSelfIntro(ctx context.Context) (string, error) {
 	return "", nil
 }
 
-func (e *AgentClient) IfAcceptProposal(ctx context.Context, proposal uint64, voter string) (bool, error) {
-	e.logger.Info("IfAcceptProposal", "proposal", proposal, "voter", voter)
+func (e *AgentClient) IfAcceptProposal(ctx context.Context, proposal uint64, voter string, options []string) (*VoteResponse, error) {
+	e.logger.Info("IfAcceptProposal", "proposal", proposal, "voter", voter, "options", options)
 	url, _ := neturl.JoinPath(e.Url, "/voteproposal")
-	body := fmt.Sprintf(`{"proposalId":"%d"}`, proposal)
-	res, err := http.Post(url, "application/json", bytes.NewBuffer([]byte(body)))
+	req := VoteProposalReq{
+		ProposalId: fmt.Sprintf("%d", proposal),
+		Options:    options,
+	}
+	data, _ := json.Marshal(&req)
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	defer res.Body.Close()
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
 		e.logger.Error("read response body fail", "err", err)
-		return false, err
+		return nil, err
 	}
 	var vote VoteResponse
 	err = json.Unmarshal(bodyBytes, &vote)
 	if err != nil {
 		e.logger.Error("unmarshal response body fail", "err", err)
-		return false, err
+		return nil, err
 	}
 	e.logger.Info("vote proposal", "proposal", proposal, "voter", voter, "vote", vote.Vote, "reason", vote.Reason)
-	if vote.Vote == "yes" {
-		return true, nil
-	}
-	return false, nil
+	return &vote, nil
 }
 
 // IfGrantNewMember implements Client.
-func (e *AgentClient) IfGrantNewMember(ctx context.Context, validator uint64, proposer string, amount uint64, statement string) (bool, error) {
-	return true, nil
+func (e *AgentClient) IfGrantNewMember(ctx context.Context, validator uint64, proposer string, amount uint64, statement string) (*VoteResponse, error) {
+	return &VoteResponse{Vote: VoteYes}, nil
+}
+
+func (e *AgentClient) IfDisqualifyMember(ctx context.Context, target string, accuser string, clause string, evidence string) (*VoteResponse, error) {
+	e.logger.Info("IfDisqualifyMember", "target", target, "accuser", accuser, "clause", clause)
+	url, _ := neturl.JoinPath(e.Url, "/if_disqualify")
+	req := VoteDisqualifyReq{
+		TargetAddress:  target,
+		AccuserAddress: accuser,
+		Clause:         clause,
+		Evidence:       evidence,
+	}
+	data, _ := json.Marshal(&req)
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		e.logger.Error("read response body fail", "err", err)
+		return nil, err
+	}
+	var vote VoteResponse
+	err = json.Unmarshal(bodyBytes, &vote)
+	if err != nil {
+		e.logger.Error("unmarshal response body fail", "err", err)
+		return nil, err
+	}
+	e.logger.Info("vote disqualify", "target", target, "vote", vote.Vote, "reason", vote.Reason)
+	return &vote, nil
+}
+
+func (e *AgentClient) IfAmendManifest(ctx context.Context, proposer string, manifest string, amended string, reason string) (*VoteResponse, error) {
+	e.logger.Info("IfAmendManifest", "proposer", proposer, "reason", reason)
+	url, _ := neturl.JoinPath(e.Url, "/if_amend_manifest")
+	req := VoteAmendManifestReq{
+		ProposerAddress: proposer,
+		Manifest:        manifest,
+		Amended:         amended,
+		Reason:          reason,
+	}
+	data, _ := json.Marshal(&req)
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		e.logger.Error("read response body fail", "err", err)
+		return nil, err
+	}
+	var vote VoteResponse
+	err = json.Unmarshal(bodyBytes, &vote)
+	if err != nil {
+		e.logger.Error("unmarshal response body fail", "err", err)
+		return nil, err
+	}
+	e.logger.Info("vote amendment", "proposer", proposer, "vote", vote.Vote, "reason", vote.Reason)
+	return &vote, nil
+}
+
+func (e *AgentClient) IfAmendParams(ctx context.Context, proposer string, params string, amended string, reason string) (*VoteResponse, error) {
+	e.logger.Info("IfAmendParams", "proposer", proposer, "reason", reason)
+	url, _ := neturl.JoinPath(e.Url, "/if_amend_params")
+	req := VoteAmendParamsReq{
+		ProposerAddress: proposer,
+		Params:          params,
+		Amended:         amended,
+		Reason:          reason,
+	}
+	data, _ := json.Marshal(&req)
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		e.logger.Error("read response body fail", "err", err)
+		return nil, err
+	}
+	var vote VoteResponse
+	err = json.Unmarshal(bodyBytes, &vote)
+	if err != nil {
+		e.logger.Error("unmarshal response body fail", "err", err)
+		return nil, err
+	}
+	e.logger.Info("vote params amendment", "proposer", proposer, "vote", vote.Vote, "reason", vote.Reason)
+	return &vote, nil
+}
+
+func (e *AgentClient) UpdateManifest(ctx context.Context, version uint64, manifest string) error {
+	e.logger.Info("UpdateManifest", "version", version)
+	url, _ := neturl.JoinPath(e.Url, "/update_manifest")
+	data, _ := json.Marshal(&UpdateManifestReq{Version: version, Manifest: manifest})
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return nil
 }
 
 type IfProcessProposalReq struct {
@@ -144,7 +247,7 @@ type IfProcessProposalReq struct {
 	Title    string `json:"title"`
 }
 
-func (e *AgentClient) IfProcessProposal(ctx context.Context, proposal, title string) (bool, error) {
+func (e *AgentClient) IfProcessProposal(ctx context.Context, proposal, title string) (*VoteResponse, error) {
 	e.logger.Info("IfProcessProposal", "proposal", proposal, "title", title)
 	url, _ := neturl.JoinPath(e.Url, "/if_process_pr")
 	req := IfProcessProposalReq{
@@ -152,25 +255,22 @@ func (e *AgentClient) IfProcessProposal(ctx context.Context, proposal, title str
 		Title:    title,
 	}
 	data, _ := json.Marshal(&req)
-	res, err := http.Post(url, "application/json", bytes.NewBuffer(data))
+	res, err := postJSON(ctx, url, bytes.NewBuffer(data))
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	defer res.Body.Close()
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
 		e.logger.Error("read response body fail", "err", err)
-		return false, err
+		return nil, err
 	}
 	var vote VoteResponse
 	err = json.Unmarshal(bodyBytes, &vote)
 	if err != nil {
 		e.logger.Error("unmarshal response body fail", "err", err)
-		return false, err
+		return nil, err
 	}
 	e.logger.Info("vote proposal", "proposal", proposal, "vote", vote.Vote, "reason", vote.Reason)
-	if vote.Vote == "yes" {
-		return true, nil
-	}
-	return false, nil
+	return &vote, nil
 }
@@ -0,0 +1,331 @@
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hetu-project/hetu-chaoschain/agent"
+	"github.com/hetu-project/hetu-chaoschain/config"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+const (
+	// maxLastVotes bounds the answers kept for the AgentFallbackLast policy.
+	maxLastVotes = 1024
+	// maxVerdicts bounds the pre-evaluated verdicts waiting for their tx
+	// to be proposed; past it new txs are judged when proposed.
+	maxVerdicts = 1024
+	// verdictBlocks is how long a pre-evaluated verdict waits for its tx
+	// to be proposed, so that the verdicts of txs that left the mempool
+	// without making it into a block do not fill up maxVerdicts.
+	verdictBlocks = 100
+)
+
+// agentQuery is an agent vote call on a governance tx. Everything the call
+// needs is taken from the state when the query is built, so it can run in
+// the background.
+type agentQuery struct {
+	subject string
+	options []string
+	call    func(ctx context.Context) (*agent.VoteResponse, error)
+}
+
+// verdict is the outcome of an agent vote call started at CheckTx on the
+// state at height.
+type verdict struct {
+	height   uint64
+	done     chan struct{}
+	vote     *agent.VoteResponse
+	fallback string
+}
+
+// newAgentQuery builds the vote call judging btx, or returns nil if btx is
+// not a governance tx.
+func (app *HACApp) newAgentQuery(st *state.State, btx *tx.HACTx, txHash string) (*agentQuery, error) {
+	switch stx := btx.Tx.(type) {
+	case *tx.GrantTx:
+		if len(stx.Grants) != 1 {
+			return nil, ErrOnlySupportOneGrant
+		}
+		proposerAct, err := st.GetAccount(btx.Validator)
+		if err != nil {
+			return nil, err
+		}
+		if proposerAct == nil {
+			return nil, errors.New("proposer not found")
+		}
+		grant, err := st.ResolveGrant(&stx.Grants[0])
+		if err != nil {
+			return nil, err
+		}
+		validator, proposer := st.Header().AccountIdx, proposerAct.Address()
+		return &agentQuery{
+			subject: "grant:" + hex.EncodeToString(grant.Pubkey),
+			call: func(ctx context.Context) (*agent.VoteResponse, error) {
+				return agent.ElizaCli.IfGrantNewMember(ctx, validator, proposer, grant.Amount, grant.Statement)
+			},
+		}, nil
+	case *tx.ProposalTx:
+		return &agentQuery{
+			subject: "process:" + txHash,
+			call: func(ctx context.Context) (*agent.VoteResponse, error) {
+				return agent.ElizaCli.IfProcessProposal(ctx, string(stx.Data), stx.Title)
+			},
+		}, nil
+	case *tx.SettleProposalTx:
+		voterAct, err := st.GetAccount(btx.Validator)
+		if err != nil {
+			return nil, err
+		}
+		if voterAct == nil {
+			return nil, errors.New("voter not found")
+		}
+		proposal, err := st.GetProposal(stx.Proposal)
+		if err != nil {
+			return nil, err
+		}
+		voter := voterAct.Address()
+		return &agentQuery{
+			subject: fmt.Sprintf("settle:%d", stx.Proposal),
+			options: proposal.Options,
+			call: func(ctx context.Context) (*agent.VoteResponse, error) {
+				return agent.ElizaCli.IfAcceptProposal(ctx, stx.Proposal, voter, proposal.Options)
+			},
+		}, nil
+	case *tx.DisqualifyTx:
+		accuserAct, err := st.GetAccount(btx.Validator)
+		if err != nil {
+			return nil, err
+		}
+		if accuserAct == nil {
+			return nil, errors.New("accuser not found")
+		}
+		targetAct, err := st.GetAccount(stx.Target)
+		if err != nil {
+			return nil, err
+		}
+		if targetAct == nil {
+			return nil, errors.New("target not found")
+		}
+		target, accuser := targetAct.Address(), accuserAct.Address()
+		return &agentQuery{
+			subject: fmt.Sprintf("disqualify:%d", stx.Target),
+			call: func(ctx context.Context) (*agent.VoteResponse, error) {
+				return agent.ElizaCli.IfDisqualifyMember(ctx, target, accuser, stx.Clause, stx.Evidence)
+			},
+		}, nil
+	case *tx.AmendManifestTx:
+		proposerAct, err := st.GetAccount(btx.Validator)
+		if err != nil {
+			return nil, err
+		}
+		if proposerAct == nil {
+			return nil, errors.New("proposer not found")
+		}
+		manifest, err := st.GetManifest()
+		if err != nil {
+			return nil, err
+		}
+		amended, err := stx.Amend(manifest)
+		if err != nil {
+			return nil, err
+		}
+		proposer := proposerAct.Address()
+		return &agentQuery{
+			subject: "amend:" + txHash,
+			call: func(ctx context.Context) (*agent.VoteResponse, error) {
+				return agent.ElizaCli.IfAmendManifest(ctx, proposer, manifest, amended, stx.Reason)
+			},
+		}, nil
+	case *tx.AmendParamsTx:
+		proposerAct, err := st.GetAccount(btx.Validator)
+		if err != nil {
+			return nil, err
+		}
+		if proposerAct == nil {
+			return nil, errors.New("proposer not found")
+		}
+		params, amended, err := st.ResolveAmendParams(stx)
+		if err != nil {
+			return nil, err
+		}
+		paramsBz, _ := json.Marshal(params)
+		amendedBz, _ := json.Marshal(amended)
+		proposer := proposerAct.Address()
+		return &agentQuery{
+			subject: "amend_params:" + txHash,
+			call: func(ctx context.Context) (*agent.VoteResponse, error) {
+				return agent.ElizaCli.IfAmendParams(ctx, proposer, string(paramsBz), string(amendedBz), stx.Reason)
+			},
+		}, nil
+	case *tx.GenericTx:
+		return moduleQuery(st, btx, stx)
+	}
+	return nil, nil
+}
+
+// preEvaluate starts judging a governance tx that passed CheckTx in the
+// background, so that by the time it is proposed the verdict is cached and
+// consensus does not wait on the agent.
+func (app *HACApp) preEvaluate(st *state.State, txHash string, btx *tx.HACTx) {
+	q, err := app.newAgentQuery(st, btx, txHash)
+	if err != nil || q == nil {
+		return
+	}
+	app.mtx.Lock()
+	if _, ok := app.verdicts[txHash]; ok || len(app.verdicts) >= maxVerdicts {
+		app.mtx.Unlock()
+		return
+	}
+	v := &verdict{height: st.Header().Height, done: make(chan struct{})}
+	app.verdicts[txHash] = v
+	app.mtx.Unlock()
+
+	app.logger.Info("agent pre-evaluation started", "tx", txHash, "subject", q.subject)
+	go func() {
+		v.vote, v.fallback = app.askAgent(context.Background(), q)
+		close(v.done)
+	}()
+}
+
+// evaluate returns the agent's verdict on a governance tx. A verdict
+// pre-evaluated at CheckTx is used as is; one still pending is waited for
+// within the agent timeout, and a failed one is asked again with what is
+// left of it, so that judging a tx never takes more than one timeout.
+func (app *HACApp) evaluate(ctx context.Context, txHash string, q *agentQuery) (vote *agent.VoteResponse, fallback string) {
+	if app.cfg.AgentTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, app.cfg.AgentTimeout)
+		defer cancel()
+	}
+	app.mtx.Lock()
+	v, ok := app.verdicts[txHash]
+	app.mtx.Unlock()
+	if ok {
+		select {
+		case <-v.done:
+			if v.fallback == "" {
+				return v.vote, ""
+			}
+		case <-ctx.Done():
+			app.logger.Error("agent pre-evaluation pending", "tx", txHash, "subject", q.subject)
+			return app.fallbackVote(q.subject, hac_types.FallbackReasonTimeout), hac_types.FallbackReasonTimeout
+		}
+	}
+	return app.askAgent(ctx, q)
+}
+
+// judge returns the verdict this node votes with on a governance tx in round
+// of the state's height. A verdict already voted with is read back from the
+// verdict store, so a node replaying ProcessProposal after a crash never
+// contradicts the vote code it signed; otherwise the agent is asked and
+// its verdict stored before it is used.
+func (app *HACApp) judge(ctx context.Context, st *state.State, round int32, txHash string, q *agentQuery, yes, no tx.VoteCode) (v *state.Verdict, err error) {
+	height := st.Header().Height
+	v, err = app.verdictDB.Get(height, round, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if v != nil {
+		app.logger.Info("agent verdict replayed", "height", height, "round", round, "tx", txHash, "code", v.Code)
+		return v, nil
+	}
+	vote, fallback := app.evaluate(ctx, txHash, q)
+	v = &state.Verdict{
+		Height:   height,
+		Round:    round,
+		TxHash:   txHash,
+		Code:     int64(voteCode(vote, yes, no, q.options)),
+		Reason:   vote.Reason,
+		Fallback: fallback,
+	}
+	err = app.verdictDB.Put(v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// forgetVerdicts drops the verdicts of txs that made it into a block, or
+// that left the mempool.
+func (app *HACApp) forgetVerdicts(txHashes []string) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	for _, h := range txHashes {
+		delete(app.verdicts, h)
+	}
+}
+
+// expireVerdicts drops the verdicts pre-evaluated verdictBlocks or more
+// before height.
+func (app *HACApp) expireVerdicts(height uint64) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	for h, v := range app.verdicts {
+		if v.height+verdictBlocks <= height {
+			delete(app.verdicts, h)
+		}
+	}
+}
+
+// askAgent makes an agent vote call with a deadline and bounded retries. If
+// every attempt fails the vote is cast by the configured fallback policy
+// and the returned fallback holds the reason code, so a broken agent costs
+// only its own vote and never stalls the block.
+func (app *HACApp) askAgent(ctx context.Context, q *agentQuery) (vote *agent.VoteResponse, fallback string) {
+	attempts := app.cfg.AgentRetries + 1
+	timeout := app.cfg.AgentTimeout / time.Duration(attempts)
+	var err error
+	for i := 0; i < attempts; i++ {
+		callCtx, cancel := ctx, context.CancelFunc(func() {})
+		if timeout > 0 {
+			callCtx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		vote, err = q.call(callCtx)
+		cancel()
+		if err == nil && vote != nil {
+			app.mtx.Lock()
+			if len(app.lastVotes) >= maxLastVotes {
+				app.lastVotes = make(map[string]*agent.VoteResponse)
+			}
+			app.lastVotes[q.subject] = vote
+			app.mtx.Unlock()
+			return vote, ""
+		}
+		if err == nil {
+			err = errors.New("empty agent vote")
+		}
+		app.logger.Info("agent vote call fail", "subject", q.subject, "attempt", i+1, "err", err)
+	}
+	fallback = hac_types.FallbackReasonError
+	if errors.Is(err, context.DeadlineExceeded) {
+		fallback = hac_types.FallbackReasonTimeout
+	}
+	vote = app.fallbackVote(q.subject, fallback)
+	app.logger.Error("agent vote fallback", "subject", q.subject, "reason", fallback, "policy", app.cfg.AgentFallback, "vote", vote.Vote)
+	return vote, fallback
+}
+
+func (app *HACApp) fallbackVote(subject, reason string) *agent.VoteResponse {
+	vote := &agent.VoteResponse{
+		Vote:   agent.VoteAbstain,
+		Reason: fmt.Sprintf("%s, fallback to %s", reason, app.cfg.AgentFallback),
+	}
+	switch app.cfg.AgentFallback {
+	case config.AgentFallbackReject:
+		vote.Vote = agent.VoteNo
+	case config.AgentFallbackLast:
+		app.mtx.Lock()
+		if last, ok := app.lastVotes[subject]; ok {
+			vote.Vote = last.Vote
+		}
+		app.mtx.Unlock()
+	}
+	return vote
+}
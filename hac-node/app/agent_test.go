@@ -0,0 +1,315 @@
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/hetu-project/hetu-chaoschain/agent"
+	"github.com/hetu-project/hetu-chaoschain/config"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// testAgentClient answers proposals with vote and counts the calls.
+type testAgentClient struct {
+	*agent.MockClient
+	vote  string
+	calls atomic.Int32
+}
+
+func (c *testAgentClient) IfProcessProposal(ctx context.Context, proposal, title string) (*agent.VoteResponse, error) {
+	c.calls.Add(1)
+	return &agent.VoteResponse{Vote: c.vote, Reason: "agent"}, nil
+}
+
+// useTestAgent makes the agent of the tests answer with vote.
+func useTestAgent(t *testing.T, vote string) *testAgentClient {
+	t.Helper()
+	old := agent.ElizaCli
+	c := &testAgentClient{MockClient: agent.NewMockClient(), vote: vote}
+	agent.ElizaCli = c
+	t.Cleanup(func() { agent.ElizaCli = old })
+	return c
+}
+
+// testVerdict returns the verdict pre-evaluated for rawTx, waiting for it,
+// or nil if there is none.
+func testVerdict(t *testing.T, app *HACApp, rawTx []byte) *verdict {
+	t.Helper()
+	app.mtx.Lock()
+	v := app.verdicts[hex.EncodeToString(tmhash.Sum(rawTx))]
+	app.mtx.Unlock()
+	if v == nil {
+		return nil
+	}
+	select {
+	case <-v.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("pre-evaluation pending")
+	}
+	return v
+}
+
+// testQuery returns a vote query on subject answered by answers in turn,
+// the last one repeated, and its count of calls. A nil answer blocks until
+// the call deadline.
+func testQuery(subject string, answers ...*agent.VoteResponse) (*agentQuery, *atomic.Int32) {
+	calls := new(atomic.Int32)
+	return &agentQuery{
+		subject: subject,
+		call: func(ctx context.Context) (*agent.VoteResponse, error) {
+			i := int(calls.Add(1)) - 1
+			if i >= len(answers) {
+				i = len(answers) - 1
+			}
+			switch {
+			case answers[i] == nil:
+				<-ctx.Done()
+				return nil, ctx.Err()
+			case answers[i].Vote == "":
+				return nil, errors.New("agent down")
+			}
+			return answers[i], nil
+		},
+	}, calls
+}
+
+var (
+	voteYes  = &agent.VoteResponse{Vote: agent.VoteYes, Reason: "yes"}
+	voteNo   = &agent.VoteResponse{Vote: agent.VoteNo, Reason: "no"}
+	agentErr = &agent.VoteResponse{}
+)
+
+func TestAskAgentFallback(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   string
+		last     *agent.VoteResponse
+		answers  []*agent.VoteResponse
+		vote     string
+		fallback string
+		calls    int32
+	}{
+		{"answered", config.AgentFallbackAbstain, nil, []*agent.VoteResponse{voteNo}, agent.VoteNo, "", 1},
+		{"answered on retry", config.AgentFallbackReject, nil, []*agent.VoteResponse{agentErr, agentErr, voteYes}, agent.VoteYes, "", 3},
+		{"error abstains", config.AgentFallbackAbstain, nil, []*agent.VoteResponse{agentErr}, agent.VoteAbstain, hac_types.FallbackReasonError, 3},
+		{"error rejects", config.AgentFallbackReject, nil, []*agent.VoteResponse{agentErr}, agent.VoteNo, hac_types.FallbackReasonError, 3},
+		{"error repeats the last answer", config.AgentFallbackLast, voteYes, []*agent.VoteResponse{agentErr}, agent.VoteYes, hac_types.FallbackReasonError, 3},
+		{"error without a last answer", config.AgentFallbackLast, nil, []*agent.VoteResponse{agentErr}, agent.VoteAbstain, hac_types.FallbackReasonError, 3},
+		{"timeout", config.AgentFallbackReject, nil, []*agent.VoteResponse{nil}, agent.VoteNo, hac_types.FallbackReasonTimeout, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, _, _ := newTestApp(t)
+			app.cfg.AgentTimeout = 300 * time.Millisecond
+			app.cfg.AgentRetries = 2
+			app.cfg.AgentFallback = tt.policy
+			if tt.last != nil {
+				q, _ := testQuery("subject", tt.last)
+				if _, fallback := app.askAgent(context.Background(), q); fallback != "" {
+					t.Fatalf("last answer fell back to %s", fallback)
+				}
+			}
+			q, calls := testQuery("subject", tt.answers...)
+			vote, fallback := app.askAgent(context.Background(), q)
+			if vote.Vote != tt.vote || fallback != tt.fallback || calls.Load() != tt.calls {
+				t.Fatalf("vote %s fallback %q after %d calls, want %s %q after %d", vote.Vote, fallback, calls.Load(), tt.vote, tt.fallback, tt.calls)
+			}
+		})
+	}
+}
+
+func TestPreEvaluate(t *testing.T) {
+	now := time.Now().UTC()
+	live, expired := uint(now.Add(time.Hour).Unix()), uint(now.Add(-time.Second).Unix())
+	tests := []struct {
+		name      string
+		txType    tx.HACTxType
+		body      interface{}
+		checkType abcitypes.CheckTxType
+		judged    bool
+	}{
+		{"new proposal", tx.HACTxTypeProposal, &tx.ProposalTx{Title: "title", ExpireTimestamp: live}, abcitypes.CheckTxType_New, true},
+		{"rechecked proposal", tx.HACTxTypeProposal, &tx.ProposalTx{Title: "title", ExpireTimestamp: live}, abcitypes.CheckTxType_Recheck, false},
+		{"rejected proposal", tx.HACTxTypeProposal, &tx.ProposalTx{Title: "title", ExpireTimestamp: expired}, abcitypes.CheckTxType_New, false},
+		{"not governance", tx.HACTxTypeRetract, &tx.RetractTx{Amount: 1}, abcitypes.CheckTxType_New, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, key, idx := newTestApp(t)
+			client := useTestAgent(t, agent.VoteNo)
+			commitTestBlock(t, app, now, nil)
+			rawTx := signTestTx(t, app, key, idx, tt.txType, tt.body)
+			if _, err := app.CheckTx(context.Background(), &abcitypes.RequestCheckTx{Tx: rawTx, Type: tt.checkType}); err != nil {
+				t.Fatal(err)
+			}
+			v := testVerdict(t, app, rawTx)
+			if (v != nil) != tt.judged || (client.calls.Load() == 1) != tt.judged {
+				t.Fatalf("verdict %+v after %d calls, want judged %v", v, client.calls.Load(), tt.judged)
+			}
+			if !tt.judged {
+				return
+			}
+			if v.vote.Vote != agent.VoteNo || v.fallback != "" || v.height != app.db.Header().Height {
+				t.Fatalf("verdict %+v vote %+v", v, v.vote)
+			}
+			// the tx proposed is not put to the agent again.
+			btx, err := tx.UnmarshalHACTx(rawTx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			txHash := hex.EncodeToString(tmhash.Sum(rawTx))
+			q, err := app.newAgentQuery(app.db.State(), btx, txHash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			vote, fallback := app.evaluate(context.Background(), txHash, q)
+			if vote.Vote != agent.VoteNo || fallback != "" || client.calls.Load() != 1 {
+				t.Fatalf("vote %+v fallback %q after %d calls", vote, fallback, client.calls.Load())
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		verdict  *verdict
+		vote     string
+		fallback string
+		calls    int32
+	}{
+		{"not pre-evaluated", nil, agent.VoteYes, "", 1},
+		{"pre-evaluated", &verdict{vote: voteNo}, agent.VoteNo, "", 0},
+		{"pre-evaluation failed", &verdict{vote: voteNo, fallback: hac_types.FallbackReasonError}, agent.VoteYes, "", 1},
+		{"pre-evaluation pending", &verdict{}, agent.VoteNo, hac_types.FallbackReasonTimeout, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, _, _ := newTestApp(t)
+			app.cfg.AgentTimeout = 200 * time.Millisecond
+			app.cfg.AgentFallback = config.AgentFallbackReject
+			if tt.verdict != nil {
+				tt.verdict.done = make(chan struct{})
+				if tt.verdict.vote != nil {
+					close(tt.verdict.done)
+				}
+				app.verdicts["tx"] = tt.verdict
+			}
+			q, calls := testQuery("subject", voteYes)
+			vote, fallback := app.evaluate(context.Background(), "tx", q)
+			if vote.Vote != tt.vote || fallback != tt.fallback || calls.Load() != tt.calls {
+				t.Fatalf("vote %s fallback %q after %d calls, want %s %q after %d", vote.Vote, fallback, calls.Load(), tt.vote, tt.fallback, tt.calls)
+			}
+		})
+	}
+}
+
+func TestVerdictLifecycle(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		name string
+		// leave makes the pre-evaluated proposal rawTx leave the mempool, or
+		// not.
+		leave func(t *testing.T, app *HACApp, rawTx []byte)
+		kept  bool
+	}{
+		{"passes recheck", func(t *testing.T, app *HACApp, rawTx []byte) {
+			if _, err := app.CheckTx(context.Background(), &abcitypes.RequestCheckTx{Tx: rawTx, Type: abcitypes.CheckTxType_Recheck}); err != nil {
+				t.Fatal(err)
+			}
+		}, true},
+		{"fails recheck", func(t *testing.T, app *HACApp, rawTx []byte) {
+			commitTestBlock(t, app, now.Add(2*time.Minute), nil)
+			res, err := app.CheckTx(context.Background(), &abcitypes.RequestCheckTx{Tx: rawTx, Type: abcitypes.CheckTxType_Recheck})
+			if err != nil || res.Code == 0 {
+				t.Fatalf("recheck %+v: %v", res, err)
+			}
+		}, false},
+		{"included in a block", func(t *testing.T, app *HACApp, rawTx []byte) {
+			commitTestBlock(t, app, now, [][]byte{rawTx}, int64(tx.VoteIgnoreProposal))
+		}, false},
+		{"short of verdictBlocks", func(t *testing.T, app *HACApp, rawTx []byte) {
+			app.expireVerdicts(app.db.Header().Height + verdictBlocks - 1)
+		}, true},
+		{"verdictBlocks old", func(t *testing.T, app *HACApp, rawTx []byte) {
+			app.expireVerdicts(app.db.Header().Height + verdictBlocks)
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, key, idx := newTestApp(t)
+			useTestAgent(t, agent.VoteYes)
+			commitTestBlock(t, app, now, nil)
+			rawTx := signTestTx(t, app, key, idx, tx.HACTxTypeProposal, &tx.ProposalTx{Title: "title", ExpireTimestamp: uint(now.Add(time.Minute).Unix())})
+			if _, err := app.CheckTx(context.Background(), &abcitypes.RequestCheckTx{Tx: rawTx, Type: abcitypes.CheckTxType_New}); err != nil {
+				t.Fatal(err)
+			}
+			if testVerdict(t, app, rawTx) == nil {
+				t.Fatal("not pre-evaluated")
+			}
+			tt.leave(t, app, rawTx)
+			if kept := testVerdict(t, app, rawTx) != nil; kept != tt.kept {
+				t.Fatalf("verdict kept %v, want %v", kept, tt.kept)
+			}
+		})
+	}
+}
+
+func TestJudgeReplay(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		name string
+		// round is the round the block is proposed again in, after a block
+		// is committed if next.
+		round int32
+		next  bool
+		code  tx.VoteCode
+		calls int32
+	}{
+		{"same round replayed", 0, false, tx.VoteProcessProposal, 1},
+		{"later round judged again", 1, false, tx.VoteIgnoreProposal, 2},
+		{"next height judged again", 0, true, tx.VoteIgnoreProposal, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, key, idx := newTestApp(t)
+			client := useTestAgent(t, agent.VoteYes)
+			commitTestBlock(t, app, now, nil)
+			rawTx := signTestTx(t, app, key, idx, tx.HACTxTypeProposal, &tx.ProposalTx{Title: "title", ExpireTimestamp: uint(now.Add(time.Hour).Unix())})
+			process := func(round int32) []int64 {
+				t.Helper()
+				res, err := app.ProcessProposal(context.Background(), &abcitypes.RequestProcessProposal{
+					Txs:    [][]byte{rawTx},
+					Hash:   []byte("block"),
+					Height: int64(app.db.Header().Height) + 1,
+					Round:  round,
+					Time:   now,
+				})
+				if err != nil || res.Status != abcitypes.ResponseProcessProposal_ACCEPT {
+					t.Fatalf("process %+v: %v", res, err)
+				}
+				return res.VoteCodes
+			}
+			if codes := process(0); len(codes) != 1 || codes[0] != int64(tx.VoteProcessProposal) {
+				t.Fatalf("codes %v", codes)
+			}
+
+			// the agent changes its mind; a vote already signed must not.
+			client.vote = agent.VoteNo
+			if tt.next {
+				commitTestBlock(t, app, now, nil)
+			}
+			codes := process(tt.round)
+			if len(codes) != 1 || codes[0] != int64(tt.code) || client.calls.Load() != tt.calls {
+				t.Fatalf("codes %v after %d calls, want %d after %d", codes, client.calls.Load(), tt.code, tt.calls)
+			}
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package app
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"sync"
+	"sync/atomic"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtlog "github.com/cometbft/cometbft/libs/log"
@@ -33,12 +37,33 @@ type HACApp struct {
 	cfg    *config.HACAppConfig
 	logger cmtlog.Logger
 
-	db       *state.StateDB
-	lastBlk  finalizeBlock
-	txHdlrs  map[tx.HACTxType]handler.TxHandler
-	queriers map[string]Querier
+	db         *state.StateDB
+	verdictDB  *state.VerdictDB
+	snapshotDB *state.SnapshotDB
+	lastBlk    finalizeBlock
+	txHdlrs    map[tx.HACTxType]handler.TxHandler
+	queriers   map[string]Querier
 
 	st *state.State
+	// rationales holds the rationales of our votes on each block accepted
+	// in ProcessProposal at the current height, keyed by block hash.
+	rationales map[string][]*types.VoteRationale
+	// mtx guards the agent verdicts, which CheckTx fills from the
+	// background, and lastVotes.
+	mtx sync.Mutex
+	// verdicts holds the agent verdicts on governance txs pre-evaluated at
+	// CheckTx, keyed by tx hash.
+	verdicts map[string]*verdict
+	// lastVotes holds the agent's last answer per vote subject, for the
+	// AgentFallbackLast policy.
+	lastVotes map[string]*agent.VoteResponse
+
+	// snapshotting is set while a snapshot is taken in the background,
+	// snapshotWg lets Stop wait for it.
+	snapshotting atomic.Bool
+	snapshotWg   sync.WaitGroup
+	// restore is the snapshot being restored by state sync.
+	restore *snapshotRestore
 }
 
 func NewHACApp(cfg *config.HACAppConfig, agentClient agent.Client, logger cmtlog.Logger) (app *HACApp, err error) {
@@ -49,13 +74,29 @@ func NewHACApp(cfg *config.HACAppConfig, agentClient agent.Client, logger cmtlog
 	if err != nil {
 		return nil, err
 	}
+	verdictDB, err := state.NewVerdictDB(dir, logger)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	snapshotDB, err := state.NewSnapshotDB(dir, logger)
+	if err != nil {
+		verdictDB.Close()
+		db.Close()
+		return nil, err
+	}
 
 	app = &HACApp{
-		cfg:      cfg,
-		logger:   logger,
-		db:       db,
-		txHdlrs:  make(map[tx.HACTxType]handler.TxHandler),
-		queriers: make(map[string]Querier),
+		cfg:        cfg,
+		logger:     logger,
+		db:         db,
+		verdictDB:  verdictDB,
+		snapshotDB: snapshotDB,
+		txHdlrs:    make(map[tx.HACTxType]handler.TxHandler),
+		queriers:   make(map[string]Querier),
+		rationales: make(map[string][]*types.VoteRationale),
+		verdicts:   make(map[string]*verdict),
+		lastVotes:  make(map[string]*agent.VoteResponse),
 	}
 	app.registerTxHandler()
 	app.registerQuerier()
@@ -75,10 +116,19 @@ func (app *HACApp) Start(bs *store.BlockStore) {
 }
 
 func (app *HACApp) Stop() {
+	app.snapshotWg.Wait()
 	err := app.db.Close()
 	if err != nil {
 		app.logger.Error("close db fail", "err", err)
 	}
+	err = app.verdictDB.Close()
+	if err != nil {
+		app.logger.Error("close verdict db fail", "err", err)
+	}
+	err = app.snapshotDB.Close()
+	if err != nil {
+		app.logger.Error("close snapshot db fail", "err", err)
+	}
 	app.logger.Info("HAC app stopped")
 }
 
@@ -89,6 +139,18 @@ func (app *HACApp) registerTxHandler() {
 		tx.HACTxTypeProposal:       handler.NewProposalTxHandler(app.logger),
 		tx.HACTxTypeDiscussion:     handler.NewDiscussionTxHandler(app.logger),
 		tx.HACTxTypeGrant:          handler.NewGrantTxHandler(app.logger),
+		tx.HACTxTypeRationale:      handler.NewRationaleTxHandler(app.logger),
+		tx.HACTxTypeDisqualify:     handler.NewDisqualifyTxHandler(app.logger),
+		tx.HACTxTypeAmendManifest:  handler.NewAmendManifestTxHandler(app.logger),
+		tx.HACTxTypeStake:          handler.NewStakeTxHandler(app.logger),
+		tx.HACTxTypeApply:          handler.NewApplyTxHandler(app.logger),
+		tx.HACTxTypeUpdateProfile:  handler.NewUpdateProfileTxHandler(app.logger),
+		tx.HACTxTypeRotateKey:      handler.NewRotateKeyTxHandler(app.logger),
+		tx.HACTxTypeSetSigners:     handler.NewSetSignersTxHandler(app.logger),
+		tx.HACTxTypeSession:        handler.NewSessionTxHandler(app.logger),
+		tx.HACTxTypeRevokeSession:  handler.NewRevokeSessionTxHandler(app.logger),
+		tx.HACTxTypeAmendParams:    handler.NewAmendParamsTxHandler(app.logger),
+		tx.HACTxTypeGeneric:        handler.NewGenericTxHandler(app.logger, moduleHandlers()),
 	}
 }
 
@@ -97,6 +159,18 @@ func (app *HACApp) registerQuerier() {
 	vq := NewValidatorQuerier(app.db, app.logger)
 	app.queriers["/accounts/"] = aq
 	app.queriers["/validators/"] = vq
+	app.queriers["/proposals/"] = NewProposalQuerier(app.db, app.logger)
+	app.queriers["/discussions/"] = NewDiscussionQuerier(app.db, app.logger)
+	app.queriers["/proposal_discussions/"] = NewProposalDiscussionQuerier(app.db, app.logger)
+	app.queriers["/votes/"] = NewVoteQuerier(app.db, app.logger)
+	app.queriers["/manifest/"] = NewManifestQuerier(app.db, app.logger)
+	app.queriers["/manifest_history/"] = NewManifestHistoryQuerier(app.db, app.logger)
+	app.queriers["/applications/"] = NewApplicationQuerier(app.db, app.logger)
+	app.queriers["/unbondings/"] = NewUnbondingQuerier(app.db, app.logger)
+	app.queriers["/sessions/"] = NewSessionQuerier(app.db, app.logger)
+	app.queriers["/community_pool/"] = NewCommunityPoolQuerier(app.db, app.logger)
+	app.queriers["/issued/"] = NewIssuedQuerier(app.db, app.logger)
+	app.queriers["/params/"] = NewParamsQuerier(app.db, app.logger)
 }
 
 func (app *HACApp) InitChain(_ context.Context, chain *abcitypes.RequestInitChain) (res *abcitypes.ResponseInitChain, err error) {
@@ -130,15 +204,24 @@ func (app *HACApp) InitChain(_ context.Context, chain *abcitypes.RequestInitChai
 			return nil, err
 		}
 	}
-	var h common.Hash
-	_, err = st.Update()
+	err = st.SetManifest(appState.Manifest)
 	if err != nil {
-		app.logger.Error("InitChain update state fail", "err", err)
+		app.logger.Error("InitChain set manifest fail", "err", err)
 		return nil, err
 	}
-	err = st.SetManifest(appState.Manifest)
+	params := appState.Params
+	if params == nil {
+		params = types.DefaultParams()
+	}
+	err = st.SetParams(params)
 	if err != nil {
-		app.logger.Error("InitChain set manifest fail", "err", err)
+		app.logger.Error("InitChain set params fail", "err", err)
+		return nil, err
+	}
+	var h common.Hash
+	_, err = st.Update()
+	if err != nil {
+		app.logger.Error("InitChain update state fail", "err", err)
 		return nil, err
 	}
 	h, err = app.db.SetState(st)
@@ -159,26 +242,64 @@ func (app *HACApp) Info(ctx context.Context, info *abcitypes.RequestInfo) (*abci
 	}, nil
 }
 
+// ExtendVote attaches the rationales of our votes on the block, if the block
+// carried proposals for the agent to judge.
 func (app *HACApp) ExtendVote(_ context.Context, extend *abcitypes.RequestExtendVote) (*abcitypes.ResponseExtendVote, error) {
-	return &abcitypes.ResponseExtendVote{}, nil
+	rationales := app.rationales[hex.EncodeToString(extend.Hash)]
+	if len(rationales) == 0 {
+		return &abcitypes.ResponseExtendVote{}, nil
+	}
+	ext, err := json.Marshal(rationales)
+	if err != nil {
+		app.logger.Error("ExtendVote marshal rationale fail", "err", err)
+		return &abcitypes.ResponseExtendVote{}, nil
+	}
+	return &abcitypes.ResponseExtendVote{VoteExtension: ext}, nil
 }
 
+// VerifyVoteExtension checks that an extension holds well formed rationales
+// about the same proposals and txs we judged, each with the code the voter
+// signed in its prevote for the tx, if we received the prevote. Reasons are
+// the voter's own and are not compared.
 func (app *HACApp) VerifyVoteExtension(_ context.Context, verify *abcitypes.RequestVerifyVoteExtension) (*abcitypes.ResponseVerifyVoteExtension, error) {
-	return &abcitypes.ResponseVerifyVoteExtension{}, nil
-}
-
-func (app *HACApp) ApplySnapshotChunk(context.Context, *abcitypes.RequestApplySnapshotChunk) (*abcitypes.ResponseApplySnapshotChunk, error) {
-	return nil, nil
-}
-
-func (app *HACApp) ListSnapshots(context.Context, *abcitypes.RequestListSnapshots) (*abcitypes.ResponseListSnapshots, error) {
-	return nil, nil
-}
-
-func (app *HACApp) LoadSnapshotChunk(context.Context, *abcitypes.RequestLoadSnapshotChunk) (*abcitypes.ResponseLoadSnapshotChunk, error) {
-	return nil, nil
-}
-
-func (app *HACApp) OfferSnapshot(context.Context, *abcitypes.RequestOfferSnapshot) (*abcitypes.ResponseOfferSnapshot, error) {
-	return nil, nil
+	accept := &abcitypes.ResponseVerifyVoteExtension{Status: abcitypes.ResponseVerifyVoteExtension_ACCEPT}
+	reject := &abcitypes.ResponseVerifyVoteExtension{Status: abcitypes.ResponseVerifyVoteExtension_REJECT}
+	local, processed := app.rationales[hex.EncodeToString(verify.Hash)]
+	if len(verify.VoteExtension) == 0 {
+		return accept, nil
+	}
+	var rationales []*types.VoteRationale
+	if err := json.Unmarshal(verify.VoteExtension, &rationales); err != nil {
+		app.logger.Info("VerifyVoteExtension unmarshal fail", "validator", verify.ValidatorAddress, "err", err)
+		return reject, nil
+	}
+	if len(rationales) > tx.MaxGovernanceTxs {
+		return reject, nil
+	}
+	for _, rationale := range rationales {
+		if rationale == nil || len(rationale.Reason) > types.MaxRationaleReasonLen {
+			return reject, nil
+		}
+	}
+	if !processed {
+		return accept, nil
+	}
+	if len(local) != len(rationales) {
+		app.logger.Info("VerifyVoteExtension rationales unmatched", "validator", verify.ValidatorAddress, "height", verify.Height)
+		return reject, nil
+	}
+	for i, rationale := range rationales {
+		if local[i].Proposal != rationale.Proposal || !bytes.Equal(local[i].Context, rationale.Context) {
+			app.logger.Info("VerifyVoteExtension rationale unmatched", "validator", verify.ValidatorAddress, "height", verify.Height)
+			return reject, nil
+		}
+		if len(verify.VoteCodes) == 0 {
+			continue
+		}
+		if local[i].Index >= len(verify.VoteCodes) || verify.VoteCodes[local[i].Index] != rationale.VoteCode {
+			app.logger.Info("VerifyVoteExtension rationale code unmatched", "validator", verify.ValidatorAddress, "height", verify.Height, "proposal", rationale.Proposal)
+			return reject, nil
+		}
+	}
+	return accept, nil
 }
@@ -0,0 +1,226 @@
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/agent"
+	"github.com/hetu-project/hetu-chaoschain/config"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// newTestApp returns an app at the genesis of chain "test" with a single
+// validator, the validator's key and account index.
+func newTestApp(t *testing.T) (*HACApp, ed25519.PrivKey, uint64) {
+	t.Helper()
+	agent.ElizaCli = agent.NewMockClient()
+	app, err := NewHACApp(config.NewHACAppConfig(t.TempDir()), agent.ElizaCli, cmtlog.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(app.Stop)
+	key := ed25519.GenPrivKey()
+	_, err = app.InitChain(context.Background(), &abcitypes.RequestInitChain{
+		ChainId:       "test",
+		AppStateBytes: []byte("{}"),
+		Validators:    []abcitypes.ValidatorUpdate{abcitypes.Ed25519ValidatorUpdate(key.PubKey().Bytes(), 10)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := app.db.State().FindAccount(key.PubKey().Address())
+	if err != nil || a == nil {
+		t.Fatalf("validator account %v: %v", a, err)
+	}
+	return app, key, a.Index
+}
+
+// commitTestBlock finalizes and commits the next block at blockTime with
+// txs, the governance ones decided with codes.
+func commitTestBlock(t *testing.T, app *HACApp, blockTime time.Time, txs [][]byte, codes ...int64) *abcitypes.ResponseFinalizeBlock {
+	t.Helper()
+	res, err := app.FinalizeBlock(context.Background(), &abcitypes.RequestFinalizeBlock{
+		Height:    int64(app.db.Header().Height) + 1,
+		Time:      blockTime,
+		Txs:       txs,
+		VoteCodes: codes,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := app.Commit(context.Background(), &abcitypes.RequestCommit{}); err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+// signTestTx signs btx with key for the account idx at its current nonce
+// and encodes it.
+func signTestTx(t *testing.T, app *HACApp, key ed25519.PrivKey, idx uint64, txType tx.HACTxType, body interface{}) []byte {
+	t.Helper()
+	a, err := app.db.State().GetAccount(idx)
+	if err != nil || a == nil {
+		t.Fatalf("account %d: %v", idx, err)
+	}
+	btx := &tx.HACTx{Version: tx.HACTxVersion2, Type: txType, Validator: idx, Nonce: a.Nonce, Tx: body}
+	dat, err := btx.SigData([]byte("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := key.Sign(dat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	btx.Sig = [][]byte{sig}
+	bz, err := tx.MarshalHACTx(btx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bz
+}
+
+func TestCheckTxExpired(t *testing.T) {
+	app, key, idx := newTestApp(t)
+	now := time.Now().UTC()
+	live, expired := uint(now.Add(time.Hour).Unix()), uint(now.Add(-time.Second).Unix())
+
+	// a proposal to settle, processed in the first block.
+	ptx := signTestTx(t, app, key, idx, tx.HACTxTypeProposal, &tx.ProposalTx{Title: "title", ExpireTimestamp: live})
+	commitTestBlock(t, app, now, [][]byte{ptx}, int64(tx.VoteProcessProposal))
+	if app.db.State().BlockTime().Unix() != now.Unix() {
+		t.Fatalf("check state block time %v, want %v", app.db.State().BlockTime(), now)
+	}
+
+	tests := []struct {
+		name   string
+		txType tx.HACTxType
+		body   interface{}
+		err    error
+	}{
+		{"live proposal", tx.HACTxTypeProposal, &tx.ProposalTx{Title: "title", ExpireTimestamp: live}, nil},
+		{"expired proposal", tx.HACTxTypeProposal, &tx.ProposalTx{Title: "title", ExpireTimestamp: expired}, state.ErrTxExpired},
+		{"live settlement", tx.HACTxTypeSettleProposal, &tx.SettleProposalTx{Proposal: 1, ExpireTimestamp: live}, nil},
+		{"expired settlement", tx.HACTxTypeSettleProposal, &tx.SettleProposalTx{Proposal: 1, ExpireTimestamp: expired}, state.ErrTxExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := app.CheckTx(context.Background(), &abcitypes.RequestCheckTx{
+				Tx:   signTestTx(t, app, key, idx, tt.txType, tt.body),
+				Type: abcitypes.CheckTxType_New,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.err == nil {
+				if res.Code != 0 {
+					t.Fatalf("code %d log %q", res.Code, res.Log)
+				}
+				return
+			}
+			if res.Code == 0 || !strings.Contains(res.Log, tt.err.Error()) {
+				t.Fatalf("code %d log %q, want %v", res.Code, res.Log, tt.err)
+			}
+		})
+	}
+}
+
+func TestVerifyVoteExtension(t *testing.T) {
+	app, _, _ := newTestApp(t)
+	hash := []byte("block")
+	local := []*hac_types.VoteRationale{
+		newRationale(1, tx.VoteProcessProposal, "worth it", []byte("tx1")),
+		newRationale(2, tx.VoteRejectProposal, "no", []byte("tx2")),
+	}
+	local[1].Index = 2
+	app.rationales[hex.EncodeToString(hash)] = local
+	ext, err := app.ExtendVote(context.Background(), &abcitypes.RequestExtendVote{Hash: hash})
+	if err != nil || len(ext.VoteExtension) == 0 {
+		t.Fatalf("extension %v: %v", ext, err)
+	}
+	// marshal builds the extension of a voter with the rationales of local
+	// changed by edit.
+	marshal := func(edit func([]*hac_types.VoteRationale) []*hac_types.VoteRationale) []byte {
+		rationales := make([]*hac_types.VoteRationale, len(local))
+		for i, r := range local {
+			c := *r
+			rationales[i] = &c
+		}
+		bz, err := json.Marshal(edit(rationales))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bz
+	}
+	codes := []int64{int64(tx.VoteProcessProposal), int64(tx.VoteGrantNewMember), int64(tx.VoteRejectProposal)}
+
+	tests := []struct {
+		name   string
+		hash   []byte
+		ext    []byte
+		codes  []int64
+		accept bool
+	}{
+		{"no extension", hash, nil, codes, true},
+		{"our own", hash, ext.VoteExtension, codes, true},
+		{"codes not received", hash, ext.VoteExtension, nil, true},
+		{"reason differs", hash, marshal(func(r []*hac_types.VoteRationale) []*hac_types.VoteRationale {
+			r[0].Reason = "other reason"
+			return r
+		}), codes, true},
+		{"block not processed", []byte("other"), marshal(func(r []*hac_types.VoteRationale) []*hac_types.VoteRationale {
+			return r[:1]
+		}), codes, true},
+		{"malformed", hash, []byte("{"), codes, false},
+		{"nil rationale", hash, []byte("[null]"), codes, false},
+		{"reason too long", hash, marshal(func(r []*hac_types.VoteRationale) []*hac_types.VoteRationale {
+			r[0].Reason = strings.Repeat("a", hac_types.MaxRationaleReasonLen+1)
+			return r
+		}), codes, false},
+		{"too many", []byte("other"), marshal(func(r []*hac_types.VoteRationale) []*hac_types.VoteRationale {
+			for len(r) <= tx.MaxGovernanceTxs {
+				r = append(r, r[0])
+			}
+			return r
+		}), codes, false},
+		{"missing rationale", hash, marshal(func(r []*hac_types.VoteRationale) []*hac_types.VoteRationale {
+			return r[:1]
+		}), codes, false},
+		{"other proposal", hash, marshal(func(r []*hac_types.VoteRationale) []*hac_types.VoteRationale {
+			r[1].Proposal = 3
+			return r
+		}), codes, false},
+		{"other tx", hash, marshal(func(r []*hac_types.VoteRationale) []*hac_types.VoteRationale {
+			r[1].Context = []byte("tx3")
+			return r
+		}), codes, false},
+		{"code not signed", hash, marshal(func(r []*hac_types.VoteRationale) []*hac_types.VoteRationale {
+			r[1].VoteCode = int64(tx.VoteAcceptProposal)
+			return r
+		}), codes, false},
+		{"codes short", hash, ext.VoteExtension, codes[:2], false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := app.VerifyVoteExtension(context.Background(), &abcitypes.RequestVerifyVoteExtension{
+				Hash:          tt.hash,
+				VoteExtension: tt.ext,
+				VoteCodes:     tt.codes,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if accepted := res.Status == abcitypes.ResponseVerifyVoteExtension_ACCEPT; accepted != tt.accept {
+				t.Fatalf("accepted %v, want %v", accepted, tt.accept)
+			}
+		})
+	}
+}
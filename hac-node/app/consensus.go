@@ -2,10 +2,14 @@ package app
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
-	"time"
+	"sync"
+	"unicode/utf8"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/hetu-project/hetu-chaoschain/agent"
 	"github.com/hetu-project/hetu-chaoschain/state"
@@ -14,10 +18,10 @@ import (
 )
 
 var (
-	ErrOnlySupportOneGrant     = errors.New("only support one grant in one tx")
-	ErrMultiProposalInOneBlock = errors.New("multi proposal in one block")
-	ErrUnexpectedTxProcess     = errors.New("unexpected tx process")
-	ErrUnexpectedGrantTxs      = errors.New("unexpected grants")
+	ErrOnlySupportOneGrant  = errors.New("only support one grant in one tx")
+	ErrTooManyGovernanceTxs = errors.New("too many governance txs in one block")
+	ErrUnexpectedTxProcess  = errors.New("unexpected tx process")
+	ErrUnexpectedGrantTxs   = errors.New("unexpected grants")
 )
 
 func (app *HACApp) getState(blkHash *common.Hash) (st *state.State) {
@@ -31,12 +35,23 @@ func (app *HACApp) parseTx(txDat []byte, allowNonceGap bool) (btx *tx.HACTx, err
 	if err != nil {
 		return
 	}
-	if btx != nil {
+	// a rationale tx has no account behind it, it is checked against the
+	// vote extension signatures it carries when applied.
+	if btx != nil && btx.Type != tx.HACTxTypeRationale {
 		_, err = app.db.State().Verify(btx, allowNonceGap)
 	}
 	return
 }
 
+// useSession counts btx against the rate cap of the session key that
+// signed it, on the state it is applied to.
+func useSession(st *state.State, btx *tx.HACTx) error {
+	if btx.Type == tx.HACTxTypeRationale {
+		return nil
+	}
+	return st.UseSession(btx)
+}
+
 func (app *HACApp) CheckTx(ctx context.Context, check *abcitypes.RequestCheckTx) (res *abcitypes.ResponseCheckTx, err error) {
 	res = &abcitypes.ResponseCheckTx{Code: 0}
 	app.logger.Info("check tx")
@@ -64,6 +79,14 @@ func (app *HACApp) CheckTx(ctx context.Context, check *abcitypes.RequestCheckTx)
 		res.Log = err.Error()
 		err = nil
 	}
+	txHash := hex.EncodeToString(tmhash.Sum(check.Tx))
+	switch {
+	case res.Code == 0 && check.Type == abcitypes.CheckTxType_New:
+		app.preEvaluate(st, txHash, btx)
+	case res.Code != 0 && check.Type == abcitypes.CheckTxType_Recheck:
+		// the tx leaves the mempool.
+		app.forgetVerdicts([]string{txHash})
+	}
 
 	return
 }
@@ -71,10 +94,11 @@ func (app *HACApp) CheckTx(ctx context.Context, check *abcitypes.RequestCheckTx)
 func (app *HACApp) PrepareProposal(ctx context.Context, proposal *abcitypes.RequestPrepareProposal) (res *abcitypes.ResponsePrepareProposal, err error) {
 	app.logger.Info("PrepareProposal")
 	st := app.getState(nil)
+	st.SetBlockTime(proposal.Time)
 	for _, h := range app.txHdlrs {
 		h.NewContext(ctx)
 	}
-	proposerAct := false
+	govTxs := 0
 	prepareTxs := make([][]byte, 0)
 	for _, stx := range proposal.Txs {
 		btx, err := app.parseTx(stx, false)
@@ -82,23 +106,38 @@ func (app *HACApp) PrepareProposal(ctx context.Context, proposal *abcitypes.Requ
 			app.logger.Error("unsupported tx, parse fail", "err", err)
 			continue
 		}
-		if btx.Type == tx.HACTxTypeGrant || btx.Type == tx.HACTxTypeProposal || btx.Type == tx.HACTxTypeSettleProposal {
-			if proposerAct == true {
+		if btx.Type == tx.HACTxTypeRationale {
+			continue
+		}
+		if btx.Governance() {
+			if govTxs == tx.MaxGovernanceTxs {
 				continue
 			}
-			prepareTxs = append(prepareTxs, stx)
-			proposerAct = true
-		} else {
-			prepareTxs = append(prepareTxs, stx)
+			// leave out txs the agent can not be asked about, so that they
+			// do not fail the whole block.
+			if _, err := app.newAgentQuery(st, btx, ""); err != nil {
+				app.logger.Error("governance tx dropped", "type", btx.Type, "err", err)
+				continue
+			}
+			govTxs++
 		}
+		prepareTxs = append(prepareTxs, stx)
 	}
 
-	code, err := app.getCode(ctx, st, prepareTxs)
+	rationaleTx, err := app.rationaleTx(proposal.Height, &proposal.LocalLastCommit)
 	if err != nil {
-		app.logger.Error("PrepareProposal getCode failed", "height", uint64(proposal.Height), "err", err)
+		app.logger.Error("PrepareProposal build rationale tx failed", "height", uint64(proposal.Height), "err", err)
+	} else if rationaleTx != nil {
+		prepareTxs = append([][]byte{rationaleTx}, prepareTxs...)
+	}
+
+	codes, _, err := app.getCodes(ctx, st, proposal.Round, prepareTxs)
+	if err != nil {
+		app.logger.Error("PrepareProposal getCodes failed", "height", uint64(proposal.Height), "err", err)
 		return &abcitypes.ResponsePrepareProposal{}, nil
 	}
 	txs := make([][]byte, 0)
+	gov := 0
 	for _, stx := range prepareTxs {
 		stTmp := st.Clone()
 		btx, err := app.parseTx(stx, false)
@@ -106,11 +145,20 @@ func (app *HACApp) PrepareProposal(ctx context.Context, proposal *abcitypes.Requ
 			app.logger.Error("unsupported tx, parse fail", "err", err)
 			continue
 		}
+		var code tx.VoteCode
+		if btx.Governance() {
+			code = codes[gov]
+			gov++
+		}
 		h, ok := app.txHdlrs[btx.Type]
 		if !ok {
 			app.logger.Error("unsupported tx", "type", btx.Type)
 			continue
 		}
+		if err := useSession(stTmp, btx); err != nil {
+			app.logger.Error("prepare tx session fail", "type", btx.Type, "err", err)
+			continue
+		}
 		result, err := h.Prepare(ctx, stTmp, btx, code)
 		if err != nil {
 			app.logger.Error("prepare tx fail ", "type", btx.Type, "err", err)
@@ -130,11 +178,12 @@ func (app *HACApp) PrepareProposal(ctx context.Context, proposal *abcitypes.Requ
 	return &abcitypes.ResponsePrepareProposal{Txs: txs}, nil
 }
 
-func (app *HACApp) finalize(ctx context.Context, st *state.State, txs [][]byte, proposer []byte, height uint64, code tx.VoteCode) (res []*abcitypes.ExecTxResult, events []abcitypes.Event, err error) {
+func (app *HACApp) finalize(ctx context.Context, st *state.State, txs [][]byte, proposer []byte, height uint64, codes []tx.VoteCode) (res []*abcitypes.ExecTxResult, events []abcitypes.Event, err error) {
 	for _, h := range app.txHdlrs {
 		h.NewContext(ctx)
 	}
 	res = make([]*abcitypes.ExecTxResult, len(txs))
+	gov := 0
 	for i, stx := range txs {
 		btx, err := app.parseTx(stx, false)
 		if err != nil {
@@ -147,6 +196,21 @@ func (app *HACApp) finalize(ctx context.Context, st *state.State, txs [][]byte,
 			err = ErrUnexpectedTxProcess
 			return nil, nil, err
 		}
+		var code tx.VoteCode
+		if btx.Governance() {
+			code = govCode(codes, gov)
+			if code == tx.VoteNoQuorum {
+				// the validators split and no code reached quorum; the tx is
+				// settled by the no-quorum fallback, keep a record of it.
+				app.logger.Info("finalize vote code no quorum", "height", height, "index", gov)
+				events = append(events, hac_types.EncodeEventVoteCode(&hac_types.EventVoteCode{Height: height, Index: uint64(gov), VoteCode: int64(code)}))
+			}
+			gov++
+		}
+		if err := useSession(st, btx); err != nil {
+			app.logger.Error("unexpected tx session fail", "type", btx.Type, "err", err)
+			return nil, nil, ErrUnexpectedTxProcess
+		}
 		result, err := h.Process(ctx, st, btx, code)
 		if err != nil {
 			app.logger.Error("unexpected process tx fail", "type", btx.Type, "err", err)
@@ -168,11 +232,12 @@ func (app *HACApp) finalize(ctx context.Context, st *state.State, txs [][]byte,
 	return
 }
 
-func (app *HACApp) process(ctx context.Context, st *state.State, txs [][]byte, proposer []byte, height uint64, code tx.VoteCode) (res []*abcitypes.ExecTxResult, events []abcitypes.Event, err error) {
+func (app *HACApp) process(ctx context.Context, st *state.State, txs [][]byte, proposer []byte, height uint64, codes []tx.VoteCode) (res []*abcitypes.ExecTxResult, events []abcitypes.Event, err error) {
 	for _, h := range app.txHdlrs {
 		h.NewContext(ctx)
 	}
 	res = make([]*abcitypes.ExecTxResult, len(txs))
+	gov := 0
 	for i, stx := range txs {
 		btx, err := app.parseTx(stx, false)
 		if err != nil {
@@ -186,6 +251,15 @@ func (app *HACApp) process(ctx context.Context, st *state.State, txs [][]byte, p
 			err = ErrUnexpectedTxProcess
 			return nil, nil, err
 		}
+		var code tx.VoteCode
+		if btx.Governance() {
+			code = govCode(codes, gov)
+			gov++
+		}
+		if err := useSession(st, btx); err != nil {
+			app.logger.Error("unexpected tx session fail", "type", btx.Type, "err", err)
+			return nil, nil, ErrUnexpectedTxProcess
+		}
 		result, err := h.Process(ctx, st, btx, code)
 		if err != nil {
 			app.logger.Error("unexpected process tx fail", "type", btx.Type, "err", err)
@@ -209,40 +283,112 @@ func (app *HACApp) process(ctx context.Context, st *state.State, txs [][]byte, p
 
 func (app *HACApp) ProcessProposal(ctx context.Context, proposal *abcitypes.RequestProcessProposal) (res *abcitypes.ResponseProcessProposal, err error) {
 	app.logger.Info("ProcessProposal AAA")
-	res = &abcitypes.ResponseProcessProposal{Status: abcitypes.ResponseProcessProposal_REJECT, VoteCode: 0}
+	res = &abcitypes.ResponseProcessProposal{Status: abcitypes.ResponseProcessProposal_REJECT}
 	if len(proposal.Txs) == 0 {
 		app.logger.Info("ProcessProposal", "height", proposal.Height, "no txs")
 		res.Status = abcitypes.ResponseProcessProposal_ACCEPT
 		return res, nil
 	}
 	st := app.getState(nil)
+	st.SetBlockTime(proposal.Time)
 
-	code, err := app.getCode(ctx, st, proposal.Txs)
+	codes, rationales, err := app.getCodes(ctx, st, proposal.Round, proposal.Txs)
 	if err != nil {
-		app.logger.Error("ProcessProposal getCode failed", "height", uint64(proposal.Height), "err", err)
+		app.logger.Error("ProcessProposal getCodes failed", "height", uint64(proposal.Height), "err", err)
 		return res, nil
 	}
-	app.logger.Info("ProcessProposal", "code", code)
-	res.VoteCode = int64(code)
+	app.logger.Info("ProcessProposal", "codes", codes)
+	res.VoteCodes = make([]int64, len(codes))
+	for i, code := range codes {
+		res.VoteCodes[i] = int64(code)
+	}
 
-	_, _, err = app.process(ctx, st, proposal.Txs, proposal.ProposerAddress, uint64(proposal.Height), code)
+	_, _, err = app.process(ctx, st, proposal.Txs, proposal.ProposerAddress, uint64(proposal.Height), codes)
 	if err != nil {
 		app.logger.Error("process fail", "err", err)
 		return res, nil
 	}
 	res.Status = abcitypes.ResponseProcessProposal_ACCEPT
-	app.logger.Info("proposal accepted", "height", proposal.Height, "voteCode", res.VoteCode)
+	app.rationales[hex.EncodeToString(proposal.Hash)] = rationales
+	app.logger.Info("proposal accepted", "height", proposal.Height, "voteCodes", res.VoteCodes)
 	return res, nil
 }
 
 func (app *HACApp) FinalizeBlock(ctx context.Context, req *abcitypes.RequestFinalizeBlock) (*abcitypes.ResponseFinalizeBlock, error) {
-	app.logger.Info("FinalizeBlock", "height", req.Height, "voteCode", req.VoteCode)
+	app.logger.Info("FinalizeBlock", "height", req.Height, "voteCodes", req.VoteCodes)
 	app.lastBlk.Set(req)
 	st := app.getState(nil)
-	res, events, err := app.finalize(ctx, st, req.Txs, req.ProposerAddress, uint64(req.Height), tx.VoteCode(req.VoteCode))
+	st.SetBlockTime(req.Time)
+	res, events, err := app.finalize(ctx, st, req.Txs, req.ProposerAddress, uint64(req.Height), toVoteCodes(req.VoteCodes))
+	if err != nil {
+		return nil, err
+	}
+	txHashes := make([]string, len(req.Txs))
+	for i, rawTx := range req.Txs {
+		txHashes[i] = hex.EncodeToString(tmhash.Sum(rawTx))
+	}
+	app.forgetVerdicts(txHashes)
+	app.expireVerdicts(uint64(req.Height))
+	for _, m := range req.Misbehavior {
+		slashed, err := st.Slash(m.Validator.Address, uint64(m.Height), m.Type.String())
+		if err != nil {
+			app.logger.Error("slash fail", "validator", m.Validator.Address, "err", err)
+			return nil, err
+		}
+		if slashed != nil {
+			events = append(events, hac_types.EncodeEventSlash(slashed))
+		}
+	}
+	err = st.RewardCommit(req.DecidedLastCommit.Votes)
+	if err != nil {
+		app.logger.Error("reward commit fail", "err", err)
+		return nil, err
+	}
+	rewards, err := st.Rewards()
+	if err != nil {
+		app.logger.Error("get rewards fail", "err", err)
+		return nil, err
+	}
+	for _, event := range rewards {
+		app.logger.Debug("reward", "account", event.Account, "kind", event.Kind, "amount", event.Amount)
+		events = append(events, hac_types.EncodeEventReward(event))
+	}
+	released, err := st.ReleaseUnbondings()
 	if err != nil {
+		app.logger.Error("release unbondings fail", "err", err)
 		return nil, err
 	}
+	for _, event := range released {
+		app.logger.Info("stake released", "validator", event.Validator, "amount", event.Amount)
+		events = append(events, hac_types.EncodeEventStakeReleased(event))
+	}
+	expiredApplications, err := st.ExpireApplications()
+	if err != nil {
+		app.logger.Error("expire applications fail", "err", err)
+		return nil, err
+	}
+	for _, event := range expiredApplications {
+		app.logger.Info("application expired", "applicant", event.Address, "expireHeight", event.ExpireHeight)
+		events = append(events, hac_types.EncodeEventApplicationExpired(event))
+	}
+	expired, err := st.ExpireProposals()
+	if err != nil {
+		app.logger.Error("expire proposals fail", "err", err)
+		return nil, err
+	}
+	for _, event := range expired {
+		app.logger.Info("proposal expired", "proposal", event.Proposal, "endHeight", event.EndHeight)
+		events = append(events, hac_types.EncodeEventProposalExpired(event))
+	}
+	refunded, err := st.RefundDeposits()
+	if err != nil {
+		app.logger.Error("refund deposits fail", "err", err)
+		return nil, err
+	}
+	for _, event := range refunded {
+		app.logger.Info("deposit refunded", "proposal", event.Proposal, "proposer", event.Proposer, "amount", event.Amount)
+		events = append(events, hac_types.EncodeEventDepositRefund(event))
+	}
 	curVals, err := st.Validators()
 	if err != nil {
 		app.logger.Error("get validators fail", "err", err)
@@ -275,89 +421,200 @@ func (app *HACApp) Commit(ctx context.Context, commit *abcitypes.RequestCommit)
 		return nil, err
 	}
 	app.st = nil
+	app.rationales = make(map[string][]*hac_types.VoteRationale)
+	height := app.db.Header().Height
+	if err = app.verdictDB.Prune(height + 1); err != nil {
+		app.logger.Error("prune verdicts fail", "err", err)
+	}
+	app.takeSnapshot(height)
 	app.logger.Info("Commit")
 	return &abcitypes.ResponseCommit{}, nil
 }
 
-func (app *HACApp) getCode(ctx context.Context, st *state.State, txs [][]byte) (code tx.VoteCode, err error) {
-	proposerAct := false
-	for _, stx := range txs {
-		btx, err := app.parseTx(stx, false)
+// govTx is a governance tx of a block and what its vote rests on.
+type govTx struct {
+	rawTx   []byte
+	hash    string
+	query   *agentQuery
+	yes, no tx.VoteCode
+	// proposal is the proposal the vote rationale is given for, 0 if none.
+	proposal uint64
+	// expired txs are not put to the agent, their code is fixed.
+	expired bool
+}
+
+// getCodes asks the agent to judge each governance tx among txs, proposed in
+// round, and returns the codes this validator votes with, in block order.
+// For proposals it also returns the rationales carried in the vote
+// extension. The agent is asked about all txs at once, so the block waits on
+// the slowest answer only.
+func (app *HACApp) getCodes(ctx context.Context, st *state.State, round int32, txs [][]byte) (codes []tx.VoteCode, rationales []*hac_types.VoteRationale, err error) {
+	govs := make([]*govTx, 0)
+	proposals := st.ProposalMax()
+	for _, rawTx := range txs {
+		btx, err := app.parseTx(rawTx, false)
 		if err != nil {
 			app.logger.Error("unsupported tx, parse fail", "err", err)
 			continue
 		}
-		switch btx.Type {
-		case tx.HACTxTypeGrant:
-			stx := btx.Tx.(*tx.GrantTx)
-			if proposerAct == true {
-				return 0, ErrMultiProposalInOneBlock
-			}
-			proposerAct = true
-			if len(stx.Grants) != 1 {
-				return 0, ErrOnlySupportOneGrant
-			}
-			proposerAct, err := st.GetAccount(btx.Validator)
-			if proposerAct == nil {
-				return 0, errors.New("proposer not found")
-			}
-			pass, err := agent.ElizaCli.IfGrantNewMember(ctx, st.Header().AccountIdx, proposerAct.Address(), stx.Grants[0].Amount, stx.Grants[0].Statement)
-			if err != nil {
-				return 0, err
-			}
-			if pass {
-				code = tx.VoteGrantNewMember
-			} else {
-				code = tx.VoteRejectNewMember
-			}
-			continue
-		case tx.HACTxTypeProposal:
-			if proposerAct == true {
-				return 0, ErrMultiProposalInOneBlock
-			}
-			proposerAct = true
-			stx := btx.Tx.(*tx.ProposalTx)
-			if time.Now().Unix() > int64(stx.ExpireTimestamp) {
-				app.logger.Info("proposal expire ignored", "expire", stx.ExpireTimestamp)
-				code = tx.VoteIgnoreProposal
-				continue
-			}
-			pass, err := agent.ElizaCli.IfProcessProposal(ctx, string(stx.Data), stx.Title)
-			if err != nil {
-				return 0, err
-			}
-			if pass {
-				code = tx.VoteProcessProposal
-			} else {
-				code = tx.VoteIgnoreProposal
-			}
+		if !btx.Governance() {
 			continue
-		case tx.HACTxTypeSettleProposal:
-			if proposerAct == true {
-				return 0, ErrMultiProposalInOneBlock
-			}
-			proposerAct = true
-			stx := btx.Tx.(*tx.SettleProposalTx)
-			voterAct, err := st.GetAccount(btx.Validator)
-			if voterAct == nil {
-				return 0, errors.New("voter not found")
-			}
-			if time.Now().Unix() > int64(stx.ExpireTimestamp) {
-				app.logger.Info("proposal expire reject", "expire", stx.ExpireTimestamp)
-				code = tx.VoteRejectProposal
-				continue
-			}
-			pass, err := agent.ElizaCli.IfAcceptProposal(ctx, stx.Proposal, voterAct.Address())
-			if err != nil {
-				return 0, err
+		}
+		if len(govs) == tx.MaxGovernanceTxs {
+			return nil, nil, ErrTooManyGovernanceTxs
+		}
+		g := &govTx{rawTx: rawTx, hash: hex.EncodeToString(tmhash.Sum(rawTx))}
+		g.query, err = app.newAgentQuery(st, btx, g.hash)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch stx := btx.Tx.(type) {
+		case *tx.GrantTx:
+			g.yes, g.no = tx.VoteGrantNewMember, tx.VoteRejectNewMember
+		case *tx.ProposalTx:
+			// every proposal takes the next index, whatever its outcome.
+			proposals++
+			g.yes, g.no, g.proposal = tx.VoteProcessProposal, tx.VoteIgnoreProposal, proposals
+			if stx.Expired(st.BlockTime()) {
+				app.logger.Info("proposal expire ignored", "expire", stx.ExpireTimestamp, "blockTime", st.BlockTime())
+				g.expired = true
 			}
-			if pass {
-				code = tx.VoteAcceptProposal
-			} else {
-				code = tx.VoteRejectProposal
+		case *tx.SettleProposalTx:
+			g.yes, g.no, g.proposal = tx.VoteAcceptProposal, tx.VoteRejectProposal, stx.Proposal
+			if stx.Expired(st.BlockTime()) {
+				app.logger.Info("proposal expire reject", "expire", stx.ExpireTimestamp, "blockTime", st.BlockTime())
+				g.expired = true
 			}
+		case *tx.DisqualifyTx:
+			g.yes, g.no = tx.VoteDisqualifyMember, tx.VoteKeepMember
+		case *tx.AmendManifestTx:
+			g.yes, g.no = tx.VoteAmendManifest, tx.VoteRejectAmendment
+		case *tx.AmendParamsTx:
+			g.yes, g.no = tx.VoteAmendParams, tx.VoteRejectAmendment
+		case *tx.GenericTx:
+			g.yes, g.no = tx.VoteAcceptGeneric, tx.VoteRejectGeneric
+		}
+		govs = append(govs, g)
+	}
+
+	verdicts := make([]*state.Verdict, len(govs))
+	errs := make([]error, len(govs))
+	var wg sync.WaitGroup
+	for i, g := range govs {
+		if g.expired {
 			continue
 		}
+		wg.Add(1)
+		go func(i int, g *govTx) {
+			defer wg.Done()
+			verdicts[i], errs[i] = app.judge(ctx, st, round, g.hash, g.query, g.yes, g.no)
+		}(i, g)
+	}
+	wg.Wait()
+
+	codes = make([]tx.VoteCode, len(govs))
+	for i, g := range govs {
+		if errs[i] != nil {
+			return nil, nil, errs[i]
+		}
+		reason, fallback := "proposal expired", ""
+		if g.expired {
+			codes[i] = g.no
+		} else {
+			codes[i] = tx.VoteCode(verdicts[i].Code)
+			reason, fallback = verdicts[i].Reason, verdicts[i].Fallback
+		}
+		if g.proposal != 0 {
+			rationale := newRationale(g.proposal, codes[i], reason, g.rawTx)
+			rationale.Fallback = fallback
+			rationale.Index = i
+			rationales = append(rationales, rationale)
+		}
 	}
 	return
 }
+
+// govCode returns the vote code of the governance tx at index idx among the
+// governance txs of a block. A tx missing from the codes was voted on by too
+// few validators and counts as undecided.
+func govCode(codes []tx.VoteCode, idx int) tx.VoteCode {
+	if idx >= len(codes) {
+		return tx.VoteNoQuorum
+	}
+	return codes[idx]
+}
+
+// toVoteCodes converts the vote codes carried by consensus.
+func toVoteCodes(codes []int64) []tx.VoteCode {
+	res := make([]tx.VoteCode, len(codes))
+	for i, code := range codes {
+		res[i] = tx.VoteCode(code)
+	}
+	return res
+}
+
+// newRationale builds the rationale of a vote on proposal. The context is
+// the hash of the tx the agent judged, so it can be matched to the block.
+func newRationale(proposal uint64, code tx.VoteCode, reason string, stx []byte) *hac_types.VoteRationale {
+	// cut the reason at a rune boundary so it stays valid UTF-8.
+	if maxLen := hac_types.MaxRationaleReasonLen; len(reason) > maxLen {
+		for maxLen > 0 && !utf8.RuneStart(reason[maxLen]) {
+			maxLen--
+		}
+		reason = reason[:maxLen]
+	}
+	return &hac_types.VoteRationale{
+		Proposal: proposal,
+		VoteCode: int64(code),
+		Reason:   reason,
+		Context:  tmhash.Sum(stx),
+	}
+}
+
+// rationaleTx wraps the vote extensions of the last commit in a rationale
+// tx, or returns nil if no precommit carried one.
+func (app *HACApp) rationaleTx(height int64, commit *abcitypes.ExtendedCommitInfo) ([]byte, error) {
+	rtx := tx.RationaleTx{
+		Height: height - 1,
+		Round:  commit.Round,
+	}
+	for _, vote := range commit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+		rtx.Votes = append(rtx.Votes, tx.RationaleVote{
+			Validator: hex.EncodeToString(vote.Validator.Address),
+			Extension: vote.VoteExtension,
+			Signature: vote.ExtensionSignature,
+		})
+	}
+	if len(rtx.Votes) == 0 {
+		return nil, nil
+	}
+	return tx.MarshalHACTx(&tx.HACTx{
+		Version: tx.HACTxVersion2,
+		Type:    tx.HACTxTypeRationale,
+		Tx:      &rtx,
+	})
+}
+
+// voteCode maps an agent vote onto the code this validator casts. On a
+// multi-option proposal the vote names the chosen option; any answer that
+// is neither an option nor an abstention counts as no.
+func voteCode(vote *agent.VoteResponse, yes, no tx.VoteCode, options []string) tx.VoteCode {
+	if vote.Vote == agent.VoteAbstain {
+		return tx.VoteAbstain
+	}
+	if len(options) == 0 {
+		if vote.Vote == agent.VoteYes {
+			return yes
+		}
+		return no
+	}
+	for i, option := range options {
+		if vote.Vote == option {
+			return tx.OptionVoteCode(i)
+		}
+	}
+	return no
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+func TestNewRationaleReason(t *testing.T) {
+	const maxLen = hac_types.MaxRationaleReasonLen
+	tests := []struct {
+		name   string
+		reason string
+		want   int
+	}{
+		{"short", "fine", 4},
+		{"at the limit", strings.Repeat("a", maxLen), maxLen},
+		{"ascii over the limit", strings.Repeat("a", maxLen+1), maxLen},
+		// a 3 byte rune straddles the limit and is dropped whole.
+		{"rune over the limit", strings.Repeat("a", maxLen-1) + "世界", maxLen - 1},
+		{"runes over the limit", strings.Repeat("世", maxLen), maxLen - maxLen%3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRationale(1, tx.VoteAcceptProposal, tt.reason, []byte("tx"))
+			if len(r.Reason) != tt.want || !utf8.ValidString(r.Reason) || !strings.HasPrefix(tt.reason, r.Reason) {
+				t.Fatalf("reason of %d bytes, valid %v, want %d", len(r.Reason), utf8.ValidString(r.Reason), tt.want)
+			}
+		})
+	}
+}
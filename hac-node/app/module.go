@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hetu-project/hetu-chaoschain/agent"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/tx/handler"
+)
+
+var ErrInvalidModule = errors.New("invalid module")
+
+// Module adds a tx type to the chain as a subtype of the generic tx, so that
+// a domain specific action needs no change to this package or to tx.
+type Module struct {
+	// Name is the subtype of the txs of the module.
+	Name string
+	// Decode decodes the data of a tx of the module into its payload.
+	Decode tx.GenericDecoder
+	// Handler checks and applies the txs of the module. Its events are
+	// recorded under types.GenericEventType.
+	Handler handler.TxHandler
+	// Question, if set, makes the txs of the module governance txs: it
+	// builds the question the agent is asked about each of them, and the
+	// handler is given the vote code the validators decided.
+	Question func(st *state.State, btx *tx.HACTx, gtx *tx.GenericTx) (*Question, error)
+}
+
+// Question is what the agent is asked about a governance tx of a module.
+// The agent answers yes or no, voted with tx.VoteAcceptGeneric and
+// tx.VoteRejectGeneric, or picks one of Options, voted with
+// tx.OptionVoteCode. Ask runs in the background, so it must take from the
+// state everything it needs when the question is built.
+type Question struct {
+	// Subject identifies the question for the AgentFallbackLast policy.
+	Subject string
+	Options []string
+	Ask     func(ctx context.Context, cli agent.Client) (*agent.VoteResponse, error)
+}
+
+var (
+	modulesMtx sync.RWMutex
+	modules    = make(map[string]*Module)
+)
+
+// RegisterModule registers m, before the app is created.
+func RegisterModule(m *Module) error {
+	if m == nil || m.Handler == nil {
+		return fmt.Errorf("%w: no handler", ErrInvalidModule)
+	}
+	err := tx.RegisterGenericTx(m.Name, m.Decode, m.Question != nil)
+	if err != nil {
+		return err
+	}
+	modulesMtx.Lock()
+	defer modulesMtx.Unlock()
+	modules[m.Name] = m
+	return nil
+}
+
+func lookupModule(name string) *Module {
+	modulesMtx.RLock()
+	defer modulesMtx.RUnlock()
+	return modules[name]
+}
+
+// moduleHandlers returns the handlers of the registered modules by name.
+func moduleHandlers() map[string]handler.TxHandler {
+	modulesMtx.RLock()
+	defer modulesMtx.RUnlock()
+	handlers := make(map[string]handler.TxHandler, len(modules))
+	for name, m := range modules {
+		handlers[name] = m.Handler
+	}
+	return handlers
+}
+
+// moduleQuery builds the vote call judging a generic tx, or returns nil if
+// its module asks no question.
+func moduleQuery(st *state.State, btx *tx.HACTx, gtx *tx.GenericTx) (*agentQuery, error) {
+	m := lookupModule(gtx.Subtype)
+	if m == nil || m.Question == nil {
+		return nil, nil
+	}
+	q, err := m.Question(st, btx, gtx)
+	if err != nil {
+		return nil, err
+	}
+	if q == nil || q.Ask == nil {
+		return nil, fmt.Errorf("%w: %s asks no question", ErrInvalidModule, gtx.Subtype)
+	}
+	if len(q.Options) > tx.MaxProposalOptions {
+		return nil, fmt.Errorf("%w: %s asks with too many options", ErrInvalidModule, gtx.Subtype)
+	}
+	return &agentQuery{
+		subject: "generic:" + gtx.Subtype + ":" + q.Subject,
+		options: q.Options,
+		call: func(ctx context.Context) (*agent.VoteResponse, error) {
+			return q.Ask(ctx, agent.ElizaCli)
+		},
+	}, nil
+}
@@ -0,0 +1,204 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/hetu-project/hetu-chaoschain/agent"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// testModuleHandler accepts every tx of a module, emits an event of type
+// "done" and records the codes its txs were processed with.
+type testModuleHandler struct {
+	codes []tx.VoteCode
+}
+
+func (h *testModuleHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (*abcitypes.ResponseCheckTx, error) {
+	return &abcitypes.ResponseCheckTx{}, nil
+}
+
+func (h *testModuleHandler) NewContext(ctx context.Context) {}
+
+func (h *testModuleHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (*abcitypes.ExecTxResult, error) {
+	return &abcitypes.ExecTxResult{}, nil
+}
+
+func (h *testModuleHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (*abcitypes.ExecTxResult, error) {
+	h.codes = append(h.codes, code)
+	return &abcitypes.ExecTxResult{Events: []abcitypes.Event{{Type: "done"}}}, nil
+}
+
+func testModuleDecode(data []byte) (any, error) {
+	return string(data), nil
+}
+
+// testModuleQuestion asks the agent about the data of a tx.
+func testModuleQuestion(st *state.State, btx *tx.HACTx, gtx *tx.GenericTx) (*Question, error) {
+	data := gtx.Payload.(string)
+	return &Question{
+		Subject: data,
+		Ask: func(ctx context.Context, cli agent.Client) (*agent.VoteResponse, error) {
+			return cli.IfProcessProposal(ctx, data, "module")
+		},
+	}, nil
+}
+
+func TestRegisterModule(t *testing.T) {
+	tests := []struct {
+		name string
+		m    *Module
+		err  error
+	}{
+		{"registered", &Module{Name: "test_module", Decode: testModuleDecode, Handler: &testModuleHandler{}}, nil},
+		{"twice", &Module{Name: "test_module", Decode: testModuleDecode, Handler: &testModuleHandler{}}, tx.ErrGenericTxRegistered},
+		{"nil", nil, ErrInvalidModule},
+		{"no handler", &Module{Name: "test_no_handler", Decode: testModuleDecode}, ErrInvalidModule},
+		{"no decoder", &Module{Name: "test_no_decoder", Handler: &testModuleHandler{}}, tx.ErrInvalidGeneric},
+		{"invalid name", &Module{Name: "Test", Decode: testModuleDecode, Handler: &testModuleHandler{}}, tx.ErrInvalidGeneric},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterModule(tt.m)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if err == nil && lookupModule(tt.m.Name) != tt.m {
+				t.Fatal("module not registered")
+			}
+			if err != nil && tt.m != nil && tt.err != tx.ErrGenericTxRegistered && lookupModule(tt.m.Name) != nil {
+				t.Fatal("invalid module registered")
+			}
+		})
+	}
+}
+
+func TestModuleQuery(t *testing.T) {
+	options := make([]string, tx.MaxProposalOptions+1)
+	for i := range options {
+		options[i] = fmt.Sprint(i)
+	}
+	question := func(q *Question, err error) func(*state.State, *tx.HACTx, *tx.GenericTx) (*Question, error) {
+		return func(*state.State, *tx.HACTx, *tx.GenericTx) (*Question, error) { return q, err }
+	}
+	ask := func(ctx context.Context, cli agent.Client) (*agent.VoteResponse, error) { return voteYes, nil }
+	errQuestion := errors.New("no question")
+	tests := []struct {
+		name     string
+		question func(*state.State, *tx.HACTx, *tx.GenericTx) (*Question, error)
+		query    bool
+		err      error
+	}{
+		{"no question", nil, false, nil},
+		{"question", question(&Question{Subject: "s", Ask: ask}, nil), true, nil},
+		{"options", question(&Question{Subject: "s", Options: options[:tx.MaxProposalOptions], Ask: ask}, nil), true, nil},
+		{"too many options", question(&Question{Subject: "s", Options: options, Ask: ask}, nil), false, ErrInvalidModule},
+		{"no ask", question(&Question{Subject: "s"}, nil), false, ErrInvalidModule},
+		{"nil question", question(nil, nil), false, ErrInvalidModule},
+		{"question fails", question(nil, errQuestion), false, errQuestion},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := fmt.Sprintf("test_query_%d", i)
+			err := RegisterModule(&Module{Name: name, Decode: testModuleDecode, Handler: &testModuleHandler{}, Question: tt.question})
+			if err != nil {
+				t.Fatal(err)
+			}
+			q, err := moduleQuery(nil, &tx.HACTx{Type: tx.HACTxTypeGeneric}, &tx.GenericTx{Subtype: name})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if (q != nil) != tt.query {
+				t.Fatalf("query %v", q)
+			}
+			if q == nil {
+				return
+			}
+			if subject := "generic:" + name + ":s"; q.subject != subject {
+				t.Fatalf("subject %s, want %s", q.subject, subject)
+			}
+			res, err := q.call(context.Background())
+			if err != nil || res != voteYes {
+				t.Fatalf("call %v: %v", res, err)
+			}
+		})
+	}
+}
+
+func TestModuleTx(t *testing.T) {
+	h := &testModuleHandler{}
+	err := RegisterModule(&Module{Name: "test_module_tx", Decode: testModuleDecode, Handler: h, Question: testModuleQuestion})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().UTC()
+	tests := []struct {
+		name string
+		vote string
+		code tx.VoteCode
+	}{
+		{"accepted", agent.VoteYes, tx.VoteAcceptGeneric},
+		{"rejected", agent.VoteNo, tx.VoteRejectGeneric},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, key, idx := newTestApp(t)
+			cli := useTestAgent(t, tt.vote)
+			commitTestBlock(t, app, now, nil)
+			rawTx := signTestTx(t, app, key, idx, tx.HACTxTypeGeneric, &tx.GenericTx{Subtype: "test_module_tx", Data: []byte(tt.name)})
+			res, err := app.CheckTx(context.Background(), &abcitypes.RequestCheckTx{Tx: rawTx, Type: abcitypes.CheckTxType_New})
+			if err != nil || res.Code != 0 {
+				t.Fatalf("check %+v: %v", res, err)
+			}
+			if testVerdict(t, app, rawTx) == nil {
+				t.Fatal("not pre-evaluated")
+			}
+			proposal, err := app.ProcessProposal(context.Background(), &abcitypes.RequestProcessProposal{
+				Txs:    [][]byte{rawTx},
+				Hash:   []byte("block"),
+				Height: int64(app.db.Header().Height) + 1,
+				Time:   now.Add(time.Second),
+			})
+			if err != nil || proposal.Status != abcitypes.ResponseProcessProposal_ACCEPT {
+				t.Fatalf("process %+v: %v", proposal, err)
+			}
+			if len(proposal.VoteCodes) != 1 || proposal.VoteCodes[0] != int64(tt.code) || cli.calls.Load() != 1 {
+				t.Fatalf("codes %v after %d calls, want %d", proposal.VoteCodes, cli.calls.Load(), tt.code)
+			}
+
+			h.codes = nil
+			block := commitTestBlock(t, app, now.Add(time.Second), [][]byte{rawTx}, int64(tt.code))
+			if len(h.codes) != 1 || h.codes[0] != tt.code {
+				t.Fatalf("processed with %v, want %d", h.codes, tt.code)
+			}
+			if len(block.TxResults) != 1 || len(block.TxResults[0].Events) == 0 {
+				t.Fatalf("tx results %+v", block.TxResults)
+			}
+			found := false
+			for _, e := range block.TxResults[0].Events {
+				found = found || e.Type == hac_types.GenericEventType("test_module_tx", "done")
+			}
+			if !found {
+				t.Fatalf("events %+v", block.TxResults[0].Events)
+			}
+		})
+	}
+}
+
+func TestModuleTxUnknownSubtype(t *testing.T) {
+	app, key, idx := newTestApp(t)
+	rawTx := signTestTx(t, app, key, idx, tx.HACTxTypeGeneric, &tx.GenericTx{Subtype: "test_module_unknown"})
+	res, err := app.CheckTx(context.Background(), &abcitypes.RequestCheckTx{Tx: rawTx, Type: abcitypes.CheckTxType_New})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Code == 0 {
+		t.Fatal("tx of an unregistered subtype passed CheckTx")
+	}
+}
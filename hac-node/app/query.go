@@ -6,8 +6,13 @@ import (
 	"strings"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
 	cmtlog "github.com/cometbft/cometbft/libs/log"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/hetu-project/hetu-chaoschain/config"
 	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
 )
 
 func (app *HACApp) Query(ctx context.Context, req *abcitypes.RequestQuery) (res *abcitypes.ResponseQuery, err error) {
@@ -44,12 +49,7 @@ func (q *AccountQuerier) Query(ctx context.Context, req *abcitypes.RequestQuery)
 	var height uint64
 	if len(req.Data) == 20 {
 		a, height, _ = q.db.GetAccountByAddress(req.Data)
-	} else if len(req.Data) <= 8 {
-		var idx uint64
-		for _, v := range req.Data {
-			idx <<= 8
-			idx |= uint64(v)
-		}
+	} else if idx, ok := queryIndex(req.Data); ok {
 		a, height, _ = q.db.GetAccountByIndex(idx)
 	}
 	if a != nil {
@@ -89,3 +89,347 @@ func (q *ValidatorQuerier) Query(ctx context.Context, req *abcitypes.RequestQuer
 	res.Value, _ = json.Marshal(validators)
 	return
 }
+
+// queryIndex reads an index given as big endian bytes.
+func queryIndex(data []byte) (idx uint64, ok bool) {
+	if len(data) > 8 {
+		return 0, false
+	}
+	for _, v := range data {
+		idx <<= 8
+		idx |= uint64(v)
+	}
+	return idx, true
+}
+
+// StateQuerier returns the raw state entry a query is about, at the height
+// asked for, with its proof if asked to prove it.
+type StateQuerier struct {
+	db     *state.StateDB
+	logger cmtlog.Logger
+	key    func(data []byte) ([]byte, bool)
+}
+
+func newIndexQuerier(db *state.StateDB, logger cmtlog.Logger, key func(idx uint64) []byte) (q *StateQuerier) {
+	q = &StateQuerier{
+		db:     db,
+		logger: logger,
+		key: func(data []byte) ([]byte, bool) {
+			idx, ok := queryIndex(data)
+			if !ok {
+				return nil, false
+			}
+			return key(idx), true
+		},
+	}
+	return
+}
+
+// NewProposalQuerier queries proposals by index.
+func NewProposalQuerier(db *state.StateDB, logger cmtlog.Logger) (q *StateQuerier) {
+	return newIndexQuerier(db, logger, state.ProposalKey)
+}
+
+// NewDiscussionQuerier queries discussions by index.
+func NewDiscussionQuerier(db *state.StateDB, logger cmtlog.Logger) (q *StateQuerier) {
+	return newIndexQuerier(db, logger, state.DiscussionKey)
+}
+
+// NewVoteQuerier queries the vote rationales recorded for a proposal, by
+// proposal index.
+func NewVoteQuerier(db *state.StateDB, logger cmtlog.Logger) (q *StateQuerier) {
+	return newIndexQuerier(db, logger, state.RationaleKey)
+}
+
+// NewManifestQuerier queries the chain manifest: the active version if no
+// version is given, else the version asked for.
+func NewManifestQuerier(db *state.StateDB, logger cmtlog.Logger) (q *StateQuerier) {
+	q = &StateQuerier{
+		db:     db,
+		logger: logger,
+		key: func(data []byte) ([]byte, bool) {
+			version, ok := queryIndex(data)
+			if !ok {
+				return nil, false
+			}
+			if version == 0 {
+				return state.ManifestKey(), true
+			}
+			return state.ManifestVersionKey(version), true
+		},
+	}
+	return
+}
+
+// NewApplicationQuerier queries the pending membership application of an
+// address.
+func NewApplicationQuerier(db *state.StateDB, logger cmtlog.Logger) (q *StateQuerier) {
+	q = &StateQuerier{
+		db:     db,
+		logger: logger,
+		key: func(data []byte) ([]byte, bool) {
+			if len(data) != crypto.AddressSize {
+				return nil, false
+			}
+			return state.ApplicationKey(crypto.Address(data).String()), true
+		},
+	}
+	return
+}
+
+// NewCommunityPoolQuerier queries the deposits forfeited to the community
+// pool, as a big endian integer.
+func NewCommunityPoolQuerier(db *state.StateDB, logger cmtlog.Logger) (q *StateQuerier) {
+	q = &StateQuerier{
+		db:     db,
+		logger: logger,
+		key: func(data []byte) ([]byte, bool) {
+			return state.CommunityPoolKey(), true
+		},
+	}
+	return
+}
+
+// NewIssuedQuerier queries the stake issued as rewards, as a big endian
+// integer.
+func NewIssuedQuerier(db *state.StateDB, logger cmtlog.Logger) (q *StateQuerier) {
+	q = &StateQuerier{
+		db:     db,
+		logger: logger,
+		key: func(data []byte) ([]byte, bool) {
+			return state.IssuedKey(), true
+		},
+	}
+	return
+}
+
+func (q *StateQuerier) Query(ctx context.Context, req *abcitypes.RequestQuery) (res *abcitypes.ResponseQuery, err error) {
+	res = &abcitypes.ResponseQuery{}
+	key, ok := q.key(req.Data)
+	if !ok || req.Height < 0 {
+		res.Code = 1
+		return
+	}
+	value, height, proof, err := q.db.Query(key, uint64(req.Height), req.Prove)
+	if err != nil {
+		q.logger.Info("query state fail", "path", req.Path, "height", req.Height, "err", err)
+		res.Code = 1
+		res.Log = err.Error()
+		return res, nil
+	}
+	res.Key = key
+	res.Value = value
+	res.Height = int64(height)
+	if req.Prove {
+		res.ProofOps = &cmtcrypto.ProofOps{Ops: []cmtcrypto.ProofOp{{Type: state.ProofOpIAVL, Key: key, Data: proof}}}
+	}
+	// a missing entry still comes with its proof of absence.
+	if value == nil {
+		res.Code = 1
+	}
+	return
+}
+
+// ProposalDiscussionQuerier queries the discussions of a proposal, by
+// proposal index. A range comes without proof, each discussion can be
+// proven through /discussions/.
+type ProposalDiscussionQuerier struct {
+	db     *state.StateDB
+	logger cmtlog.Logger
+}
+
+func NewProposalDiscussionQuerier(db *state.StateDB, logger cmtlog.Logger) (q *ProposalDiscussionQuerier) {
+	q = &ProposalDiscussionQuerier{
+		db:     db,
+		logger: logger,
+	}
+	return
+}
+
+func (q *ProposalDiscussionQuerier) Query(ctx context.Context, req *abcitypes.RequestQuery) (res *abcitypes.ResponseQuery, err error) {
+	res = &abcitypes.ResponseQuery{}
+	proposal, ok := queryIndex(req.Data)
+	if !ok || req.Height < 0 {
+		res.Code = 1
+		return
+	}
+	discussions, height, err := q.db.QueryProposalDiscussions(proposal, uint64(req.Height))
+	if err != nil {
+		q.logger.Info("query proposal discussions fail", "proposal", proposal, "height", req.Height, "err", err)
+		res.Code = 1
+		res.Log = err.Error()
+		return res, nil
+	}
+	res.Height = int64(height)
+	res.Value, _ = json.Marshal(discussions)
+	return
+}
+
+// ManifestHistoryQuerier queries every version of the manifest. The history
+// comes without proof, each version can be proven through /manifest/.
+type ManifestHistoryQuerier struct {
+	db     *state.StateDB
+	logger cmtlog.Logger
+}
+
+func NewManifestHistoryQuerier(db *state.StateDB, logger cmtlog.Logger) (q *ManifestHistoryQuerier) {
+	q = &ManifestHistoryQuerier{
+		db:     db,
+		logger: logger,
+	}
+	return
+}
+
+func (q *ManifestHistoryQuerier) Query(ctx context.Context, req *abcitypes.RequestQuery) (res *abcitypes.ResponseQuery, err error) {
+	res = &abcitypes.ResponseQuery{}
+	if req.Height < 0 {
+		res.Code = 1
+		return
+	}
+	history, height, err := q.db.QueryManifestHistory(uint64(req.Height))
+	if err != nil {
+		q.logger.Info("query manifest history fail", "height", req.Height, "err", err)
+		res.Code = 1
+		res.Log = err.Error()
+		return res, nil
+	}
+	res.Height = int64(height)
+	res.Value, _ = json.Marshal(history)
+	return
+}
+
+// UnbondingQuerier queries the stake of an account waiting in the unbonding
+// queue.
+type UnbondingQuerier struct {
+	db     *state.StateDB
+	logger cmtlog.Logger
+}
+
+func NewUnbondingQuerier(db *state.StateDB, logger cmtlog.Logger) (q *UnbondingQuerier) {
+	q = &UnbondingQuerier{
+		db:     db,
+		logger: logger,
+	}
+	return
+}
+
+func (q *UnbondingQuerier) Query(ctx context.Context, req *abcitypes.RequestQuery) (res *abcitypes.ResponseQuery, err error) {
+	res = &abcitypes.ResponseQuery{}
+	account, ok := queryIndex(req.Data)
+	if !ok || req.Height < 0 {
+		res.Code = 1
+		return
+	}
+	unbondings, height, err := q.db.QueryUnbondings(account, uint64(req.Height))
+	if err != nil {
+		q.logger.Info("query unbondings fail", "account", account, "height", req.Height, "err", err)
+		res.Code = 1
+		res.Log = err.Error()
+		return res, nil
+	}
+	res.Height = int64(height)
+	res.Value, _ = json.Marshal(unbondings)
+	return
+}
+
+// SessionQuerier queries the session keys of an account, by account index.
+type SessionQuerier struct {
+	db     *state.StateDB
+	logger cmtlog.Logger
+}
+
+func NewSessionQuerier(db *state.StateDB, logger cmtlog.Logger) (q *SessionQuerier) {
+	q = &SessionQuerier{
+		db:     db,
+		logger: logger,
+	}
+	return
+}
+
+func (q *SessionQuerier) Query(ctx context.Context, req *abcitypes.RequestQuery) (res *abcitypes.ResponseQuery, err error) {
+	res = &abcitypes.ResponseQuery{}
+	account, ok := queryIndex(req.Data)
+	if !ok || req.Height < 0 {
+		res.Code = 1
+		return
+	}
+	sessions, height, err := q.db.QuerySessions(account, uint64(req.Height))
+	if err != nil {
+		q.logger.Info("query sessions fail", "account", account, "height", req.Height, "err", err)
+		res.Code = 1
+		res.Log = err.Error()
+		return res, nil
+	}
+	res.Height = int64(height)
+	res.Value, _ = json.Marshal(sessions)
+	return
+}
+
+// Params are the parameters of the chain at the queried height. The
+// embedded types.Params are read from the state, where the validators'
+// vote amends them; the others are fixed by the node software. They come
+// without proof.
+type Params struct {
+	*types.Params
+
+	ChainId               string `json:"chain_id"`
+	GWeiPerPower          uint64 `json:"gwei_per_power"`
+	MaxProposalOptions    int    `json:"max_proposal_options"`
+	MaxGovernanceTxs      int    `json:"max_governance_txs"`
+	MaxRationaleReasonLen int    `json:"max_rationale_reason_len"`
+	MaxManifestLen        int    `json:"max_manifest_len"`
+	UnbondingBlocks       uint64 `json:"unbonding_blocks"`
+
+	BlockReward                 uint64 `json:"block_reward"`
+	ProposalReward              uint64 `json:"proposal_reward"`
+	DiscussionReward            uint64 `json:"discussion_reward"`
+	RewardedProposalDiscussions uint64 `json:"rewarded_proposal_discussions"`
+	RewardHalvingBlocks         uint64 `json:"reward_halving_blocks"`
+}
+
+type ParamsQuerier struct {
+	db     *state.StateDB
+	logger cmtlog.Logger
+}
+
+func NewParamsQuerier(db *state.StateDB, logger cmtlog.Logger) (q *ParamsQuerier) {
+	q = &ParamsQuerier{
+		db:     db,
+		logger: logger,
+	}
+	return
+}
+
+func (q *ParamsQuerier) Query(ctx context.Context, req *abcitypes.RequestQuery) (res *abcitypes.ResponseQuery, err error) {
+	res = &abcitypes.ResponseQuery{}
+	if req.Height < 0 {
+		res.Code = 1
+		return
+	}
+	params, height, err := q.db.QueryParams(uint64(req.Height))
+	if err != nil {
+		q.logger.Info("query params fail", "height", req.Height, "err", err)
+		res.Code = 1
+		res.Log = err.Error()
+		return res, nil
+	}
+	header := q.db.Header()
+	res.Height = int64(height)
+	res.Value, _ = json.Marshal(&Params{
+		Params:                params,
+		ChainId:               header.ChainId,
+		GWeiPerPower:          config.GWeiPerPower(height),
+		MaxProposalOptions:    tx.MaxProposalOptions,
+		MaxGovernanceTxs:      tx.MaxGovernanceTxs,
+		MaxRationaleReasonLen: types.MaxRationaleReasonLen,
+		MaxManifestLen:        tx.MaxManifestLen,
+		UnbondingBlocks:       config.UnbondingBlocks(height),
+
+		BlockReward:                 config.BlockReward(height),
+		ProposalReward:              config.ProposalReward(height),
+		DiscussionReward:            config.DiscussionReward(height),
+		RewardedProposalDiscussions: config.RewardedProposalDiscussions(height),
+		RewardHalvingBlocks:         config.RewardHalvingBlocks,
+	})
+	return
+}
@@ -0,0 +1,185 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// verifyQueryProof checks the proof of res against the state root at its
+// height, of membership if res found a value and of absence otherwise.
+func verifyQueryProof(t *testing.T, roots map[int64][]byte, res *abcitypes.ResponseQuery) {
+	t.Helper()
+	if res.ProofOps == nil || len(res.ProofOps.Ops) != 1 || res.ProofOps.Ops[0].Type != state.ProofOpIAVL {
+		t.Fatalf("proof ops %+v", res.ProofOps)
+	}
+	var proof ics23.CommitmentProof
+	if err := proof.Unmarshal(res.ProofOps.Ops[0].Data); err != nil {
+		t.Fatal(err)
+	}
+	root, ok := roots[res.Height]
+	if !ok {
+		t.Fatalf("no root at height %d", res.Height)
+	}
+	if res.Value != nil {
+		if !ics23.VerifyMembership(ics23.IavlSpec, root, &proof, res.Key, res.Value) {
+			t.Fatal("membership proof invalid")
+		}
+		return
+	}
+	if !ics23.VerifyNonMembership(ics23.IavlSpec, root, &proof, res.Key) {
+		t.Fatal("absence proof invalid")
+	}
+}
+
+func TestQuery(t *testing.T) {
+	app, key, idx := newTestApp(t)
+	now := time.Now().UTC()
+	live := uint(now.Add(time.Hour).Unix())
+	// roots holds the state root each height commits, the app hash is its
+	// keccak256.
+	roots := make(map[int64][]byte)
+	commit := func(txs [][]byte, codes ...int64) {
+		res := commitTestBlock(t, app, now, txs, codes...)
+		header := app.db.Header()
+		if string(crypto.Keccak256(header.RootHash)) != string(res.AppHash) {
+			t.Fatalf("app hash %x, root %x", res.AppHash, header.RootHash)
+		}
+		roots[int64(header.Height)] = header.RootHash
+	}
+	commit(nil)
+	proposal := signTestTx(t, app, key, idx, tx.HACTxTypeProposal, &tx.ProposalTx{Title: "title", EndHeight: 100, ExpireTimestamp: live})
+	commit([][]byte{proposal}, int64(tx.VoteProcessProposal))
+	proposalHeight := int64(app.db.Header().Height)
+	amend := signTestTx(t, app, key, idx, tx.HACTxTypeAmendManifest, &tx.AmendManifestTx{Text: "manifest v2", Reason: "reason"})
+	commit([][]byte{amend}, int64(tx.VoteAmendManifest))
+	amendHeight := int64(app.db.Header().Height)
+	discussion := signTestTx(t, app, key, idx, tx.HACTxTypeDiscussion, &tx.DiscussionTx{Proposal: 1, Data: []byte("discussion")})
+	commit([][]byte{discussion})
+	latest := int64(app.db.Header().Height)
+
+	tests := []struct {
+		name   string
+		path   string
+		data   []byte
+		height int64
+		prove  bool
+		code   uint32
+		// check checks the value found.
+		check func(t *testing.T, value []byte)
+	}{
+		{"proposal", "/proposals", []byte{1}, 0, true, 0, func(t *testing.T, value []byte) {
+			var p types.Proposal
+			if err := json.Unmarshal(value, &p); err != nil || p.Index != 1 || p.Title != "title" || p.Proposer != idx {
+				t.Fatalf("proposal %+v: %v", p, err)
+			}
+		}},
+		{"proposal without proof", "/proposals/", []byte{1}, 0, false, 0, nil},
+		{"proposal before it", "/proposals/", []byte{1}, proposalHeight - 1, true, 1, nil},
+		{"missing proposal", "/proposals/", []byte{2}, 0, true, 1, nil},
+		{"proposal index too long", "/proposals/", make([]byte, 9), 0, true, 1, nil},
+		{"proposal future height", "/proposals/", []byte{1}, latest + 1, true, 1, nil},
+		{"proposal negative height", "/proposals/", []byte{1}, -1, true, 1, nil},
+		{"no votes yet", "/votes/", []byte{1}, 0, true, 1, nil},
+		{"discussion", "/discussions/", []byte{1}, 0, true, 0, func(t *testing.T, value []byte) {
+			var d types.Discussion
+			if err := json.Unmarshal(value, &d); err != nil || d.Index != 1 || d.Proposal != 1 || d.Speaker != idx || string(d.Data) != "discussion" {
+				t.Fatalf("discussion %+v: %v", d, err)
+			}
+		}},
+		{"discussion before it", "/discussions/", []byte{1}, amendHeight, true, 1, nil},
+		{"missing discussion", "/discussions/", []byte{2}, 0, true, 1, nil},
+		{"proposal discussions", "/proposal_discussions/", []byte{1}, 0, false, 0, func(t *testing.T, value []byte) {
+			var ds []types.Discussion
+			if err := json.Unmarshal(value, &ds); err != nil || len(ds) != 1 || ds[0].Index != 1 {
+				t.Fatalf("discussions %+v: %v", ds, err)
+			}
+		}},
+		{"proposal discussions before them", "/proposal_discussions/", []byte{1}, amendHeight, false, 0, func(t *testing.T, value []byte) {
+			var ds []types.Discussion
+			if err := json.Unmarshal(value, &ds); err != nil || len(ds) != 0 {
+				t.Fatalf("discussions %+v: %v", ds, err)
+			}
+		}},
+		{"discussions of a missing proposal", "/proposal_discussions/", []byte{2}, 0, false, 0, func(t *testing.T, value []byte) {
+			var ds []types.Discussion
+			if err := json.Unmarshal(value, &ds); err != nil || len(ds) != 0 {
+				t.Fatalf("discussions %+v: %v", ds, err)
+			}
+		}},
+		{"proposal discussions index too long", "/proposal_discussions/", make([]byte, 9), 0, false, 1, nil},
+		{"proposal discussions future height", "/proposal_discussions/", []byte{1}, latest + 1, false, 1, nil},
+		{"active manifest", "/manifest/", nil, 0, true, 0, func(t *testing.T, value []byte) {
+			var m types.Manifest
+			if err := json.Unmarshal(value, &m); err != nil || m.Version != 2 || m.Text != "manifest v2" {
+				t.Fatalf("manifest %+v: %v", m, err)
+			}
+		}},
+		{"manifest before the amendment", "/manifest/", nil, amendHeight - 1, true, 0, func(t *testing.T, value []byte) {
+			var m types.Manifest
+			if err := json.Unmarshal(value, &m); err != nil || m.Version != 1 {
+				t.Fatalf("manifest %+v: %v", m, err)
+			}
+		}},
+		{"manifest version", "/manifest/", []byte{1}, 0, true, 0, func(t *testing.T, value []byte) {
+			var m types.Manifest
+			if err := json.Unmarshal(value, &m); err != nil || m.Version != 1 {
+				t.Fatalf("manifest %+v: %v", m, err)
+			}
+		}},
+		{"missing manifest version", "/manifest/", []byte{3}, 0, true, 1, nil},
+		{"manifest history", "/manifest_history/", nil, 0, false, 0, func(t *testing.T, value []byte) {
+			var history []types.Manifest
+			if err := json.Unmarshal(value, &history); err != nil || len(history) != 2 || history[1].Reason != "reason" {
+				t.Fatalf("history %+v: %v", history, err)
+			}
+		}},
+		{"manifest history negative height", "/manifest_history/", nil, -1, false, 1, nil},
+		{"params", "/params/", nil, 0, false, 0, func(t *testing.T, value []byte) {
+			var p Params
+			if err := json.Unmarshal(value, &p); err != nil || p.ChainId != "test" || p.MaxGovernanceTxs != tx.MaxGovernanceTxs || p.Params == nil {
+				t.Fatalf("params %+v: %v", p, err)
+			}
+		}},
+		{"params future height", "/params/", nil, latest + 1, false, 1, nil},
+		{"unknown path", "/nothing/", nil, 0, false, 404, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := app.Query(context.Background(), &abcitypes.RequestQuery{Path: tt.path, Data: tt.data, Height: tt.height, Prove: tt.prove})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Code != tt.code {
+				t.Fatalf("code %d log %q, want %d", res.Code, res.Log, tt.code)
+			}
+			if tt.height > latest && !strings.Contains(res.Log, state.ErrQueryHeightInvalid.Error()) {
+				t.Fatalf("log %q, want %v", res.Log, state.ErrQueryHeightInvalid)
+			}
+			if res.Code == 0 {
+				wantHeight := tt.height
+				if wantHeight == 0 {
+					wantHeight = latest
+				}
+				if res.Height != wantHeight {
+					t.Fatalf("height %d, want %d", res.Height, wantHeight)
+				}
+				if tt.check != nil {
+					tt.check(t, res.Value)
+				}
+			}
+			if tt.prove && res.Key != nil {
+				verifyQueryProof(t, roots, res)
+			}
+		})
+	}
+}
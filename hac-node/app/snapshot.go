@@ -0,0 +1,165 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/hetu-project/hetu-chaoschain/state"
+)
+
+// snapshotRestore is a snapshot offered by state sync, restored once all of
+// its chunks are applied.
+type snapshotRestore struct {
+	snapshot *state.Snapshot
+	appHash  []byte
+	chunks   [][]byte
+	applied  int
+}
+
+// takeSnapshot snapshots the state committed at height in the background,
+// if the height falls on the snapshot interval. A snapshot still being
+// taken skips the next one.
+func (app *HACApp) takeSnapshot(height uint64) {
+	if app.cfg.SnapshotInterval == 0 || height == 0 || height%app.cfg.SnapshotInterval != 0 {
+		return
+	}
+	if !app.snapshotting.CompareAndSwap(false, true) {
+		app.logger.Info("snapshot in progress, skipped", "height", height)
+		return
+	}
+	version := app.db.Version()
+	app.snapshotWg.Add(1)
+	go func() {
+		defer app.snapshotWg.Done()
+		defer app.snapshotting.Store(false)
+		snapshot, chunks, err := app.db.ExportSnapshot(height, version)
+		if err != nil {
+			app.logger.Error("export snapshot fail", "height", height, "err", err)
+			return
+		}
+		err = app.snapshotDB.Save(snapshot, chunks)
+		if err != nil {
+			app.logger.Error("save snapshot fail", "height", height, "err", err)
+			return
+		}
+		app.logger.Info("snapshot taken", "height", height, "chunks", len(chunks))
+		err = app.snapshotDB.Prune(app.cfg.SnapshotKeepRecent)
+		if err != nil {
+			app.logger.Error("prune snapshots fail", "err", err)
+		}
+	}()
+}
+
+func (app *HACApp) ListSnapshots(context.Context, *abcitypes.RequestListSnapshots) (*abcitypes.ResponseListSnapshots, error) {
+	res := &abcitypes.ResponseListSnapshots{}
+	snapshots, err := app.snapshotDB.List()
+	if err != nil {
+		app.logger.Error("list snapshots fail", "err", err)
+		return res, nil
+	}
+	for _, snapshot := range snapshots {
+		metadata, err := json.Marshal(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		res.Snapshots = append(res.Snapshots, &abcitypes.Snapshot{
+			Height:   snapshot.Height,
+			Format:   snapshot.Format,
+			Chunks:   uint32(len(snapshot.ChunkHashes)),
+			Hash:     snapshot.Hash,
+			Metadata: metadata,
+		})
+	}
+	return res, nil
+}
+
+func (app *HACApp) LoadSnapshotChunk(_ context.Context, req *abcitypes.RequestLoadSnapshotChunk) (*abcitypes.ResponseLoadSnapshotChunk, error) {
+	res := &abcitypes.ResponseLoadSnapshotChunk{}
+	if req.Format != state.SnapshotFormat {
+		return res, nil
+	}
+	chunk, err := app.snapshotDB.LoadChunk(req.Height, req.Chunk)
+	if err != nil {
+		app.logger.Error("load snapshot chunk fail", "height", req.Height, "chunk", req.Chunk, "err", err)
+		return res, nil
+	}
+	res.Chunk = chunk
+	return res, nil
+}
+
+// OfferSnapshot accepts a snapshot whose metadata matches it. The app hash
+// it is checked against once restored comes from the light client.
+func (app *HACApp) OfferSnapshot(_ context.Context, req *abcitypes.RequestOfferSnapshot) (*abcitypes.ResponseOfferSnapshot, error) {
+	reject := &abcitypes.ResponseOfferSnapshot{Result: abcitypes.ResponseOfferSnapshot_REJECT}
+	if req.Snapshot == nil {
+		return reject, nil
+	}
+	if req.Snapshot.Format != state.SnapshotFormat {
+		return &abcitypes.ResponseOfferSnapshot{Result: abcitypes.ResponseOfferSnapshot_REJECT_FORMAT}, nil
+	}
+	snapshot := &state.Snapshot{}
+	if err := json.Unmarshal(req.Snapshot.Metadata, snapshot); err != nil {
+		app.logger.Info("OfferSnapshot unmarshal metadata fail", "height", req.Snapshot.Height, "err", err)
+		return reject, nil
+	}
+	if snapshot.Height != req.Snapshot.Height || snapshot.Format != req.Snapshot.Format ||
+		uint32(len(snapshot.ChunkHashes)) != req.Snapshot.Chunks || !bytes.Equal(snapshot.Hash, req.Snapshot.Hash) {
+		app.logger.Info("OfferSnapshot metadata unmatched", "height", req.Snapshot.Height)
+		return reject, nil
+	}
+	if err := snapshot.Verify(); err != nil {
+		app.logger.Info("OfferSnapshot verify fail", "height", req.Snapshot.Height, "err", err)
+		return reject, nil
+	}
+	if app.db.Version() != 0 {
+		app.logger.Error("OfferSnapshot state not empty", "height", app.db.Header().Height)
+		return &abcitypes.ResponseOfferSnapshot{Result: abcitypes.ResponseOfferSnapshot_ABORT}, nil
+	}
+	app.restore = &snapshotRestore{
+		snapshot: snapshot,
+		appHash:  req.AppHash,
+		chunks:   make([][]byte, len(snapshot.ChunkHashes)),
+	}
+	app.logger.Info("snapshot accepted", "height", snapshot.Height, "chunks", len(snapshot.ChunkHashes))
+	return &abcitypes.ResponseOfferSnapshot{Result: abcitypes.ResponseOfferSnapshot_ACCEPT}, nil
+}
+
+// ApplySnapshotChunk collects the chunks of the accepted snapshot, checked
+// against their hashes, and restores the state once it has them all.
+func (app *HACApp) ApplySnapshotChunk(_ context.Context, req *abcitypes.RequestApplySnapshotChunk) (*abcitypes.ResponseApplySnapshotChunk, error) {
+	abort := &abcitypes.ResponseApplySnapshotChunk{Result: abcitypes.ResponseApplySnapshotChunk_ABORT}
+	r := app.restore
+	if r == nil {
+		return abort, nil
+	}
+	if err := r.snapshot.VerifyChunk(req.Index, req.Chunk); err != nil {
+		app.logger.Info("ApplySnapshotChunk verify fail", "chunk", req.Index, "sender", req.Sender, "err", err)
+		return &abcitypes.ResponseApplySnapshotChunk{
+			Result:        abcitypes.ResponseApplySnapshotChunk_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}, nil
+	}
+	if r.chunks[req.Index] == nil {
+		r.applied++
+	}
+	r.chunks[req.Index] = req.Chunk
+	if r.applied < len(r.chunks) {
+		return &abcitypes.ResponseApplySnapshotChunk{Result: abcitypes.ResponseApplySnapshotChunk_ACCEPT}, nil
+	}
+
+	app.restore = nil
+	hash, err := app.db.RestoreSnapshot(r.snapshot, r.chunks)
+	if err != nil {
+		app.logger.Error("restore snapshot fail", "height", r.snapshot.Height, "err", err)
+		return abort, nil
+	}
+	if !bytes.Equal(hash, r.appHash) {
+		app.logger.Error("restored state hash unmatched", "height", r.snapshot.Height, "hash", hash, "appHash", r.appHash)
+		return abort, nil
+	}
+	app.lastBlk.Height = r.snapshot.Height
+	return &abcitypes.ResponseApplySnapshotChunk{Result: abcitypes.ResponseApplySnapshotChunk_ACCEPT}, nil
+}
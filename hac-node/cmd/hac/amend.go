@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/spf13/cobra"
+)
+
+type amendArguments struct {
+	Url    string
+	Index  uint64
+	Nonce  uint64
+	Skey   string
+	File   string
+	Old    string
+	New    string
+	Reason string
+	NoSend bool
+	Sig    string
+}
+
+var amendArgs amendArguments
+
+var amendCmd = &cobra.Command{
+	Use:   "amend",
+	Short: "propose an amendment of the manifest",
+	Long:  `propose either the whole text of the new manifest with --file, or a replacement of one passage with --old and --new`,
+	Run:   amendRun,
+}
+
+func init() {
+	urlFlag(amendCmd, &amendArgs.Url)
+	amendCmd.Flags().Uint64VarP(&amendArgs.Index, "index", "i", 0, "account index")
+	amendCmd.Flags().Uint64VarP(&amendArgs.Nonce, "nonce", "n", 0, "account nonce")
+	amendCmd.Flags().StringVarP(&amendArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
+	amendCmd.Flags().StringVarP(&amendArgs.File, "file", "f", "", "file holding the text of the new manifest")
+	amendCmd.Flags().StringVarP(&amendArgs.Old, "old", "", "", "passage of the manifest to replace")
+	amendCmd.Flags().StringVarP(&amendArgs.New, "new", "", "", "replacement of the passage")
+	amendCmd.Flags().StringVarP(&amendArgs.Reason, "reason", "r", "", "reason for the amendment")
+	sigFlags(amendCmd, &amendArgs.NoSend, &amendArgs.Sig)
+}
+
+func amendRun(cmd *cobra.Command, args []string) {
+	atx := &tx.AmendManifestTx{Reason: amendArgs.Reason}
+	if amendArgs.File != "" {
+		text, err := os.ReadFile(amendArgs.File)
+		if err != nil {
+			fmt.Printf("read manifest file err:%v\n", err)
+			return
+		}
+		atx.Text = string(text)
+	} else {
+		atx.Edits = []tx.ManifestEdit{{Old: amendArgs.Old, New: amendArgs.New}}
+	}
+	cli, err := http.New(amendArgs.Url, "/websocket")
+	if err != nil {
+		fmt.Printf("new client err:%v\n", err)
+		return
+	}
+	ctx := context.Background()
+	gres, err := cli.Genesis(ctx)
+	if err != nil {
+		fmt.Printf("get chain genesis err:%v\n", err)
+		return
+	}
+	chainId := gres.Genesis.ChainID
+	nonce := amendArgs.Nonce
+	if nonce == 0 {
+		act, err := queryAccount(amendArgs.Url, amendArgs.Index, "")
+		if err != nil {
+			return
+		}
+		nonce = act.Nonce
+	}
+	btx := tx.HACTx{
+		Version:   tx.HACTxVersion2,
+		Type:      tx.HACTxTypeAmendManifest,
+		Nonce:     nonce,
+		Validator: amendArgs.Index,
+		Tx:        atx,
+	}
+	dat, err := btx.SigData([]byte(chainId))
+	if err != nil {
+		fmt.Printf("tx sign data err:%v\n", err)
+		return
+	}
+	sig, sigs, err := signTx(amendArgs.Url, &btx, dat, amendArgs.Skey, amendArgs.Sig)
+	if err != nil {
+		fmt.Printf("sign tx err:%v\n", err)
+		return
+	}
+	if amendArgs.NoSend {
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
+		return
+	}
+	btx.Sig = sigs
+	dat, err = encodeTx(&btx)
+	if err != nil {
+		fmt.Printf("encode tx err:%v\n", err)
+		return
+	}
+	fmt.Printf("tx:%s\n", hex.EncodeToString(dat))
+	res, err := cli.BroadcastTxSync(ctx, dat)
+	if err != nil {
+		fmt.Printf("broadcast tx err:%v\n", err)
+		return
+	}
+	dat, _ = json.Marshal(res)
+	fmt.Printf("%v\n", string(dat))
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/hetu-project/hetu-chaoschain/crypto"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+	"github.com/spf13/cobra"
+)
+
+type applyArguments struct {
+	Url             string
+	Skey            string
+	Statement       string
+	AgentUrl        string
+	Name            string
+	ManifestVersion uint64
+}
+
+var applyArgs applyArguments
+
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "apply for membership with the key of the new node, agreeing to the manifest",
+	Long:  ``,
+	Run:   applyRun,
+}
+
+func init() {
+	urlFlag(applyCmd, &applyArgs.Url)
+	applyCmd.Flags().StringVarP(&applyArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path of the applicant")
+	applyCmd.Flags().StringVarP(&applyArgs.Statement, "statement", "", "", "reasons for joining")
+	applyCmd.Flags().StringVarP(&applyArgs.AgentUrl, "agentUrl", "", types.DefaultAgentUrl, "agent url of the applicant")
+	applyCmd.Flags().StringVarP(&applyArgs.Name, "name", "", "", "applicant name")
+	applyCmd.Flags().Uint64VarP(&applyArgs.ManifestVersion, "manifestVersion", "m", 0, "manifest version agreed to, the active one if 0")
+}
+
+func applyRun(cmd *cobra.Command, args []string) {
+	cli, err := http.New(applyArgs.Url, "/websocket")
+	if err != nil {
+		fmt.Printf("new client err:%v\n", err)
+		return
+	}
+	ctx := context.Background()
+	gres, err := cli.Genesis(ctx)
+	if err != nil {
+		fmt.Printf("get chain genesis err:%v\n", err)
+		return
+	}
+	chainId := gres.Genesis.ChainID
+	version := applyArgs.ManifestVersion
+	if version == 0 {
+		res, err := cli.ABCIQuery(ctx, "/manifest/", nil)
+		if err != nil {
+			fmt.Printf("query manifest err:%v\n", err)
+			return
+		}
+		var manifest types.Manifest
+		if err = json.Unmarshal(res.Response.Value, &manifest); err != nil {
+			fmt.Printf("decode manifest err:%v\n", err)
+			return
+		}
+		version = manifest.Version
+	}
+	pv := crypto.LoadFilePV(applyArgs.Skey)
+	if applyArgs.Name == "" {
+		applyArgs.Name = hex.EncodeToString(pv.PublicKey())
+	}
+	btx := tx.HACTx{
+		Version: tx.HACTxVersion2,
+		Type:    tx.HACTxTypeApply,
+		Tx: &tx.ApplyTx{
+			Pubkey:          pv.PublicKey(),
+			Statement:       applyArgs.Statement,
+			AgentUrl:        applyArgs.AgentUrl,
+			Name:            applyArgs.Name,
+			ManifestVersion: version,
+		},
+	}
+	dat, err := btx.SigData([]byte(chainId))
+	if err != nil {
+		fmt.Printf("tx sign data err:%v\n", err)
+		return
+	}
+	sig, err := pv.Sign(dat)
+	if err != nil {
+		fmt.Printf("sign tx err:%v\n", err)
+		return
+	}
+	btx.Sig = [][]byte{sig}
+	dat, err = encodeTx(&btx)
+	if err != nil {
+		fmt.Printf("encode tx err:%v\n", err)
+		return
+	}
+	fmt.Printf("tx:%s\n", hex.EncodeToString(dat))
+	res, err := cli.BroadcastTxSync(ctx, dat)
+	if err != nil {
+		fmt.Printf("broadcast tx err:%v\n", err)
+		return
+	}
+	dat, _ = json.Marshal(res)
+	fmt.Printf("%v\n", string(dat))
+}
@@ -86,6 +86,7 @@ func run(cmd *cobra.Command, args []string) {
 	// new app
 	appConfig.App.Home = homeDir
 	appConfig.App.TimeoutCommit = uint64(appConfig.Consensus.TimeoutCommit.Seconds())
+	appConfig.App.AgentTimeout = app_config.AgentTimeout(appConfig.Consensus)
 	app, err := app.NewHACApp(appConfig.App, agent.ElizaCli, logger)
 	if err != nil {
 		log.Fatalf("new App err:%v", err)
@@ -7,7 +7,6 @@ import (
 	"fmt"
 
 	"github.com/cometbft/cometbft/rpc/client/http"
-	"github.com/hetu-project/hetu-chaoschain/crypto"
 	"github.com/hetu-project/hetu-chaoschain/tx"
 	"github.com/spf13/cobra"
 )
@@ -40,7 +39,7 @@ func init() {
 	discussionCmd.Flags().StringVarP(&discussionArgs.Data, "data", "d", "", "proposal data")
 	discussionCmd.Flags().Uint64VarP(&discussionArgs.Proposal, "proposal", "p", 0, "proposal index")
 	discussionCmd.Flags().BoolVarP(&discussionArgs.NoSend, "nosend", "", false, "not send transaction but print signature")
-	discussionCmd.Flags().StringVarP(&discussionArgs.Sig, "sig", "", "", "transaction signatures")
+	discussionCmd.Flags().StringVarP(&discussionArgs.Sig, "sig", "", "", "signatures of the other signers, comma separated")
 }
 
 func discussionRun(cmd *cobra.Command, args []string) {
@@ -65,7 +64,7 @@ func discussionRun(cmd *cobra.Command, args []string) {
 		nonce = act.Nonce
 	}
 	btx := tx.HACTx{
-		Version:   tx.HACTxVersion1,
+		Version:   tx.HACTxVersion2,
 		Nonce:     nonce,
 		Validator: discussionArgs.Index,
 	}
@@ -82,25 +81,18 @@ func discussionRun(cmd *cobra.Command, args []string) {
 	}
 	println("data to sign:", string(dat))
 	println("data signed:", hex.EncodeToString(dat))
-	sigs := [][]byte{}
-	pv := crypto.LoadFilePV(discussionArgs.Skey)
-	sig, err := pv.Sign(dat)
+	sig, sigs, err := signTx(discussionArgs.Url, &btx, dat, discussionArgs.Skey, discussionArgs.Sig)
 	if err != nil {
 		fmt.Printf("sign tx err:%v\n", err)
 		return
 	}
-	println("pubkey:", hex.EncodeToString(pv.PublicKey()))
-	println("address:", pv.Address())
-	sigs = append(sigs, sig)
 	if discussionArgs.NoSend {
-		fmt.Println("transaction signatures:")
-		for _, sig := range sigs {
-			fmt.Println(hex.EncodeToString(sig))
-		}
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
 		return
 	}
 	btx.Sig = sigs
-	dat, err = json.Marshal(btx)
+	dat, err = encodeTx(&btx)
 	if err != nil {
 		fmt.Printf("rlp encode tx err:%v\n", err)
 		return
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/spf13/cobra"
+)
+
+type disqualifyArguments struct {
+	Url      string
+	Index    uint64
+	Nonce    uint64
+	Skey     string
+	Target   uint64
+	Clause   string
+	Evidence string
+	NoSend   bool
+	Sig      string
+}
+
+var disqualifyArgs disqualifyArguments
+
+var disqualifyCmd = &cobra.Command{
+	Use:   "disqualify",
+	Short: "ask the validators to expel a member breaking the manifest",
+	Long:  ``,
+	Run:   disqualifyRun,
+}
+
+func init() {
+	urlFlag(disqualifyCmd, &disqualifyArgs.Url)
+	disqualifyCmd.Flags().Uint64VarP(&disqualifyArgs.Index, "index", "i", 0, "account index")
+	disqualifyCmd.Flags().Uint64VarP(&disqualifyArgs.Nonce, "nonce", "n", 0, "account nonce")
+	disqualifyCmd.Flags().StringVarP(&disqualifyArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
+	disqualifyCmd.Flags().Uint64VarP(&disqualifyArgs.Target, "target", "t", 0, "index of the account to expel")
+	disqualifyCmd.Flags().StringVarP(&disqualifyArgs.Clause, "clause", "c", "", "manifest clause broken, quoted as stored")
+	disqualifyCmd.Flags().StringVarP(&disqualifyArgs.Evidence, "evidence", "e", "", "evidence of the breach")
+	sigFlags(disqualifyCmd, &disqualifyArgs.NoSend, &disqualifyArgs.Sig)
+}
+
+func disqualifyRun(cmd *cobra.Command, args []string) {
+	cli, err := http.New(disqualifyArgs.Url, "/websocket")
+	if err != nil {
+		fmt.Printf("new client err:%v\n", err)
+		return
+	}
+	ctx := context.Background()
+	gres, err := cli.Genesis(ctx)
+	if err != nil {
+		fmt.Printf("get chain genesis err:%v\n", err)
+		return
+	}
+	chainId := gres.Genesis.ChainID
+	nonce := disqualifyArgs.Nonce
+	if nonce == 0 {
+		act, err := queryAccount(disqualifyArgs.Url, disqualifyArgs.Index, "")
+		if err != nil {
+			return
+		}
+		nonce = act.Nonce
+	}
+	btx := tx.HACTx{
+		Version:   tx.HACTxVersion2,
+		Type:      tx.HACTxTypeDisqualify,
+		Nonce:     nonce,
+		Validator: disqualifyArgs.Index,
+		Tx: &tx.DisqualifyTx{
+			Target:   disqualifyArgs.Target,
+			Clause:   disqualifyArgs.Clause,
+			Evidence: disqualifyArgs.Evidence,
+		},
+	}
+	dat, err := btx.SigData([]byte(chainId))
+	if err != nil {
+		fmt.Printf("tx sign data err:%v\n", err)
+		return
+	}
+	sig, sigs, err := signTx(disqualifyArgs.Url, &btx, dat, disqualifyArgs.Skey, disqualifyArgs.Sig)
+	if err != nil {
+		fmt.Printf("sign tx err:%v\n", err)
+		return
+	}
+	if disqualifyArgs.NoSend {
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
+		return
+	}
+	btx.Sig = sigs
+	dat, err = encodeTx(&btx)
+	if err != nil {
+		fmt.Printf("encode tx err:%v\n", err)
+		return
+	}
+	fmt.Printf("tx:%s\n", hex.EncodeToString(dat))
+	res, err := cli.BroadcastTxSync(ctx, dat)
+	if err != nil {
+		fmt.Printf("broadcast tx err:%v\n", err)
+		return
+	}
+	dat, _ = json.Marshal(res)
+	fmt.Printf("%v\n", string(dat))
+}
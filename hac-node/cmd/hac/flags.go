@@ -1,7 +1,22 @@
 package main
 
-import "github.com/spf13/cobra"
+import (
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/spf13/cobra"
+)
 
 func urlFlag(cmd *cobra.Command, url *string) {
 	cmd.Flags().StringVarP(url, "url", "u", "http://127.0.0.1:26657", "hac-cl service url")
 }
+
+// sigFlags adds the flags by which the signers of a multi-signature account
+// collect their signatures, see signTx.
+func sigFlags(cmd *cobra.Command, noSend *bool, sig *string) {
+	cmd.Flags().BoolVarP(noSend, "nosend", "", false, "not send transaction but print signature")
+	cmd.Flags().StringVarP(sig, "sig", "", "", "signatures of the other signers, comma separated")
+}
+
+// encodeTx encodes btx for broadcast.
+func encodeTx(btx *tx.HACTx) ([]byte, error) {
+	return tx.MarshalHACTx(btx)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/spf13/cobra"
+)
+
+type genericArguments struct {
+	Url     string
+	Index   uint64
+	Nonce   uint64
+	Skey    string
+	Subtype string
+	Data    string
+	NoSend  bool
+	Sig     string
+}
+
+var genericArgs genericArguments
+
+var genericCmd = &cobra.Command{
+	Use:   "generic",
+	Short: "send a tx of the module registered for a generic subtype",
+	Long:  `send a generic tx of --subtype carrying the hex encoded --data, which the module registered for the subtype decodes. The nodes reject the tx if no module is registered for the subtype`,
+	Run:   genericRun,
+}
+
+func init() {
+	urlFlag(genericCmd, &genericArgs.Url)
+	genericCmd.Flags().Uint64VarP(&genericArgs.Index, "index", "i", 0, "account index")
+	genericCmd.Flags().Uint64VarP(&genericArgs.Nonce, "nonce", "n", 0, "account nonce")
+	genericCmd.Flags().StringVarP(&genericArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
+	genericCmd.Flags().StringVarP(&genericArgs.Subtype, "subtype", "t", "", "generic tx subtype")
+	genericCmd.Flags().StringVarP(&genericArgs.Data, "data", "d", "", "tx data, hex encoded")
+	sigFlags(genericCmd, &genericArgs.NoSend, &genericArgs.Sig)
+}
+
+func genericRun(cmd *cobra.Command, args []string) {
+	data, err := hex.DecodeString(genericArgs.Data)
+	if err != nil {
+		fmt.Printf("decode data err:%v\n", err)
+		return
+	}
+	stx := &tx.GenericTx{
+		Subtype: genericArgs.Subtype,
+		Data:    data,
+	}
+	if err := stx.ValidateBasic(); err != nil {
+		fmt.Printf("invalid generic tx:%v\n", err)
+		return
+	}
+	cli, err := http.New(genericArgs.Url, "/websocket")
+	if err != nil {
+		fmt.Printf("new client err:%v\n", err)
+		return
+	}
+	ctx := context.Background()
+	gres, err := cli.Genesis(ctx)
+	if err != nil {
+		fmt.Printf("get chain genesis err:%v\n", err)
+		return
+	}
+	chainId := gres.Genesis.ChainID
+	nonce := genericArgs.Nonce
+	if nonce == 0 {
+		act, err := queryAccount(genericArgs.Url, genericArgs.Index, "")
+		if err != nil {
+			return
+		}
+		nonce = act.Nonce
+	}
+	btx := tx.HACTx{
+		Version:   tx.HACTxVersion2,
+		Type:      tx.HACTxTypeGeneric,
+		Nonce:     nonce,
+		Validator: genericArgs.Index,
+		Tx:        stx,
+	}
+	dat, err := btx.SigData([]byte(chainId))
+	if err != nil {
+		fmt.Printf("tx sign data err:%v\n", err)
+		return
+	}
+	sig, sigs, err := signTx(genericArgs.Url, &btx, dat, genericArgs.Skey, genericArgs.Sig)
+	if err != nil {
+		fmt.Printf("sign tx err:%v\n", err)
+		return
+	}
+	if genericArgs.NoSend {
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
+		return
+	}
+	btx.Sig = sigs
+	dat, err = encodeTx(&btx)
+	if err != nil {
+		fmt.Printf("encode tx err:%v\n", err)
+		return
+	}
+	fmt.Printf("tx:%s\n", hex.EncodeToString(dat))
+	res, err := cli.BroadcastTxSync(ctx, dat)
+	if err != nil {
+		fmt.Printf("broadcast tx err:%v\n", err)
+		return
+	}
+	dat, _ = json.Marshal(res)
+	fmt.Printf("%v\n", string(dat))
+}
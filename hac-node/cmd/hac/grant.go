@@ -14,17 +14,20 @@ import (
 )
 
 type grantArguments struct {
-	Url       string
-	Index     uint64
-	Nonce     uint64
-	Skey      string
-	Amount    uint64
-	Pubkey    string
-	Name      string
-	AgentUrl  string
-	Statement string
-	NoSend    bool
-	Sig       string
+	Url         string
+	Index       uint64
+	Nonce       uint64
+	Skey        string
+	Amount      uint64
+	Pubkey      string
+	Name        string
+	AgentUrl    string
+	Statement   string
+	NoSend      bool
+	Sig         string
+	Pop         string
+	PopKey      string
+	Application string
 }
 
 var grantArgs grantArguments
@@ -45,9 +48,12 @@ func init() {
 	grantCmd.Flags().StringVarP(&grantArgs.Pubkey, "pubkey", "p", "", "new account pubkey")
 	grantCmd.Flags().Uint64VarP(&grantArgs.Amount, "amount", "a", 0, "grant amout")
 	grantCmd.Flags().BoolVarP(&grantArgs.NoSend, "nosend", "", false, "not send transaction but print signature")
-	grantCmd.Flags().StringVarP(&grantArgs.Sig, "sig", "", "", "transaction signatures")
+	grantCmd.Flags().StringVarP(&grantArgs.Sig, "sig", "", "", "signatures of the other signers, comma separated")
 	grantCmd.Flags().StringVarP(&grantArgs.Name, "name", "", "", "account name")
 	grantCmd.Flags().StringVarP(&grantArgs.AgentUrl, "agentUrl", "", "", "account agentUrl")
+	grantCmd.Flags().StringVarP(&grantArgs.Pop, "pop", "", "", "proof of possession of the new key, see sign --pop")
+	grantCmd.Flags().StringVarP(&grantArgs.PopKey, "popKeyPath", "", "", "private key path of the new account, to sign the proof of possession")
+	grantCmd.Flags().StringVarP(&grantArgs.Application, "application", "", "", "address of the pending application to grant, taking the key and profile from it")
 }
 
 func grantRun(cmd *cobra.Command, args []string) {
@@ -72,31 +78,64 @@ func grantRun(cmd *cobra.Command, args []string) {
 		nonce = act.Nonce
 	}
 	btx := tx.HACTx{
-		Version:   tx.HACTxVersion1,
+		Version:   tx.HACTxVersion2,
 		Nonce:     nonce,
 		Validator: grantArgs.Index,
 	}
-	pubkey, err := hex.DecodeString(grantArgs.Pubkey)
-	if err != nil {
-		fmt.Printf("decode pubkey hex err:%v\n", err)
-		return
-	}
-	if grantArgs.Name == "" {
-		grantArgs.Name = hex.EncodeToString(pubkey)
-	}
-	if grantArgs.AgentUrl == "" {
-		grantArgs.AgentUrl = types.DefaultAgentUrl
-	}
-	stx := &tx.GrantTx{
-		Grants: []tx.GrantSt{
-			tx.GrantSt{
-				Statement: grantArgs.Statement,
-				Amount:    grantArgs.Amount,
-				AgentUrl:  grantArgs.AgentUrl,
-				Name:      grantArgs.Name,
-				Pubkey:    pubkey,
+	var stx *tx.GrantTx
+	if grantArgs.Application != "" {
+		stx = &tx.GrantTx{
+			Grants: []tx.GrantSt{
+				tx.GrantSt{
+					Amount:      grantArgs.Amount,
+					Application: grantArgs.Application,
+				},
 			},
-		},
+		}
+	} else {
+		var popPV *crypto.PV
+		if grantArgs.PopKey != "" {
+			popPV = crypto.LoadFilePV(grantArgs.PopKey)
+			if grantArgs.Pubkey == "" {
+				grantArgs.Pubkey = hex.EncodeToString(popPV.PublicKey())
+			}
+		}
+		pubkey, err := hex.DecodeString(grantArgs.Pubkey)
+		if err != nil {
+			fmt.Printf("decode pubkey hex err:%v\n", err)
+			return
+		}
+		if grantArgs.Name == "" {
+			grantArgs.Name = hex.EncodeToString(pubkey)
+		}
+		if grantArgs.AgentUrl == "" {
+			grantArgs.AgentUrl = types.DefaultAgentUrl
+		}
+		stx = &tx.GrantTx{
+			Grants: []tx.GrantSt{
+				tx.GrantSt{
+					Statement: grantArgs.Statement,
+					Amount:    grantArgs.Amount,
+					AgentUrl:  grantArgs.AgentUrl,
+					Name:      grantArgs.Name,
+					Pubkey:    pubkey,
+				},
+			},
+		}
+		grant := &stx.Grants[0]
+		if popPV != nil {
+			grant.Pop, err = popPV.Sign(grant.PopData([]byte(chainId)))
+		} else {
+			grant.Pop, err = hex.DecodeString(grantArgs.Pop)
+		}
+		if err != nil {
+			fmt.Printf("proof of possession err:%v\n", err)
+			return
+		}
+		if err = grant.ValidateBasic(); err != nil {
+			fmt.Printf("%v\n", err)
+			return
+		}
 	}
 	btx.Tx = stx
 	btx.Type = tx.HACTxTypeGrant
@@ -106,25 +145,18 @@ func grantRun(cmd *cobra.Command, args []string) {
 		return
 	}
 	println("data signed:", hex.EncodeToString(dat))
-	sigs := [][]byte{}
-	pv := crypto.LoadFilePV(grantArgs.Skey)
-	sig, err := pv.Sign(dat)
+	sig, sigs, err := signTx(grantArgs.Url, &btx, dat, grantArgs.Skey, grantArgs.Sig)
 	if err != nil {
 		fmt.Printf("sign tx err:%v\n", err)
 		return
 	}
-	println("pubkey:", hex.EncodeToString(pv.PublicKey()))
-	println("address:", pv.Address())
-	sigs = append(sigs, sig)
 	if grantArgs.NoSend {
-		fmt.Println("transaction signatures:")
-		for _, sig := range sigs {
-			fmt.Println(hex.EncodeToString(sig))
-		}
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
 		return
 	}
 	btx.Sig = sigs
-	dat, err = json.Marshal(btx)
+	dat, err = encodeTx(&btx)
 	if err != nil {
 		fmt.Printf("rlp encode tx err:%v\n", err)
 		return
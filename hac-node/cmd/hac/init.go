@@ -98,14 +98,18 @@ func initRun(cmd *cobra.Command, args []string) error {
 	appState := types.GenesisAppState{
 		Agents:   agentInfos,
 		Manifest: types.DefaultStatement,
+		Params:   types.DefaultParams(),
 	}
 
 	appStateJson, _ := json.MarshalIndent(appState, "", " ")
 	genFile := appConfig.GenesisFile()
+	consensusParams := cmttypes.DefaultConsensusParams()
+	// vote extensions carry the agents' vote rationale
+	consensusParams.ABCI.VoteExtensionsEnableHeight = 1
 	appGenesis := &types.GenesisDoc{
 		GenesisTime:     genesisTime,
 		ChainID:         chainID,
-		ConsensusParams: cmttypes.DefaultConsensusParams(),
+		ConsensusParams: consensusParams,
 		InitialHeight:   1,
 		Validators:      vals,
 		AppState:        appStateJson,
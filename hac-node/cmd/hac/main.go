@@ -12,7 +12,17 @@ func main() {
 	clCmd.AddCommand(newProposalCmd)
 	clCmd.AddCommand(discussionCmd)
 	clCmd.AddCommand(settleCmd)
+	clCmd.AddCommand(disqualifyCmd)
+	clCmd.AddCommand(amendCmd)
+	clCmd.AddCommand(amendParamsCmd)
+	clCmd.AddCommand(stakeCmd)
 	clCmd.AddCommand(grantCmd)
+	clCmd.AddCommand(applyCmd)
+	clCmd.AddCommand(profileCmd)
+	clCmd.AddCommand(rotateKeyCmd)
+	clCmd.AddCommand(signersCmd)
+	clCmd.AddCommand(sessionCmd)
+	clCmd.AddCommand(genericCmd)
 	clCmd.AddCommand(pubkeyCmd)
 	clCmd.AddCommand(signCmd)
 	clCmd.AddCommand(mockCmd)
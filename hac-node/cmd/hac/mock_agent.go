@@ -61,15 +61,18 @@ func mockRun(cmd *cobra.Command, args []string) {
 	})
 
 	r.POST("/voteproposal", func(c *gin.Context) {
-		var req map[string]interface{}
+		var req agent.VoteProposalReq
 		if err := c.ShouldBindJSON(&req); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
 
-		voteRes := "no"
+		voteRes := agent.VoteNo
 		if mockArguments.Vote {
-			voteRes = "yes"
+			voteRes = agent.VoteYes
+			if len(req.Options) != 0 {
+				voteRes = req.Options[0]
+			}
 		}
 		c.JSON(http.StatusOK, gin.H{"vote": voteRes, "reason": "mock"})
 	})
@@ -87,5 +90,56 @@ func mockRun(cmd *cobra.Command, args []string) {
 		}
 		c.JSON(http.StatusOK, gin.H{"vote": voteRes, "reason": "mock"})
 	})
+
+	r.POST("/if_disqualify", func(c *gin.Context) {
+		var req agent.VoteDisqualifyReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		voteRes := agent.VoteNo
+		if mockArguments.Vote {
+			voteRes = agent.VoteYes
+		}
+		c.JSON(http.StatusOK, gin.H{"vote": voteRes, "reason": "mock"})
+	})
+
+	r.POST("/if_amend_manifest", func(c *gin.Context) {
+		var req agent.VoteAmendManifestReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		voteRes := agent.VoteNo
+		if mockArguments.Vote {
+			voteRes = agent.VoteYes
+		}
+		c.JSON(http.StatusOK, gin.H{"vote": voteRes, "reason": "mock"})
+	})
+
+	r.POST("/if_amend_params", func(c *gin.Context) {
+		var req agent.VoteAmendParamsReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		voteRes := agent.VoteNo
+		if mockArguments.Vote {
+			voteRes = agent.VoteYes
+		}
+		c.JSON(http.StatusOK, gin.H{"vote": voteRes, "reason": "mock"})
+	})
+
+	r.POST("/update_manifest", func(c *gin.Context) {
+		var req agent.UpdateManifestReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"version": req.Version})
+	})
 	r.Run(mockArguments.Address)
 }
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/hetu-project/hetu-chaoschain/crypto"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+)
+
+// signTx signs dat, the sign data of btx, with the key at skey. The tx of a
+// multi-signature account also takes the signatures the other signers
+// printed with --nosend, passed comma separated in others; each is placed at
+// the signer it verifies against. It returns the signature made with skey
+// and the signatures of the tx. A session key of the account signs alone.
+func signTx(url string, btx *tx.HACTx, dat []byte, skey string, others string) (sig []byte, sigs [][]byte, err error) {
+	pv := crypto.LoadFilePV(skey)
+	sig, err = pv.Sign(dat)
+	if err != nil {
+		return nil, nil, err
+	}
+	println("pubkey:", hex.EncodeToString(pv.PublicKey()))
+	println("address:", pv.Address())
+	act, err := queryAccount(url, btx.Validator, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	if act.Threshold == 0 {
+		return sig, [][]byte{sig}, nil
+	}
+	sigs = make([][]byte, len(act.Signers))
+	placed := false
+	for i, signer := range act.Signers {
+		if bytes.Equal(signer, pv.PublicKey()) {
+			sigs[i] = sig
+			placed = true
+		}
+	}
+	if !placed {
+		// a session key signs on its own.
+		session, err := isSessionKey(url, act.Index, pv.PublicKey())
+		if err != nil {
+			return nil, nil, err
+		}
+		if !session {
+			return nil, nil, fmt.Errorf("key is not a signer of account %v", act.Index)
+		}
+		return sig, [][]byte{sig}, nil
+	}
+	osigs, err := decodeHexList(others)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, osig := range osigs {
+		placed = false
+		for i, signer := range act.Signers {
+			if ed25519.PubKey(signer).VerifySignature(dat, osig) {
+				sigs[i] = osig
+				placed = true
+			}
+		}
+		if !placed {
+			return nil, nil, fmt.Errorf("signature %x of no signer of account %v", osig, act.Index)
+		}
+	}
+	signed := 0
+	for _, s := range sigs {
+		if len(s) != 0 {
+			signed++
+		}
+	}
+	println("signatures:", signed, "threshold:", act.Threshold)
+	return sig, sigs, nil
+}
+
+// decodeHexList decodes a comma separated list of hex strings.
+func decodeHexList(list string) (dats [][]byte, err error) {
+	for _, item := range strings.Split(list, ",") {
+		if item == "" {
+			continue
+		}
+		dat, err := hex.DecodeString(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		dats = append(dats, dat)
+	}
+	return
+}
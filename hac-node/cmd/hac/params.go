@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/spf13/cobra"
+)
+
+type amendParamsArguments struct {
+	Url    string
+	Index  uint64
+	Nonce  uint64
+	Skey   string
+	Set    []string
+	Reason string
+	NoSend bool
+	Sig    string
+}
+
+var amendParamsArgs amendParamsArguments
+
+var amendParamsCmd = &cobra.Command{
+	Use:   "amendparams",
+	Short: "propose an amendment of the chain params",
+	Long:  `propose new values of chain params, each as --set key=value with the key as /params/ names it`,
+	Run:   amendParamsRun,
+}
+
+func init() {
+	urlFlag(amendParamsCmd, &amendParamsArgs.Url)
+	amendParamsCmd.Flags().Uint64VarP(&amendParamsArgs.Index, "index", "i", 0, "account index")
+	amendParamsCmd.Flags().Uint64VarP(&amendParamsArgs.Nonce, "nonce", "n", 0, "account nonce")
+	amendParamsCmd.Flags().StringVarP(&amendParamsArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
+	amendParamsCmd.Flags().StringArrayVarP(&amendParamsArgs.Set, "set", "", nil, "param to set, as key=value")
+	amendParamsCmd.Flags().StringVarP(&amendParamsArgs.Reason, "reason", "r", "", "reason for the amendment")
+	sigFlags(amendParamsCmd, &amendParamsArgs.NoSend, &amendParamsArgs.Sig)
+}
+
+func amendParamsRun(cmd *cobra.Command, args []string) {
+	atx := &tx.AmendParamsTx{Reason: amendParamsArgs.Reason}
+	for _, set := range amendParamsArgs.Set {
+		key, value, ok := strings.Cut(set, "=")
+		if !ok {
+			fmt.Printf("invalid --set %q, want key=value\n", set)
+			return
+		}
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			fmt.Printf("invalid value of %s err:%v\n", key, err)
+			return
+		}
+		atx.Changes = append(atx.Changes, tx.ParamChange{Key: key, Value: v})
+	}
+	if err := atx.ValidateBasic(); err != nil {
+		fmt.Printf("invalid params amendment:%v\n", err)
+		return
+	}
+	cli, err := http.New(amendParamsArgs.Url, "/websocket")
+	if err != nil {
+		fmt.Printf("new client err:%v\n", err)
+		return
+	}
+	ctx := context.Background()
+	gres, err := cli.Genesis(ctx)
+	if err != nil {
+		fmt.Printf("get chain genesis err:%v\n", err)
+		return
+	}
+	chainId := gres.Genesis.ChainID
+	nonce := amendParamsArgs.Nonce
+	if nonce == 0 {
+		act, err := queryAccount(amendParamsArgs.Url, amendParamsArgs.Index, "")
+		if err != nil {
+			return
+		}
+		nonce = act.Nonce
+	}
+	btx := tx.HACTx{
+		Version:   tx.HACTxVersion2,
+		Type:      tx.HACTxTypeAmendParams,
+		Nonce:     nonce,
+		Validator: amendParamsArgs.Index,
+		Tx:        atx,
+	}
+	dat, err := btx.SigData([]byte(chainId))
+	if err != nil {
+		fmt.Printf("tx sign data err:%v\n", err)
+		return
+	}
+	sig, sigs, err := signTx(amendParamsArgs.Url, &btx, dat, amendParamsArgs.Skey, amendParamsArgs.Sig)
+	if err != nil {
+		fmt.Printf("sign tx err:%v\n", err)
+		return
+	}
+	if amendParamsArgs.NoSend {
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
+		return
+	}
+	btx.Sig = sigs
+	dat, err = encodeTx(&btx)
+	if err != nil {
+		fmt.Printf("encode tx err:%v\n", err)
+		return
+	}
+	fmt.Printf("tx:%s\n", hex.EncodeToString(dat))
+	res, err := cli.BroadcastTxSync(ctx, dat)
+	if err != nil {
+		fmt.Printf("broadcast tx err:%v\n", err)
+		return
+	}
+	dat, _ = json.Marshal(res)
+	fmt.Printf("%v\n", string(dat))
+}
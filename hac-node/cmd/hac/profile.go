@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/spf13/cobra"
+)
+
+type profileArguments struct {
+	Url       string
+	Index     uint64
+	Skey      string
+	Name      string
+	AgentUrl  string
+	SelfIntro string
+	NoSend    bool
+	Sig       string
+}
+
+var profileArgs profileArguments
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "update the name, agent url and self introduction of the account, the ones left out are kept",
+	Long:  ``,
+	Run:   profileRun,
+}
+
+func init() {
+	urlFlag(profileCmd, &profileArgs.Url)
+	profileCmd.Flags().Uint64VarP(&profileArgs.Index, "index", "i", 0, "account index")
+	profileCmd.Flags().StringVarP(&profileArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
+	profileCmd.Flags().StringVarP(&profileArgs.Name, "name", "", "", "account name")
+	profileCmd.Flags().StringVarP(&profileArgs.AgentUrl, "agentUrl", "", "", "account agentUrl")
+	profileCmd.Flags().StringVarP(&profileArgs.SelfIntro, "selfIntro", "", "", "self introduction of the agent")
+	sigFlags(profileCmd, &profileArgs.NoSend, &profileArgs.Sig)
+}
+
+func profileRun(cmd *cobra.Command, args []string) {
+	cli, err := http.New(profileArgs.Url, "/websocket")
+	if err != nil {
+		fmt.Printf("new client err:%v\n", err)
+		return
+	}
+	ctx := context.Background()
+	gres, err := cli.Genesis(ctx)
+	if err != nil {
+		fmt.Printf("get chain genesis err:%v\n", err)
+		return
+	}
+	chainId := gres.Genesis.ChainID
+	act, err := queryAccount(profileArgs.Url, profileArgs.Index, "")
+	if err != nil {
+		return
+	}
+	ptx := &tx.UpdateProfileTx{
+		Name:      act.Name,
+		AgentUrl:  act.AgentUrl,
+		SelfIntro: act.SelfIntro,
+	}
+	if cmd.Flags().Changed("name") {
+		ptx.Name = profileArgs.Name
+	}
+	if cmd.Flags().Changed("agentUrl") {
+		ptx.AgentUrl = profileArgs.AgentUrl
+	}
+	if cmd.Flags().Changed("selfIntro") {
+		ptx.SelfIntro = profileArgs.SelfIntro
+	}
+	if err = ptx.ValidateBasic(); err != nil {
+		fmt.Printf("%v\n", err)
+		return
+	}
+	btx := tx.HACTx{
+		Version:   tx.HACTxVersion2,
+		Type:      tx.HACTxTypeUpdateProfile,
+		Nonce:     act.Nonce,
+		Validator: profileArgs.Index,
+		Tx:        ptx,
+	}
+	dat, err := btx.SigData([]byte(chainId))
+	if err != nil {
+		fmt.Printf("tx sign data err:%v\n", err)
+		return
+	}
+	sig, sigs, err := signTx(profileArgs.Url, &btx, dat, profileArgs.Skey, profileArgs.Sig)
+	if err != nil {
+		fmt.Printf("sign tx err:%v\n", err)
+		return
+	}
+	if profileArgs.NoSend {
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
+		return
+	}
+	btx.Sig = sigs
+	dat, err = encodeTx(&btx)
+	if err != nil {
+		fmt.Printf("encode tx err:%v\n", err)
+		return
+	}
+	fmt.Printf("tx:%s\n", hex.EncodeToString(dat))
+	res, err := cli.BroadcastTxSync(ctx, dat)
+	if err != nil {
+		fmt.Printf("broadcast tx err:%v\n", err)
+		return
+	}
+	dat, _ = json.Marshal(res)
+	fmt.Printf("%v\n", string(dat))
+}
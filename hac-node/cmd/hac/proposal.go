@@ -8,7 +8,7 @@ import (
 	"time"
 
 	"github.com/cometbft/cometbft/rpc/client/http"
-	"github.com/hetu-project/hetu-chaoschain/crypto"
+	"github.com/hetu-project/hetu-chaoschain/agent"
 	"github.com/hetu-project/hetu-chaoschain/tx"
 	"github.com/spf13/cobra"
 )
@@ -41,7 +41,7 @@ func init() {
 	newProposalCmd.Flags().StringVarP(&newProposalArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
 	newProposalCmd.Flags().StringVarP(&newProposalArgs.Data, "data", "d", "", "proposal data")
 	newProposalCmd.Flags().BoolVarP(&newProposalArgs.NoSend, "nosend", "", false, "not send transaction but print signature")
-	newProposalCmd.Flags().StringVarP(&newProposalArgs.Sig, "sig", "", "", "transaction signatures")
+	newProposalCmd.Flags().StringVarP(&newProposalArgs.Sig, "sig", "", "", "signatures of the other signers, comma separated")
 	newProposalCmd.Flags().StringVarP(&newProposalArgs.Title, "title", "t", "New Proposal", "proposal title")
 	newProposalCmd.Flags().StringVarP(&newProposerArgs.AgentUrl, "agent", "a", "http://127.0.0.1/3000/proposal_title", "agent")
 }
@@ -59,6 +59,11 @@ func newProposalRun(cmd *cobra.Command, args []string) {
 		return
 	}
 	chainId := gres.Genesis.ChainID
+	now, err := agent.ChainTime(cli)
+	if err != nil {
+		fmt.Printf("get chain time err:%v\n", err)
+		return
+	}
 	nonce := newProposalArgs.Nonce
 	if nonce == 0 {
 		act, err := queryAccount(newProposalArgs.Url, newProposalArgs.Index, "")
@@ -68,7 +73,7 @@ func newProposalRun(cmd *cobra.Command, args []string) {
 		nonce = act.Nonce
 	}
 	btx := tx.HACTx{
-		Version:   tx.HACTxVersion1,
+		Version:   tx.HACTxVersion2,
 		Nonce:     nonce,
 		Validator: newProposalArgs.Index,
 	}
@@ -80,12 +85,16 @@ func newProposalRun(cmd *cobra.Command, args []string) {
 		}
 	}
 	stx := &tx.ProposalTx{
-		EndHeight:       1000000,
+		EndHeight:       0,
 		ImageUrl:        "",
 		Title:           newProposalArgs.Title,
 		Link:            "",
 		Data:            []byte(newProposalArgs.Data),
-		ExpireTimestamp: uint(time.Now().Add(time.Minute * 2).Unix()),
+		ExpireTimestamp: uint(now.Add(time.Minute * 2).Unix()),
+	}
+	if err = stx.ValidateBasic(); err != nil {
+		fmt.Printf("invalid proposal:%v\n", err)
+		return
 	}
 	btx.Tx = stx
 	btx.Type = tx.HACTxTypeProposal
@@ -95,25 +104,18 @@ func newProposalRun(cmd *cobra.Command, args []string) {
 		return
 	}
 	println("data signed:", hex.EncodeToString(dat))
-	sigs := [][]byte{}
-	pv := crypto.LoadFilePV(newProposalArgs.Skey)
-	sig, err := pv.Sign(dat)
+	sig, sigs, err := signTx(newProposalArgs.Url, &btx, dat, newProposalArgs.Skey, newProposalArgs.Sig)
 	if err != nil {
 		fmt.Printf("sign tx err:%v\n", err)
 		return
 	}
-	println("pubkey:", hex.EncodeToString(pv.PublicKey()))
-	println("address:", pv.Address())
-	sigs = append(sigs, sig)
 	if newProposalArgs.NoSend {
-		fmt.Println("transaction signatures:")
-		for _, sig := range sigs {
-			fmt.Println(hex.EncodeToString(sig))
-		}
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
 		return
 	}
 	btx.Sig = sigs
-	dat, err = json.Marshal(btx)
+	dat, err = encodeTx(&btx)
 	if err != nil {
 		fmt.Printf("rlp encode tx err:%v\n", err)
 		return
@@ -11,7 +11,6 @@ import (
 	htp "net/http"
 
 	"github.com/cometbft/cometbft/rpc/client/http"
-	"github.com/hetu-project/hetu-chaoschain/crypto"
 	"github.com/hetu-project/hetu-chaoschain/tx"
 	"github.com/spf13/cobra"
 )
@@ -43,7 +42,7 @@ func init() {
 	newProposerCmd.Flags().Uint64VarP(&newProposerArgs.Nonce, "nonce", "n", 0, "account nonce")
 	newProposerCmd.Flags().StringVarP(&newProposerArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
 	newProposerCmd.Flags().BoolVarP(&newProposerArgs.NoSend, "nosend", "", false, "not send transaction but print signature")
-	newProposerCmd.Flags().StringVarP(&newProposerArgs.Sig, "sig", "", "", "transaction signatures")
+	newProposerCmd.Flags().StringVarP(&newProposerArgs.Sig, "sig", "", "", "signatures of the other signers, comma separated")
 	newProposerCmd.Flags().StringVarP(&newProposerArgs.SourceUrl, "source", "o", "", "source url")
 	newProposerCmd.Flags().Uint64VarP(&newProposerArgs.Duration, "duration", "t", 60, "duration")
 	newProposerCmd.Flags().StringVarP(&newProposerArgs.AgentUrl, "agent", "a", "http://127.0.0.1/3000", "agent")
@@ -108,7 +107,7 @@ func newProposerRun(cmd *cobra.Command, args []string) {
 			nonce = act.Nonce
 		}
 		btx := tx.HACTx{
-			Version:   tx.HACTxVersion1,
+			Version:   tx.HACTxVersion2,
 			Nonce:     nonce,
 			Validator: newProposerArgs.Index,
 		}
@@ -137,25 +136,18 @@ func newProposerRun(cmd *cobra.Command, args []string) {
 			return
 		}
 		println("data signed:", hex.EncodeToString(dat))
-		sigs := [][]byte{}
-		pv := crypto.LoadFilePV(newProposerArgs.Skey)
-		sig, err := pv.Sign(dat)
+		sig, sigs, err := signTx(newProposerArgs.Url, &btx, dat, newProposerArgs.Skey, newProposerArgs.Sig)
 		if err != nil {
 			fmt.Printf("sign tx err:%v\n", err)
 			return
 		}
-		println("pubkey:", hex.EncodeToString(pv.PublicKey()))
-		println("address:", pv.Address())
-		sigs = append(sigs, sig)
 		if newProposerArgs.NoSend {
-			fmt.Println("transaction signatures:")
-			for _, sig := range sigs {
-				fmt.Println(hex.EncodeToString(sig))
-			}
+			fmt.Println("transaction signature:")
+			fmt.Println(hex.EncodeToString(sig))
 			return
 		}
 		btx.Sig = sigs
-		dat, err = json.Marshal(btx)
+		dat, err = encodeTx(&btx)
 		if err != nil {
 			fmt.Printf("rlp encode tx err:%v\n", err)
 			return
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/hetu-project/hetu-chaoschain/crypto"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/spf13/cobra"
+)
+
+type rotateKeyArguments struct {
+	Url    string
+	Index  uint64
+	Nonce  uint64
+	Skey   string
+	NewKey string
+	NoSend bool
+	Sig    string
+}
+
+var rotateKeyArgs rotateKeyArguments
+
+var rotateKeyCmd = &cobra.Command{
+	Use:   "rotatekey",
+	Short: "replace the key of the account, signed by the current key",
+	Long:  `replace the key of the account with the one at --newKeyPath, which signs the proof of possession. The account keeps its index; once the tx is committed, the node must run with the new key as its private validator key`,
+	Run:   rotateKeyRun,
+}
+
+func init() {
+	urlFlag(rotateKeyCmd, &rotateKeyArgs.Url)
+	rotateKeyCmd.Flags().Uint64VarP(&rotateKeyArgs.Index, "index", "i", 0, "account index")
+	rotateKeyCmd.Flags().Uint64VarP(&rotateKeyArgs.Nonce, "nonce", "n", 0, "account nonce")
+	rotateKeyCmd.Flags().StringVarP(&rotateKeyArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path of the current key")
+	rotateKeyCmd.Flags().StringVarP(&rotateKeyArgs.NewKey, "newKeyPath", "k", "", "private key path of the new key")
+	sigFlags(rotateKeyCmd, &rotateKeyArgs.NoSend, &rotateKeyArgs.Sig)
+}
+
+func rotateKeyRun(cmd *cobra.Command, args []string) {
+	cli, err := http.New(rotateKeyArgs.Url, "/websocket")
+	if err != nil {
+		fmt.Printf("new client err:%v\n", err)
+		return
+	}
+	ctx := context.Background()
+	gres, err := cli.Genesis(ctx)
+	if err != nil {
+		fmt.Printf("get chain genesis err:%v\n", err)
+		return
+	}
+	chainId := gres.Genesis.ChainID
+	nonce := rotateKeyArgs.Nonce
+	if nonce == 0 {
+		act, err := queryAccount(rotateKeyArgs.Url, rotateKeyArgs.Index, "")
+		if err != nil {
+			return
+		}
+		nonce = act.Nonce
+	}
+	btx := tx.HACTx{
+		Version:   tx.HACTxVersion2,
+		Type:      tx.HACTxTypeRotateKey,
+		Nonce:     nonce,
+		Validator: rotateKeyArgs.Index,
+	}
+	newPV := crypto.LoadFilePV(rotateKeyArgs.NewKey)
+	rtx := &tx.RotateKeyTx{Pubkey: newPV.PublicKey()}
+	rtx.Pop, err = newPV.Sign(rtx.PopData([]byte(chainId), rotateKeyArgs.Index))
+	if err != nil {
+		fmt.Printf("proof of possession err:%v\n", err)
+		return
+	}
+	btx.Tx = rtx
+	dat, err := btx.SigData([]byte(chainId))
+	if err != nil {
+		fmt.Printf("tx sign data err:%v\n", err)
+		return
+	}
+	sig, sigs, err := signTx(rotateKeyArgs.Url, &btx, dat, rotateKeyArgs.Skey, rotateKeyArgs.Sig)
+	if err != nil {
+		fmt.Printf("sign tx err:%v\n", err)
+		return
+	}
+	if rotateKeyArgs.NoSend {
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
+		return
+	}
+	btx.Sig = sigs
+	dat, err = encodeTx(&btx)
+	if err != nil {
+		fmt.Printf("encode tx err:%v\n", err)
+		return
+	}
+	fmt.Printf("tx:%s\n", hex.EncodeToString(dat))
+	res, err := cli.BroadcastTxSync(ctx, dat)
+	if err != nil {
+		fmt.Printf("broadcast tx err:%v\n", err)
+		return
+	}
+	dat, _ = json.Marshal(res)
+	fmt.Printf("%v\n", string(dat))
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/hetu-project/hetu-chaoschain/crypto"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+	"github.com/spf13/cobra"
+)
+
+// sessionScopes names the tx types a session key may be scoped to.
+var sessionScopes = map[string]tx.HACTxType{
+	"proposal":   tx.HACTxTypeProposal,
+	"discussion": tx.HACTxTypeDiscussion,
+	"settle":     tx.HACTxTypeSettleProposal,
+}
+
+type sessionArguments struct {
+	Url          string
+	Index        uint64
+	Nonce        uint64
+	Skey         string
+	SessionKey   string
+	Scope        string
+	ExpireHeight uint64
+	RateCap      uint64
+	Revoke       string
+	List         bool
+	NoSend       bool
+	Sig          string
+}
+
+var sessionArgs sessionArguments
+
+var sessionCmd = &cobra.Command{
+	Use:   "session",
+	Short: "register, renew, revoke or list the session keys of the account",
+	Long:  `register the key at --sessionKeyPath as a session key of the account, which signs the proof of possession. Until --expireHeight it may sign the txs of the account of the types of --scope, comma separated out of proposal, discussion and settle, at most --rateCap of them in a rate window, with no cap if 0. Registering a session key again renews it. --revoke removes the session key of an address, --list prints the session keys of the account`,
+	Run:   sessionRun,
+}
+
+func init() {
+	urlFlag(sessionCmd, &sessionArgs.Url)
+	sessionCmd.Flags().Uint64VarP(&sessionArgs.Index, "index", "i", 0, "account index")
+	sessionCmd.Flags().Uint64VarP(&sessionArgs.Nonce, "nonce", "n", 0, "account nonce")
+	sessionCmd.Flags().StringVarP(&sessionArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
+	sessionCmd.Flags().StringVarP(&sessionArgs.SessionKey, "sessionKeyPath", "k", "", "private key path of the session key")
+	sessionCmd.Flags().StringVarP(&sessionArgs.Scope, "scope", "", "discussion", "tx types the session key may sign, comma separated")
+	sessionCmd.Flags().Uint64VarP(&sessionArgs.ExpireHeight, "expireHeight", "e", 0, "height the session key expires at")
+	sessionCmd.Flags().Uint64VarP(&sessionArgs.RateCap, "rateCap", "r", 0, "txs the session key may sign in a rate window, 0 for no cap")
+	sessionCmd.Flags().StringVarP(&sessionArgs.Revoke, "revoke", "", "", "address of the session key to revoke")
+	sessionCmd.Flags().BoolVarP(&sessionArgs.List, "list", "l", false, "list the session keys of the account")
+	sigFlags(sessionCmd, &sessionArgs.NoSend, &sessionArgs.Sig)
+}
+
+func sessionRun(cmd *cobra.Command, args []string) {
+	if sessionArgs.List {
+		sessions, err := querySessions(sessionArgs.Url, sessionArgs.Index)
+		if err != nil {
+			fmt.Printf("query sessions err:%v\n", err)
+			return
+		}
+		dat, _ := json.MarshalIndent(sessions, "", "  ")
+		fmt.Println(string(dat))
+		return
+	}
+	cli, err := http.New(sessionArgs.Url, "/websocket")
+	if err != nil {
+		fmt.Printf("new client err:%v\n", err)
+		return
+	}
+	ctx := context.Background()
+	gres, err := cli.Genesis(ctx)
+	if err != nil {
+		fmt.Printf("get chain genesis err:%v\n", err)
+		return
+	}
+	chainId := gres.Genesis.ChainID
+	nonce := sessionArgs.Nonce
+	if nonce == 0 {
+		act, err := queryAccount(sessionArgs.Url, sessionArgs.Index, "")
+		if err != nil {
+			return
+		}
+		nonce = act.Nonce
+	}
+	btx := tx.HACTx{
+		Version:   tx.HACTxVersion2,
+		Nonce:     nonce,
+		Validator: sessionArgs.Index,
+	}
+	if sessionArgs.Revoke != "" {
+		btx.Type = tx.HACTxTypeRevokeSession
+		btx.Tx = &tx.RevokeSessionTx{Address: strings.ToUpper(sessionArgs.Revoke)}
+	} else {
+		stx := &tx.SessionTx{
+			ExpireHeight: sessionArgs.ExpireHeight,
+			RateCap:      sessionArgs.RateCap,
+		}
+		for _, name := range strings.Split(sessionArgs.Scope, ",") {
+			t, ok := sessionScopes[strings.TrimSpace(name)]
+			if !ok {
+				fmt.Printf("unknown scope:%v\n", name)
+				return
+			}
+			stx.Scope = append(stx.Scope, t)
+		}
+		sessionPV := crypto.LoadFilePV(sessionArgs.SessionKey)
+		stx.Pubkey = sessionPV.PublicKey()
+		stx.Pop, err = sessionPV.Sign(stx.PopData([]byte(chainId), sessionArgs.Index))
+		if err != nil {
+			fmt.Printf("proof of possession err:%v\n", err)
+			return
+		}
+		if err := stx.ValidateBasic(); err != nil {
+			fmt.Printf("invalid session:%v\n", err)
+			return
+		}
+		println("session address:", sessionPV.Address())
+		btx.Type = tx.HACTxTypeSession
+		btx.Tx = stx
+	}
+	dat, err := btx.SigData([]byte(chainId))
+	if err != nil {
+		fmt.Printf("tx sign data err:%v\n", err)
+		return
+	}
+	sig, sigs, err := signTx(sessionArgs.Url, &btx, dat, sessionArgs.Skey, sessionArgs.Sig)
+	if err != nil {
+		fmt.Printf("sign tx err:%v\n", err)
+		return
+	}
+	if sessionArgs.NoSend {
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
+		return
+	}
+	btx.Sig = sigs
+	dat, err = encodeTx(&btx)
+	if err != nil {
+		fmt.Printf("encode tx err:%v\n", err)
+		return
+	}
+	fmt.Printf("tx:%s\n", hex.EncodeToString(dat))
+	res, err := cli.BroadcastTxSync(ctx, dat)
+	if err != nil {
+		fmt.Printf("broadcast tx err:%v\n", err)
+		return
+	}
+	dat, _ = json.Marshal(res)
+	fmt.Printf("%v\n", string(dat))
+}
+
+// querySessions returns the session keys of the account.
+func querySessions(url string, index uint64) ([]*hac_types.Session, error) {
+	cli, err := http.New(url, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+	dat, _ := hex.DecodeString(fmt.Sprintf("%016x", index))
+	res, err := cli.ABCIQuery(context.Background(), "/sessions/", dat)
+	if err != nil {
+		return nil, err
+	}
+	if res.Response.Code != 0 {
+		return nil, fmt.Errorf("query sessions code %v: %v", res.Response.Code, res.Response.Log)
+	}
+	var sessions []*hac_types.Session
+	err = json.Unmarshal(res.Response.Value, &sessions)
+	return sessions, err
+}
+
+// isSessionKey reports whether pubkey is a session key of the account.
+func isSessionKey(url string, index uint64, pubkey []byte) (bool, error) {
+	sessions, err := querySessions(url, index)
+	if err != nil {
+		return false, err
+	}
+	for _, session := range sessions {
+		if bytes.Equal(session.Pubkey, pubkey) {
+			println("session key, scope:", fmt.Sprint(session.Scope), "expire height:", session.ExpireHeight)
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -5,10 +5,9 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"github.com/cometbft/cometbft/rpc/client/http"
-	"github.com/hetu-project/hetu-chaoschain/crypto"
+	"github.com/hetu-project/hetu-chaoschain/agent"
 	"github.com/hetu-project/hetu-chaoschain/tx"
 	"github.com/spf13/cobra"
 )
@@ -39,7 +38,7 @@ func init() {
 	settleCmd.Flags().StringVarP(&settleArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
 	settleCmd.Flags().Uint64VarP(&settleArgs.Proposal, "proposal", "p", 0, "proposal index")
 	settleCmd.Flags().BoolVarP(&settleArgs.NoSend, "nosend", "", false, "not send transaction but print signature")
-	settleCmd.Flags().StringVarP(&settleArgs.Sig, "sig", "", "", "transaction signatures")
+	settleCmd.Flags().StringVarP(&settleArgs.Sig, "sig", "", "", "signatures of the other signers, comma separated")
 }
 
 func settleRun(cmd *cobra.Command, args []string) {
@@ -55,6 +54,11 @@ func settleRun(cmd *cobra.Command, args []string) {
 		return
 	}
 	chainId := gres.Genesis.ChainID
+	now, err := agent.ChainTime(cli)
+	if err != nil {
+		fmt.Printf("get chain time err:%v\n", err)
+		return
+	}
 	nonce := settleArgs.Nonce
 	if nonce == 0 {
 		act, err := queryAccount(settleArgs.Url, settleArgs.Index, "")
@@ -64,13 +68,13 @@ func settleRun(cmd *cobra.Command, args []string) {
 		nonce = act.Nonce
 	}
 	btx := tx.HACTx{
-		Version:   tx.HACTxVersion1,
+		Version:   tx.HACTxVersion2,
 		Nonce:     nonce,
 		Validator: settleArgs.Index,
 	}
 	stx := &tx.SettleProposalTx{
 		Proposal:        settleArgs.Proposal,
-		ExpireTimestamp: uint(time.Now().Unix() + 60*3),
+		ExpireTimestamp: uint(now.Unix() + 60*3),
 	}
 	btx.Tx = stx
 	btx.Type = tx.HACTxTypeSettleProposal
@@ -80,25 +84,18 @@ func settleRun(cmd *cobra.Command, args []string) {
 		return
 	}
 	println("data signed:", hex.EncodeToString(dat))
-	sigs := [][]byte{}
-	pv := crypto.LoadFilePV(settleArgs.Skey)
-	sig, err := pv.Sign(dat)
+	sig, sigs, err := signTx(settleArgs.Url, &btx, dat, settleArgs.Skey, settleArgs.Sig)
 	if err != nil {
 		fmt.Printf("sign tx err:%v\n", err)
 		return
 	}
-	println("pubkey:", hex.EncodeToString(pv.PublicKey()))
-	println("address:", pv.Address())
-	sigs = append(sigs, sig)
 	if settleArgs.NoSend {
-		fmt.Println("transaction signatures:")
-		for _, sig := range sigs {
-			fmt.Println(hex.EncodeToString(sig))
-		}
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
 		return
 	}
 	btx.Sig = sigs
-	dat, err = json.Marshal(btx)
+	dat, err = encodeTx(&btx)
 	if err != nil {
 		fmt.Printf("rlp encode tx err:%v\n", err)
 		return
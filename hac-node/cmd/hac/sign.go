@@ -7,12 +7,18 @@ import (
 	"fmt"
 
 	"github.com/hetu-project/hetu-chaoschain/crypto"
+	"github.com/hetu-project/hetu-chaoschain/tx"
 
 	"github.com/spf13/cobra"
 )
 
 type signArguments struct {
-	Skey string
+	Skey      string
+	Pop       bool
+	ChainId   string
+	Statement string
+	SignerPop bool
+	Account   uint64
 }
 
 var signArgs signArguments
@@ -26,10 +32,22 @@ var signCmd = &cobra.Command{
 
 func init() {
 	signCmd.Flags().StringVarP(&signArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
+	signCmd.Flags().BoolVarP(&signArgs.Pop, "pop", "", false, "sign the proof of possession of the key for a grant")
+	signCmd.Flags().StringVarP(&signArgs.ChainId, "chainId", "", "", "chain id the proof of possession is for")
+	signCmd.Flags().StringVarP(&signArgs.Statement, "statement", "", "", "statement of the grant")
+	signCmd.Flags().BoolVarP(&signArgs.SignerPop, "signerPop", "", false, "sign the proof of possession of the key for the signers of an account")
+	signCmd.Flags().Uint64VarP(&signArgs.Account, "account", "", 0, "index of the account the signers are for")
 }
 
 func signRun(cmd *cobra.Command, args []string) {
 	dat := []byte("Hello, CosmJS!")
+	if signArgs.Pop {
+		grant := tx.GrantSt{Statement: signArgs.Statement}
+		dat = grant.PopData([]byte(signArgs.ChainId))
+	}
+	if signArgs.SignerPop {
+		dat = (&tx.SetSignersTx{}).PopData([]byte(signArgs.ChainId), signArgs.Account)
+	}
 	pv := crypto.LoadFilePV(signArgs.Skey)
 	sig, err := pv.Sign(dat)
 	if err != nil {
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/hetu-project/hetu-chaoschain/crypto"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/spf13/cobra"
+)
+
+type signersArguments struct {
+	Url        string
+	Index      uint64
+	Nonce      uint64
+	Skey       string
+	Signers    string
+	Threshold  uint32
+	Pops       string
+	SignerKeys string
+	NoSend     bool
+	Sig        string
+}
+
+var signersArgs signersArguments
+
+var signersCmd = &cobra.Command{
+	Use:   "signers",
+	Short: "put the account under the control of threshold of signer keys, or back under its own key with none",
+	Long:  `each signer proves it holds its key, with --pops made by sign --signerPop or with --signerKeyPaths. Once set, txs of the account need the signatures of threshold signers: each signer runs the same command with --nosend, and the last one passes the signatures printed with --sig`,
+	Run:   signersRun,
+}
+
+func init() {
+	urlFlag(signersCmd, &signersArgs.Url)
+	signersCmd.Flags().Uint64VarP(&signersArgs.Index, "index", "i", 0, "account index")
+	signersCmd.Flags().Uint64VarP(&signersArgs.Nonce, "nonce", "n", 0, "account nonce")
+	signersCmd.Flags().StringVarP(&signersArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
+	signersCmd.Flags().StringVarP(&signersArgs.Signers, "signers", "", "", "public keys of the signers, hex, comma separated")
+	signersCmd.Flags().Uint32VarP(&signersArgs.Threshold, "threshold", "t", 0, "signatures needed out of the signers")
+	signersCmd.Flags().StringVarP(&signersArgs.Pops, "pops", "", "", "proofs of possession of the signers, hex, comma separated in the order of the signers")
+	signersCmd.Flags().StringVarP(&signersArgs.SignerKeys, "signerKeyPaths", "", "", "private key paths of the signers, comma separated, to sign the proofs of possession")
+	sigFlags(signersCmd, &signersArgs.NoSend, &signersArgs.Sig)
+}
+
+func signersRun(cmd *cobra.Command, args []string) {
+	cli, err := http.New(signersArgs.Url, "/websocket")
+	if err != nil {
+		fmt.Printf("new client err:%v\n", err)
+		return
+	}
+	ctx := context.Background()
+	gres, err := cli.Genesis(ctx)
+	if err != nil {
+		fmt.Printf("get chain genesis err:%v\n", err)
+		return
+	}
+	chainId := gres.Genesis.ChainID
+	nonce := signersArgs.Nonce
+	if nonce == 0 {
+		act, err := queryAccount(signersArgs.Url, signersArgs.Index, "")
+		if err != nil {
+			return
+		}
+		nonce = act.Nonce
+	}
+	stx := &tx.SetSignersTx{Threshold: signersArgs.Threshold}
+	if signersArgs.SignerKeys != "" {
+		for _, path := range strings.Split(signersArgs.SignerKeys, ",") {
+			pv := crypto.LoadFilePV(path)
+			pop, err := pv.Sign(stx.PopData([]byte(chainId), signersArgs.Index))
+			if err != nil {
+				fmt.Printf("proof of possession err:%v\n", err)
+				return
+			}
+			stx.Signers = append(stx.Signers, pv.PublicKey())
+			stx.Pops = append(stx.Pops, pop)
+		}
+	} else {
+		stx.Signers, err = decodeHexList(signersArgs.Signers)
+		if err != nil {
+			fmt.Printf("decode signers err:%v\n", err)
+			return
+		}
+		stx.Pops, err = decodeHexList(signersArgs.Pops)
+		if err != nil {
+			fmt.Printf("decode proofs of possession err:%v\n", err)
+			return
+		}
+	}
+	if err = stx.ValidateBasic(); err != nil {
+		fmt.Printf("%v\n", err)
+		return
+	}
+	btx := tx.HACTx{
+		Version:   tx.HACTxVersion2,
+		Type:      tx.HACTxTypeSetSigners,
+		Nonce:     nonce,
+		Validator: signersArgs.Index,
+		Tx:        stx,
+	}
+	dat, err := btx.SigData([]byte(chainId))
+	if err != nil {
+		fmt.Printf("tx sign data err:%v\n", err)
+		return
+	}
+	sig, sigs, err := signTx(signersArgs.Url, &btx, dat, signersArgs.Skey, signersArgs.Sig)
+	if err != nil {
+		fmt.Printf("sign tx err:%v\n", err)
+		return
+	}
+	if signersArgs.NoSend {
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
+		return
+	}
+	btx.Sig = sigs
+	dat, err = encodeTx(&btx)
+	if err != nil {
+		fmt.Printf("encode tx err:%v\n", err)
+		return
+	}
+	fmt.Printf("tx:%s\n", hex.EncodeToString(dat))
+	res, err := cli.BroadcastTxSync(ctx, dat)
+	if err != nil {
+		fmt.Printf("broadcast tx err:%v\n", err)
+		return
+	}
+	dat, _ = json.Marshal(res)
+	fmt.Printf("%v\n", string(dat))
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/spf13/cobra"
+)
+
+type stakeArguments struct {
+	Url       string
+	Index     uint64
+	Nonce     uint64
+	Skey      string
+	Amount    uint64
+	Statement string
+	Retract   bool
+	NoSend    bool
+	Sig       string
+}
+
+var stakeArgs stakeArguments
+
+var stakeCmd = &cobra.Command{
+	Use:   "stake",
+	Short: "top up the stake, or retract part or all of it to the unbonding queue",
+	Long:  ``,
+	Run:   stakeRun,
+}
+
+func init() {
+	urlFlag(stakeCmd, &stakeArgs.Url)
+	stakeCmd.Flags().Uint64VarP(&stakeArgs.Index, "index", "i", 0, "account index")
+	stakeCmd.Flags().Uint64VarP(&stakeArgs.Nonce, "nonce", "n", 0, "account nonce")
+	stakeCmd.Flags().StringVarP(&stakeArgs.Skey, "skeyPath", "s", "./config/priv_validator_key.json", "private key path")
+	stakeCmd.Flags().Uint64VarP(&stakeArgs.Amount, "amount", "a", 0, "stake amount")
+	stakeCmd.Flags().StringVarP(&stakeArgs.Statement, "statement", "t", "", "statement for the top up")
+	stakeCmd.Flags().BoolVarP(&stakeArgs.Retract, "retract", "r", false, "retract the amount instead")
+	sigFlags(stakeCmd, &stakeArgs.NoSend, &stakeArgs.Sig)
+}
+
+func stakeRun(cmd *cobra.Command, args []string) {
+	cli, err := http.New(stakeArgs.Url, "/websocket")
+	if err != nil {
+		fmt.Printf("new client err:%v\n", err)
+		return
+	}
+	ctx := context.Background()
+	gres, err := cli.Genesis(ctx)
+	if err != nil {
+		fmt.Printf("get chain genesis err:%v\n", err)
+		return
+	}
+	chainId := gres.Genesis.ChainID
+	nonce := stakeArgs.Nonce
+	if nonce == 0 {
+		act, err := queryAccount(stakeArgs.Url, stakeArgs.Index, "")
+		if err != nil {
+			return
+		}
+		nonce = act.Nonce
+	}
+	btx := tx.HACTx{
+		Version:   tx.HACTxVersion2,
+		Type:      tx.HACTxTypeStake,
+		Nonce:     nonce,
+		Validator: stakeArgs.Index,
+		Tx: &tx.StakeTx{
+			Amount:    stakeArgs.Amount,
+			Statement: stakeArgs.Statement,
+		},
+	}
+	if stakeArgs.Retract {
+		btx.Type = tx.HACTxTypeRetract
+		btx.Tx = &tx.RetractTx{Amount: stakeArgs.Amount}
+	}
+	dat, err := btx.SigData([]byte(chainId))
+	if err != nil {
+		fmt.Printf("tx sign data err:%v\n", err)
+		return
+	}
+	sig, sigs, err := signTx(stakeArgs.Url, &btx, dat, stakeArgs.Skey, stakeArgs.Sig)
+	if err != nil {
+		fmt.Printf("sign tx err:%v\n", err)
+		return
+	}
+	if stakeArgs.NoSend {
+		fmt.Println("transaction signature:")
+		fmt.Println(hex.EncodeToString(sig))
+		return
+	}
+	btx.Sig = sigs
+	dat, err = encodeTx(&btx)
+	if err != nil {
+		fmt.Printf("encode tx err:%v\n", err)
+		return
+	}
+	fmt.Printf("tx:%s\n", hex.EncodeToString(dat))
+	res, err := cli.BroadcastTxSync(ctx, dat)
+	if err != nil {
+		fmt.Printf("broadcast tx err:%v\n", err)
+		return
+	}
+	dat, _ = json.Marshal(res)
+	fmt.Printf("%v\n", string(dat))
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -14,28 +15,76 @@ import (
 	eth_crypto "github.com/ethereum/go-ethereum/crypto"
 )
 
+// Votes cast when the agent can't answer a vote call in time.
+const (
+	AgentFallbackAbstain = "abstain"
+	AgentFallbackReject  = "reject"
+	// AgentFallbackLast repeats the agent's last answer on the same
+	// subject, abstaining if it never answered.
+	AgentFallbackLast = "last"
+)
+
 type HACAppConfig struct {
 	Home           string `mapstructure:"-"`
 	TimeoutCommit  uint64 `mapstructure:"-"`
 	AgentUrl       string `mapstructure:"agent_url"`
 	ServiceAddress string `mapstructure:"service_address"`
 	DiscussionRate int    `mapstructure:"discussion_rate"`
+
+	// AgentTimeout is the time an agent vote call may take, retries
+	// included. It is derived from the consensus timeouts.
+	AgentTimeout  time.Duration `mapstructure:"-"`
+	AgentRetries  int           `mapstructure:"agent_retries"`
+	AgentFallback string        `mapstructure:"agent_fallback"`
+
+	// SnapshotInterval is the number of blocks between state snapshots
+	// served to state syncing peers, 0 to take none.
+	SnapshotInterval   uint64 `mapstructure:"snapshot_interval"`
+	SnapshotKeepRecent int    `mapstructure:"snapshot_keep_recent"`
+
+	// SessionKey is the path of a session key of the validator's account,
+	// relative to the home directory. When set, the agent signs the txs the
+	// session permits with it instead of the private validator key.
+	SessionKey string `mapstructure:"session_key"`
 }
 
 func DefaultHACAppConfig(home string) *HACAppConfig {
-	return &HACAppConfig{
-		Home:     home,
-		AgentUrl: "http://127.0.0.1:3000",
-	}
+	return NewHACAppConfig(home)
 
 }
 func NewHACAppConfig(home string) *HACAppConfig {
 	return &HACAppConfig{
-		Home:     home,
-		AgentUrl: "http://127.0.0.1:3000",
+		Home:               home,
+		AgentUrl:           "http://127.0.0.1:3000",
+		AgentRetries:       2,
+		AgentFallback:      AgentFallbackAbstain,
+		SnapshotInterval:   1000,
+		SnapshotKeepRecent: 2,
 	}
 }
 
+func (c *HACAppConfig) ValidateBasic() error {
+	if c.AgentRetries < 0 {
+		return errors.New("agent_retries can't be negative")
+	}
+	switch c.AgentFallback {
+	case AgentFallbackAbstain, AgentFallbackReject, AgentFallbackLast:
+	default:
+		return fmt.Errorf("unknown agent_fallback %q", c.AgentFallback)
+	}
+	if c.SnapshotInterval > 0 && c.SnapshotKeepRecent < 1 {
+		return errors.New("snapshot_keep_recent must be at least 1 when snapshots are taken")
+	}
+	return nil
+}
+
+// AgentTimeout returns the budget of an agent vote call. The call runs
+// in ProcessProposal, ahead of our prevote, so it must leave room within
+// the propose timeout for the vote to reach the other validators.
+func AgentTimeout(cfg *config.ConsensusConfig) time.Duration {
+	return cfg.TimeoutPropose / 2
+}
+
 func GWeiPerPower(height uint64) uint64 {
 	return 1000000000
 }
@@ -44,12 +93,71 @@ func PowerPerStake(stake uint64, height uint64) int64 {
 	return int64(stake / GWeiPerPower(height))
 }
 
+// UnbondingBlocks returns how long stake retracted at height stays in the
+// unbonding queue, slashable, before it is released.
+func UnbondingBlocks(height uint64) uint64 {
+	return 20000
+}
+
+// ApplicationBlocks returns how long a membership application made at
+// height stays pending before it expires.
+func ApplicationBlocks(height uint64) uint64 {
+	return 20000
+}
+
+// SessionRateWindow returns the length, in blocks, of the windows the rate
+// cap of a session key counts txs in, at height.
+func SessionRateWindow(height uint64) uint64 {
+	return 100
+}
+
+// RewardHalvingBlocks is the number of blocks after which the block reward
+// halves.
+const RewardHalvingBlocks = 4000000
+
+// BlockReward returns the stake issued at height to the members who signed
+// the commit of the block before, shared by their power. It starts at a
+// thousandth of a power and halves every RewardHalvingBlocks, down to 0.
+// The other rewards follow it.
+func BlockReward(height uint64) uint64 {
+	return (GWeiPerPower(height) / 1000) >> (height / RewardHalvingBlocks)
+}
+
+// ProposalReward returns the stake issued at height to the proposer of a
+// proposal settled as accepted.
+func ProposalReward(height uint64) uint64 {
+	return BlockReward(height) * 100
+}
+
+// DiscussionReward returns the stake issued at height to the speaker of a
+// discussion, for the first RewardedProposalDiscussions discussions it
+// holds on a proposal.
+func DiscussionReward(height uint64) uint64 {
+	return BlockReward(height) / 10
+}
+
+// RewardedProposalDiscussions bounds the discussions of an account on a
+// proposal that are rewarded, at height.
+func RewardedProposalDiscussions(height uint64) uint64 {
+	return 4
+}
+
 type Config struct {
 	*config.Config `mapstructure:",squash"`
 
 	App *HACAppConfig `mapstructure:"app"`
 }
 
+func (c *Config) ValidateBasic() error {
+	if err := c.Config.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := c.App.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [app] section: %w", err)
+	}
+	return nil
+}
+
 func DefaultConfig(home string) *Config {
 	if len(home) == 0 {
 		home = os.ExpandEnv("$HOME/.hac")
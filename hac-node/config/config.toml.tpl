@@ -487,3 +487,22 @@ namespace = "{{ .Instrumentation.Namespace }}"
 
 [app]
 
+# Number of times a failed agent vote call is retried before falling back
+agent_retries = {{ .App.AgentRetries }}
+
+# Vote cast when the agent can't answer a vote call in time:
+# "abstain", "reject" or "last" (the agent's last answer on the same subject)
+agent_fallback = "{{ .App.AgentFallback }}"
+
+# Number of blocks between snapshots of the app state, served to peers
+# joining through state sync. 0 disables snapshots
+snapshot_interval = {{ .App.SnapshotInterval }}
+
+# Number of latest snapshots kept
+snapshot_keep_recent = {{ .App.SnapshotKeepRecent }}
+
+# Path of a session key of the validator's account, registered with
+# "hac session". When set, the agent signs the discussions, proposals and
+# settlements the session permits with it instead of the private validator
+# key. Empty to sign everything with the private validator key
+session_key = "{{ .App.SessionKey }}"
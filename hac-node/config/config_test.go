@@ -0,0 +1,65 @@
+package config
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBlockReward(t *testing.T) {
+	const start = 1000000
+	tests := []struct {
+		name   string
+		height uint64
+		want   uint64
+	}{
+		{"genesis", 0, start},
+		{"before first halving", RewardHalvingBlocks - 1, start},
+		{"first halving", RewardHalvingBlocks, start / 2},
+		{"before second halving", 2*RewardHalvingBlocks - 1, start / 2},
+		{"second halving", 2 * RewardHalvingBlocks, start / 4},
+		{"last reward", 19 * RewardHalvingBlocks, 1},
+		{"run out", 20 * RewardHalvingBlocks, 0},
+		{"shift past width", 64 * RewardHalvingBlocks, 0},
+		{"max height", math.MaxUint64, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BlockReward(tt.height); got != tt.want {
+				t.Fatalf("reward %d, want %d", got, tt.want)
+			}
+			if got := ProposalReward(tt.height); got != tt.want*100 {
+				t.Fatalf("proposal reward %d, want %d", got, tt.want*100)
+			}
+			if got := DiscussionReward(tt.height); got != tt.want/10 {
+				t.Fatalf("discussion reward %d, want %d", got, tt.want/10)
+			}
+		})
+	}
+}
+
+func TestValidateBasicAgent(t *testing.T) {
+	tests := []struct {
+		name     string
+		retries  int
+		fallback string
+		ok       bool
+	}{
+		{"defaults", 2, AgentFallbackAbstain, true},
+		{"no retries", 0, AgentFallbackAbstain, true},
+		{"reject", 2, AgentFallbackReject, true},
+		{"last", 2, AgentFallbackLast, true},
+		{"negative retries", -1, AgentFallbackAbstain, false},
+		{"unknown fallback", 2, "accept", false},
+		{"empty fallback", 2, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewHACAppConfig(t.TempDir())
+			c.AgentRetries = tt.retries
+			c.AgentFallback = tt.fallback
+			if err := c.ValidateBasic(); (err == nil) != tt.ok {
+				t.Fatalf("err %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
@@ -8,22 +8,30 @@ import (
 )
 
 type accountSt struct {
-	Index    uint64         `json:"index"`
-	PubKey   ed25519.PubKey `json:"pubKey"`
-	Stake    uint64         `json:"stake"`
-	AgentUrl string         `json:"agentUrl"`
-	Name     string         `json:"name"`
-	Nonce    uint64         `json:"nonce"`
+	Index     uint64         `json:"index"`
+	PubKey    ed25519.PubKey `json:"pubKey"`
+	Stake     uint64         `json:"stake"`
+	Deposit   uint64         `json:"deposit"`
+	AgentUrl  string         `json:"agentUrl"`
+	Name      string         `json:"name"`
+	SelfIntro string         `json:"selfIntro"`
+	Nonce     uint64         `json:"nonce"`
+	Signers   [][]byte       `json:"signers,omitempty"`
+	Threshold uint32         `json:"threshold,omitempty"`
 }
 
 func (a *Account) MarshalJSON() (dat []byte, err error) {
 	o := accountSt{
-		Index:    a.Index,
-		PubKey:   a.PubKey,
-		Stake:    a.Stake,
-		Nonce:    a.Nonce,
-		Name:     a.Name,
-		AgentUrl: a.AgentUrl,
+		Index:     a.Index,
+		PubKey:    a.PubKey,
+		Stake:     a.Stake,
+		Deposit:   a.Deposit,
+		Nonce:     a.Nonce,
+		Name:      a.Name,
+		AgentUrl:  a.AgentUrl,
+		SelfIntro: a.SelfIntro,
+		Signers:   a.Signers,
+		Threshold: a.Threshold,
 	}
 	return json.Marshal(o)
 }
@@ -37,9 +45,13 @@ func (a *Account) UnmarshalJSON(dat []byte) (err error) {
 	a.Index = o.Index
 	a.PubKey = o.PubKey
 	a.Stake = o.Stake
+	a.Deposit = o.Deposit
 	a.AgentUrl = o.AgentUrl
 	a.Nonce = o.Nonce
 	a.Name = o.Name
+	a.SelfIntro = o.SelfIntro
+	a.Signers = o.Signers
+	a.Threshold = o.Threshold
 	return
 }
 
@@ -65,10 +77,31 @@ func (a *Account) Address() string {
 	return pk.Address().String()
 }
 
+// Verify checks sigs authorize msg for the account. An account with no
+// Threshold is controlled by its own key, with one signature. Otherwise
+// sigs[i] is the signature of Signers[i], empty for a signer who did not
+// sign, and at least Threshold of them must be present; every present one
+// must verify.
 func (a *Account) Verify(msg []byte, sigs [][]byte) (succ bool) {
-	if len(sigs) != 1 {
+	if a.Threshold == 0 {
+		if len(sigs) != 1 {
+			return false
+		}
+		pk := ed25519.PubKey(a.PubKey[:])
+		return pk.VerifySignature(msg, sigs[0])
+	}
+	if len(sigs) > len(a.Signers) {
 		return false
 	}
-	pk := ed25519.PubKey(a.PubKey[:])
-	return pk.VerifySignature(msg, sigs[0])
+	var signed uint32
+	for i, sig := range sigs {
+		if len(sig) == 0 {
+			continue
+		}
+		if !ed25519.PubKey(a.Signers[i]).VerifySignature(msg, sig) {
+			return false
+		}
+		signed++
+	}
+	return signed >= a.Threshold
 }
@@ -0,0 +1,55 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+)
+
+func TestAccountVerify(t *testing.T) {
+	msg := []byte("msg")
+	keys := make([]ed25519.PrivKey, 3)
+	signers := make([][]byte, len(keys))
+	sigs := make([][]byte, len(keys))
+	for i := range keys {
+		keys[i] = ed25519.GenPrivKey()
+		signers[i] = keys[i].PubKey().Bytes()
+		sigs[i], _ = keys[i].Sign(msg)
+	}
+	other, _ := ed25519.GenPrivKey().Sign(msg)
+	none := []byte(nil)
+
+	tests := []struct {
+		name      string
+		threshold uint32
+		sigs      [][]byte
+		want      bool
+	}{
+		{"single key", 0, [][]byte{sigs[0]}, true},
+		{"single key other signature", 0, [][]byte{other}, false},
+		{"single key two signatures", 0, [][]byte{sigs[0], sigs[0]}, false},
+		{"single key no signature", 0, nil, false},
+		{"2 of 3", 2, [][]byte{sigs[0], none, sigs[2]}, true},
+		{"2 of 3 trailing absent", 2, [][]byte{sigs[0], sigs[1]}, true},
+		{"3 of 3 over threshold", 2, sigs, true},
+		{"1 of 3 under threshold", 2, [][]byte{none, sigs[1], none}, false},
+		{"same signature twice", 2, [][]byte{sigs[0], sigs[0], none}, false},
+		{"signatures swapped", 2, [][]byte{sigs[1], sigs[0], none}, false},
+		{"bad signature with threshold met", 2, [][]byte{sigs[0], sigs[1], other}, false},
+		{"more signatures than signers", 2, append(append([][]byte{}, sigs...), sigs[0]), false},
+		{"3 of 3 all required", 3, sigs, true},
+		{"2 of 3 all required", 3, [][]byte{sigs[0], sigs[1], none}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Account{Threshold: tt.threshold}
+			a.SetPubKey(signers[0])
+			if tt.threshold != 0 {
+				a.Signers = signers
+			}
+			if got := a.Verify(msg, tt.sigs); got != tt.want {
+				t.Fatalf("verify %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package state
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/hetu-project/hetu-chaoschain/config"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// The pending membership applications are kept under KeyApplication by the
+// applicant's address, one at most per key. A grant of the application or
+// its expiry drops it.
+
+// GetApplication returns the pending application of address, nil if there
+// is none.
+func (s *State) GetApplication(address string) (*hac_types.Application, error) {
+	if application, ok := s.modApplications[address]; ok {
+		return application, nil
+	}
+	return getApplication(s.db.Get, []byte(fmt.Sprintf(KeyApplication, address)))
+}
+
+// verifyApplication checks an application tx is signed by the applicant's
+// key. The applicant has no account, so no nonce to follow either.
+func (s *State) verifyApplication(btx *tx.HACTx, atx *tx.ApplyTx) (succ bool, err error) {
+	if btx.Nonce != 0 || btx.Validator != 0 || len(btx.Sig) != 1 || len(atx.Pubkey) != ed25519.PubKeySize {
+		return false, ErrTxSigInvalid
+	}
+	dat, err := btx.SigData([]byte(s.header.ChainId))
+	if err != nil {
+		return false, err
+	}
+	if !ed25519.PubKey(atx.Pubkey).VerifySignature(dat, btx.Sig[0]) {
+		return false, ErrTxSigInvalid
+	}
+	return true, nil
+}
+
+// Apply stores the membership application of the holder of tx.Pubkey as
+// pending, until config.ApplicationBlocks have passed. The key must not be
+// an account's or have an application pending, and the applicant must
+// agree to the active manifest.
+func (s *State) Apply(tx *tx.ApplyTx, checkOnly bool) (event *hac_types.EventApply, err error) {
+	err = tx.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	pk := ed25519.PubKey(tx.Pubkey)
+	address := pk.Address().String()
+	s.logger.Debug("apply membership application", "applicant", address, "height", s.header.Height)
+	exist, err := s.existPubkey(tx.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		err = ErrAccountAlreadyExists
+		return
+	}
+	pending, err := s.GetApplication(address)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		err = ErrTxApplicationExists
+		return
+	}
+	active, err := s.GetActiveManifest()
+	if err != nil {
+		if err == ErrNotFound {
+			err = ErrTxManifestNoexists
+		}
+		return nil, err
+	}
+	if tx.ManifestVersion != active.Version {
+		err = ErrTxManifestVersion
+		return
+	}
+	if checkOnly {
+		return
+	}
+	application := &hac_types.Application{
+		Address:         address,
+		Pubkey:          tx.Pubkey,
+		Statement:       tx.Statement,
+		AgentUrl:        tx.AgentUrl,
+		Name:            tx.Name,
+		ManifestVersion: tx.ManifestVersion,
+		Height:          s.header.Height,
+		ExpireHeight:    s.header.Height + config.ApplicationBlocks(s.header.Height),
+	}
+	s.modApplications[address] = application
+	event = &hac_types.EventApply{
+		Address:         address,
+		Pubkey:          hex.EncodeToString(tx.Pubkey),
+		Name:            tx.Name,
+		AgentUrl:        tx.AgentUrl,
+		Statement:       tx.Statement,
+		ManifestVersion: tx.ManifestVersion,
+		ExpireHeight:    application.ExpireHeight,
+	}
+	return
+}
+
+// ResolveGrant returns the grant as voted on: a grant of an application
+// takes the applicant's key and profile from it.
+func (s *State) ResolveGrant(grant *tx.GrantSt) (*tx.GrantSt, error) {
+	if grant.Application == "" {
+		return grant, nil
+	}
+	application, err := s.GetApplication(grant.Application)
+	if err != nil {
+		return nil, err
+	}
+	if application == nil {
+		return nil, ErrTxApplicationNoexists
+	}
+	return &tx.GrantSt{
+		Statement:   application.Statement,
+		Amount:      grant.Amount,
+		AgentUrl:    application.AgentUrl,
+		Name:        application.Name,
+		Pubkey:      application.Pubkey,
+		Application: application.Address,
+	}, nil
+}
+
+// ExpireApplications drops the pending applications whose ExpireHeight has
+// passed. It runs after the txs of the block, a grant in the last block
+// still counts.
+func (s *State) ExpireApplications() (events []*hac_types.EventApplicationExpired, err error) {
+	start := []byte(fmt.Sprintf(KeyApplicationExpire, uint64(0), ""))
+	end := []byte(fmt.Sprintf(KeyApplicationExpire, s.header.Height, ""))
+	it, err := s.db.Iterator(start, end, true)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]string, 0)
+	for ; it.Valid(); it.Next() {
+		addresses = append(addresses, string(it.Value()))
+	}
+	err = it.Error()
+	it.Close()
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range addresses {
+		application, err := s.GetApplication(address)
+		if err != nil {
+			return nil, err
+		}
+		if application == nil {
+			continue
+		}
+		s.modApplications[address] = nil
+		events = append(events, &hac_types.EventApplicationExpired{
+			Address:      address,
+			ExpireHeight: application.ExpireHeight,
+			Height:       s.header.Height,
+		})
+	}
+	return
+}
+
+// writeApplications writes the applications made and dropped in the block.
+func (s *State) writeApplications() (err error) {
+	addresses := make([]string, 0, len(s.modApplications))
+	for address := range s.modApplications {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		key := []byte(fmt.Sprintf(KeyApplication, address))
+		application := s.modApplications[address]
+		if application == nil {
+			var stored *hac_types.Application
+			stored, err = getApplication(s.db.Get, key)
+			if err != nil {
+				return
+			}
+			if stored == nil {
+				continue
+			}
+			_, _, err = s.db.Remove(key)
+			if err != nil {
+				return
+			}
+			_, _, err = s.db.Remove([]byte(fmt.Sprintf(KeyApplicationExpire, stored.ExpireHeight, address)))
+			if err != nil {
+				return
+			}
+			continue
+		}
+		applicationBz, _ := json.Marshal(application)
+		_, err = s.db.Set(key, applicationBz)
+		if err != nil {
+			return
+		}
+		_, err = s.db.Set([]byte(fmt.Sprintf(KeyApplicationExpire, application.ExpireHeight, address)), []byte(address))
+		if err != nil {
+			return
+		}
+	}
+	s.modApplications = make(map[string]*hac_types.Application)
+	return
+}
+
+func getApplication(get func(key []byte) ([]byte, error), key []byte) (*hac_types.Application, error) {
+	val, err := get(key)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+	application := new(hac_types.Application)
+	err = json.Unmarshal(val, application)
+	if err != nil {
+		return nil, err
+	}
+	return application, nil
+}
@@ -0,0 +1,231 @@
+package state
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/hetu-project/hetu-chaoschain/config"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+)
+
+// testApplication returns the application of key to the first version of
+// the manifest.
+func testApplication(key ed25519.PrivKey) *tx.ApplyTx {
+	return &tx.ApplyTx{Pubkey: key.PubKey().Bytes(), Statement: "statement", Name: "applicant", AgentUrl: "http://127.0.0.1:3000", ManifestVersion: 1}
+}
+
+// applyAt stores the application of key in a block of db.
+func applyAt(t *testing.T, db *StateDB, key ed25519.PrivKey) {
+	t.Helper()
+	st := db.NewState()
+	if _, err := st.Apply(testApplication(key), false); err != nil {
+		t.Fatal(err)
+	}
+	commitTestState(t, db, st)
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name string
+		// pending applies with the key first.
+		pending bool
+		// member applies with the key of a member.
+		member bool
+		edit   func(atx *tx.ApplyTx)
+		err    error
+	}{
+		{"applied", false, false, nil, nil},
+		{"already pending", true, false, nil, ErrTxApplicationExists},
+		{"member key", false, true, nil, ErrAccountAlreadyExists},
+		{"stale manifest", false, false, func(atx *tx.ApplyTx) { atx.ManifestVersion = 0 }, ErrTxManifestVersion},
+		{"future manifest", false, false, func(atx *tx.ApplyTx) { atx.ManifestVersion = 2 }, ErrTxManifestVersion},
+		{"no statement", false, false, func(atx *tx.ApplyTx) { atx.Statement = "" }, tx.ErrInvalidGrant},
+		{"no name", false, false, func(atx *tx.ApplyTx) { atx.Name = "" }, tx.ErrInvalidGrant},
+		{"bad agent url", false, false, func(atx *tx.ApplyTx) { atx.AgentUrl = "ftp://agent" }, tx.ErrInvalidGrant},
+		{"bad key", false, false, func(atx *tx.ApplyTx) { atx.Pubkey = atx.Pubkey[1:] }, tx.ErrInvalidGrant},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, 1000)
+			key := ed25519.GenPrivKey()
+			if tt.pending {
+				applyAt(t, db, key)
+			}
+			atx := testApplication(key)
+			if tt.member {
+				atx.Pubkey = testAccount(t, db.NewState(), idxs[0]).PubKey
+			}
+			if tt.edit != nil {
+				tt.edit(atx)
+			}
+			st := db.NewState()
+			if _, err := st.Apply(atx, true); !errors.Is(err, tt.err) {
+				t.Fatalf("check err %v, want %v", err, tt.err)
+			}
+			event, err := st.Apply(atx, false)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			commitTestState(t, db, st)
+			application, err := db.NewState().GetApplication(key.PubKey().Address().String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			height := st.Header().Height
+			if application == nil || application.Name != "applicant" || application.ExpireHeight != height+config.ApplicationBlocks(height) || event.ExpireHeight != application.ExpireHeight {
+				t.Fatalf("application %+v event %+v", application, event)
+			}
+		})
+	}
+}
+
+func TestApplicationVerify(t *testing.T) {
+	key := ed25519.GenPrivKey()
+	tests := []struct {
+		name  string
+		edit  func(btx *tx.HACTx)
+		valid bool
+	}{
+		{"signed by the applicant", nil, true},
+		{"with a nonce", func(btx *tx.HACTx) { btx.Nonce = 1 }, false},
+		{"for an account", func(btx *tx.HACTx) { btx.Validator = 1 }, false},
+		{"signed by another key", func(btx *tx.HACTx) { btx.Sig = [][]byte{sign(t, ed25519.GenPrivKey(), btx)} }, false},
+		{"signed twice", func(btx *tx.HACTx) { btx.Sig = append(btx.Sig, btx.Sig[0]) }, false},
+		{"unsigned", func(btx *tx.HACTx) { btx.Sig = nil }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, _ := newTestDB(t, nil, 1000)
+			btx := &tx.HACTx{Version: tx.HACTxVersion2, Type: tx.HACTxTypeApply, Tx: testApplication(key)}
+			btx.Sig = [][]byte{sign(t, key, btx)}
+			if tt.edit != nil {
+				tt.edit(btx)
+			}
+			ok, err := db.NewState().Verify(btx, false)
+			if ok != tt.valid || (err == nil) != tt.valid {
+				t.Fatalf("verified %v err %v, want %v", ok, err, tt.valid)
+			}
+			if err != nil && !errors.Is(err, ErrTxSigInvalid) {
+				t.Fatalf("err %v, want %v", err, ErrTxSigInvalid)
+			}
+		})
+	}
+}
+
+// sign signs btx on chain "test" with key.
+func sign(t *testing.T, key ed25519.PrivKey, btx *tx.HACTx) []byte {
+	t.Helper()
+	dat, err := btx.SigData([]byte("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := key.Sign(dat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+func TestGrantApplication(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    tx.VoteCode
+		granted bool
+	}{
+		{"granted", tx.VoteGrantNewMember, true},
+		{"rejected", tx.VoteRejectNewMember, false},
+		{"no quorum", tx.VoteNoQuorum, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, 1000)
+			key := ed25519.GenPrivKey()
+			address := key.PubKey().Address().String()
+			applyAt(t, db, key)
+
+			st := db.NewState()
+			grant := &tx.GrantSt{Amount: 2 * config.GWeiPerPower(0), Application: address}
+			event, err := st.Grant(idxs[0], grant, false, tt.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if event.Grant != tt.granted || event.Address != address {
+				t.Fatalf("event %+v, want grant %v", event, tt.granted)
+			}
+			commitTestState(t, db, st)
+
+			// the application is no longer pending whatever the outcome, and
+			// cannot be granted again.
+			st = db.NewState()
+			if application, err := st.GetApplication(address); err != nil || application != nil {
+				t.Fatalf("application %+v: %v, want none", application, err)
+			}
+			if _, err := st.Grant(idxs[0], grant, true, tx.VoteGrantNewMember); !errors.Is(err, ErrTxApplicationNoexists) {
+				t.Fatalf("regrant err %v, want %v", err, ErrTxApplicationNoexists)
+			}
+			a, err := st.FindAccount(key.PubKey().Address())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.granted {
+				if a != nil {
+					t.Fatalf("account %+v, want none", a)
+				}
+				return
+			}
+			if a == nil || a.Name != "applicant" || a.AgentUrl != "http://127.0.0.1:3000" || a.Stake != grant.Amount {
+				t.Fatalf("account %+v", a)
+			}
+		})
+	}
+}
+
+func TestExpireApplications(t *testing.T) {
+	tests := []struct {
+		name string
+		// past is the height past the application's ExpireHeight it is
+		// expired at.
+		past    int64
+		expired bool
+	}{
+		{"before its expiry", -1, false},
+		{"at its expiry", 0, false},
+		{"past its expiry", 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, _ := newTestDB(t, nil, 1000)
+			key := ed25519.GenPrivKey()
+			address := key.PubKey().Address().String()
+			applyAt(t, db, key)
+			application, err := db.NewState().GetApplication(address)
+			if err != nil || application == nil {
+				t.Fatalf("application %+v: %v", application, err)
+			}
+
+			st := db.NewState()
+			st.Header().Height = uint64(int64(application.ExpireHeight) + tt.past)
+			events, err := st.ExpireApplications()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if expired := len(events) == 1 && events[0].Address == address; expired != tt.expired || len(events) > 1 {
+				t.Fatalf("expired %+v, want %v", events, tt.expired)
+			}
+			commitTestState(t, db, st)
+			st = db.NewState()
+			application, err = st.GetApplication(address)
+			if err != nil || (application == nil) != tt.expired {
+				t.Fatalf("application %+v: %v, want expired %v", application, err, tt.expired)
+			}
+			// an expired application may be made again.
+			if _, err := st.Apply(testApplication(key), true); (err == nil) != tt.expired {
+				t.Fatalf("apply again err %v, want expired %v", err, tt.expired)
+			}
+		})
+	}
+}
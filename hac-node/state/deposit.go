@@ -0,0 +1,144 @@
+package state
+
+import (
+	"math/big"
+
+	"github.com/hetu-project/hetu-chaoschain/config"
+	txtypes "github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// A proposal takes the ProposalDeposit of the chain params from the stake
+// of its proposer, so that flooding the agents with proposals to judge
+// costs stake. The validators' draft vote decides the deposit:
+// VoteProcessProposal refunds it at once, VoteIgnoreProposal forfeits it
+// to the community pool, and any other code, VoteNoQuorum included, holds
+// it on the proposal, in Account.Deposit, until the proposal's EndHeight.
+// A held deposit is forfeited with the stake of a slashed or disqualified
+// account.
+
+// CommunityPool returns the deposits forfeited so far.
+func (s *State) CommunityPool() (uint64, error) {
+	val, err := s.db.Get([]byte(KeyCommunityPool))
+	if err != nil {
+		return 0, err
+	}
+	return new(big.Int).SetBytes(val).Uint64() + s.poolDeposits, nil
+}
+
+// checkDeposit checks that a, proposing at this height, can pay the
+// deposit and keep some stake.
+func (s *State) checkDeposit(a *Account) (deposit uint64, err error) {
+	params, err := s.GetParams()
+	if err != nil {
+		return 0, err
+	}
+	deposit = params.ProposalDeposit
+	if deposit != 0 && a.Stake <= deposit {
+		return 0, ErrTxDepositInsufficient
+	}
+	return deposit, nil
+}
+
+// takeDeposit takes the deposit of proposal from its proposer a as the
+// draft vote code decides, and returns what became of it. Any code but
+// VoteProcessProposal and VoteIgnoreProposal, VoteNoQuorum or an abstain
+// included, leaves the draft undecided and holds the deposit.
+func (s *State) takeDeposit(a *Account, proposal *hac_types.Proposal, deposit uint64, code txtypes.VoteCode) string {
+	switch {
+	case deposit == 0 || code == txtypes.VoteProcessProposal:
+		return hac_types.DepositRefunded
+	case code == txtypes.VoteIgnoreProposal:
+		a.Stake -= deposit
+		s.poolDeposits += deposit
+		return hac_types.DepositForfeited
+	default:
+		a.Stake -= deposit
+		a.Deposit += deposit
+		proposal.Deposit = deposit
+		return hac_types.DepositHeld
+	}
+}
+
+// forfeitDeposit sends the deposits a holds to the community pool. The
+// caller marks a modified.
+func (s *State) forfeitDeposit(a *Account) {
+	s.poolDeposits += a.Deposit
+	a.Deposit = 0
+}
+
+// RefundDeposits refunds the deposits held on the proposals past their
+// EndHeight. The deposit goes back to the stake of the proposer, or to the
+// unbonding queue if it retracted all its stake meanwhile.
+func (s *State) RefundDeposits() (events []*hac_types.EventDepositRefund, err error) {
+	if s.header.Height == 0 {
+		return nil, nil
+	}
+	idxs, err := s.endedProposals()
+	if err != nil {
+		return nil, err
+	}
+	for _, idx := range idxs {
+		proposal, err := s.getProposal(idx)
+		if err != nil {
+			return nil, err
+		}
+		if proposal.Deposit == 0 {
+			continue
+		}
+		a, err := s.GetAccount(proposal.Proposer)
+		if err != nil {
+			return nil, err
+		}
+		// a slashed proposer forfeited the deposit already.
+		amount := min(proposal.Deposit, a.Deposit)
+		proposal.Deposit = 0
+		s.modProposals[proposal.Index] = proposal
+		if amount == 0 {
+			continue
+		}
+		a.Deposit -= amount
+		if a.Stake == 0 {
+			err = s.addUnbonding(hac_types.Unbonding{
+				Account:       a.Index,
+				Amount:        amount,
+				Height:        s.header.Height,
+				ReleaseHeight: s.header.Height + config.UnbondingBlocks(s.header.Height),
+			})
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			a.Stake += amount
+		}
+		v := s.modifiedAcnts[a.Index]
+		v |= ModifiedFlagMod
+		s.modifiedAcnts[a.Index] = v
+		s.acnts[a.Index] = a.Clone()
+		events = append(events, &hac_types.EventDepositRefund{
+			Proposal: proposal.Index,
+			Proposer: a.Index,
+			Amount:   amount,
+			Stake:    a.Stake,
+			Height:   s.header.Height,
+		})
+	}
+	return
+}
+
+// writeCommunityPool adds the deposits forfeited in the block to the pool.
+func (s *State) writeCommunityPool() error {
+	if s.poolDeposits == 0 {
+		return nil
+	}
+	pool, err := s.CommunityPool()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Set([]byte(KeyCommunityPool), new(big.Int).SetUint64(pool).Bytes())
+	if err != nil {
+		return err
+	}
+	s.poolDeposits = 0
+	return nil
+}
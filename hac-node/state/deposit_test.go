@@ -0,0 +1,137 @@
+package state
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+func TestProposalDeposit(t *testing.T) {
+	const stake = 1000
+	tests := []struct {
+		name    string
+		deposit uint64
+		stake   uint64
+		code    tx.VoteCode
+		err     error
+		// status is what became of the deposit, held the part held on the
+		// proposal and pool the part forfeited.
+		status string
+		held   uint64
+		pool   uint64
+	}{
+		{"processed refunded", 100, stake, tx.VoteProcessProposal, nil, hac_types.DepositRefunded, 0, 0},
+		{"ignored forfeited", 100, stake, tx.VoteIgnoreProposal, nil, hac_types.DepositForfeited, 0, 100},
+		{"abstained held", 100, stake, tx.VoteAbstain, nil, hac_types.DepositHeld, 100, 0},
+		{"no quorum held", 100, stake, tx.VoteNoQuorum, nil, hac_types.DepositHeld, 100, 0},
+		{"no deposit", 0, stake, tx.VoteIgnoreProposal, nil, hac_types.DepositRefunded, 0, 0},
+		{"stake short", 100, 100, tx.VoteProcessProposal, ErrTxDepositInsufficient, "", 0, 0},
+		{"invalid code", 100, stake, tx.VoteAcceptProposal, ErrTxVoteCodeInvalid, "", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := hac_types.DefaultParams()
+			params.ProposalDeposit = tt.deposit
+			db, idxs := newTestDB(t, params, tt.stake)
+			st := db.NewState()
+			ptx := &tx.ProposalTx{Title: "title", EndHeight: st.Header().Height + 1, ExpireTimestamp: math.MaxUint32}
+			event, err := st.Proposal(ptx, idxs[0], false, tt.code)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if event.DepositStatus != tt.status {
+				t.Fatalf("deposit %s, want %s", event.DepositStatus, tt.status)
+			}
+			commitTestState(t, db, st)
+
+			// the stake of the proposer keeps what was not forfeited, the
+			// held part in its deposits until the proposal ends.
+			st = db.NewState()
+			a := testAccount(t, st, idxs[0])
+			if a.Stake+a.Deposit != tt.stake-tt.pool || a.Deposit != tt.held {
+				t.Fatalf("stake %d deposit %d, want %d held %d", a.Stake, a.Deposit, tt.stake-tt.pool, tt.held)
+			}
+			if pool, _ := st.CommunityPool(); pool != tt.pool {
+				t.Fatalf("pool %d, want %d", pool, tt.pool)
+			}
+			commitTestState(t, db, st)
+
+			st = db.NewState()
+			events, err := st.RefundDeposits()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.held != 0 && (len(events) != 1 || events[0].Amount != tt.held) {
+				t.Fatalf("refunds %+v, want %d", events, tt.held)
+			}
+			if tt.held == 0 && len(events) != 0 {
+				t.Fatalf("refunds %+v, want none", events)
+			}
+			commitTestState(t, db, st)
+			a = testAccount(t, db.NewState(), idxs[0])
+			if a.Stake != tt.stake-tt.pool || a.Deposit != 0 {
+				t.Fatalf("stake %d deposit %d after refund, want %d", a.Stake, a.Deposit, tt.stake-tt.pool)
+			}
+		})
+	}
+}
+
+func TestHeldDepositRefund(t *testing.T) {
+	const stake, deposit = 1000, 100
+	params := hac_types.DefaultParams()
+	params.ProposalDeposit = deposit
+	db, idxs := newTestDB(t, params, stake)
+	st := db.NewState()
+	endHeight := st.Header().Height + 3
+	ptx := &tx.ProposalTx{Title: "title", EndHeight: endHeight, ExpireTimestamp: math.MaxUint32}
+	event, err := st.Proposal(ptx, idxs[0], false, tx.VoteNoQuorum)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.DepositStatus != hac_types.DepositHeld {
+		t.Fatalf("deposit %s, want %s", event.DepositStatus, hac_types.DepositHeld)
+	}
+	commitTestState(t, db, st)
+
+	// the deposit stays held up to the EndHeight, and is refunded by the
+	// first block past it.
+	for {
+		st = db.NewState()
+		events, err := st.RefundDeposits()
+		if err != nil {
+			t.Fatal(err)
+		}
+		height := st.Header().Height
+		a := testAccount(t, st, idxs[0])
+		if height <= endHeight {
+			if len(events) != 0 {
+				t.Fatalf("height %d: refunds %+v before EndHeight %d", height, events, endHeight)
+			}
+			if a.Stake != stake-deposit || a.Deposit != deposit {
+				t.Fatalf("height %d: stake %d deposit %d, want %d held %d", height, a.Stake, a.Deposit, stake-deposit, deposit)
+			}
+			commitTestState(t, db, st)
+			continue
+		}
+		if len(events) != 1 || events[0].Amount != deposit {
+			t.Fatalf("height %d: refunds %+v, want %d", height, events, deposit)
+		}
+		commitTestState(t, db, st)
+		break
+	}
+	a := testAccount(t, db.NewState(), idxs[0])
+	if a.Stake != stake || a.Deposit != 0 {
+		t.Fatalf("stake %d deposit %d after refund, want %d", a.Stake, a.Deposit, stake)
+	}
+	// a refund is paid once.
+	st = db.NewState()
+	if events, err := st.RefundDeposits(); err != nil || len(events) != 0 {
+		t.Fatalf("refunds %+v err %v, want none", events, err)
+	}
+}
@@ -0,0 +1,112 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// Discussions are held on processing proposals only. The limits on them,
+// in the chain params, keep a single agent from flooding the blocks and the
+// context of the other agents: the data of a discussion is at most
+// MaxDiscussionLen bytes, an account holds at most MaxProposalDiscussions on
+// a proposal, and a block carries at most MaxBlockDiscussions discussions,
+// MaxAccountBlockDiscussions of them by one account. The block caps count
+// the discussions applied before in the same block, so PrepareProposal
+// leaves out the discussions over them and ProcessProposal rejects a block
+// that carries them.
+
+// checkDiscussion checks the discussion of tx by account against the
+// proposal it is held on and the limits on discussions.
+func (s *State) checkDiscussion(tx *tx.DiscussionTx, account uint64) error {
+	params, err := s.GetParams()
+	if err != nil {
+		return err
+	}
+	if uint64(len(tx.Data)) > params.MaxDiscussionLen {
+		return ErrTxDiscussionTooLong
+	}
+	p, err := s.getProposal(tx.Proposal)
+	if errors.Is(err, ErrProposaNoexists) || errors.Is(err, ErrNotFound) {
+		return ErrTxProposalNoexists
+	}
+	if err != nil {
+		return err
+	}
+	if p.Status != hac_types.ProposalStatusProcessing {
+		return ErrTxProposalClosed
+	}
+	if uint64(len(s.newDiscussions)) >= params.MaxBlockDiscussions {
+		return ErrTxDiscussionBlockCap
+	}
+	inBlock := uint64(0)
+	for _, dis := range s.newDiscussions {
+		if dis.Speaker == account {
+			inBlock++
+		}
+	}
+	if inBlock >= params.MaxAccountBlockDiscussions {
+		return ErrTxDiscussionAccountBlockCap
+	}
+	count, err := s.DiscussionCount(tx.Proposal, account)
+	if err != nil {
+		return err
+	}
+	if count >= params.MaxProposalDiscussions {
+		return ErrTxDiscussionQuota
+	}
+	return nil
+}
+
+// DiscussionCount returns the discussions account held on proposal.
+func (s *State) DiscussionCount(proposal, account uint64) (uint64, error) {
+	count, err := s.storedDiscussionCount(proposal, account)
+	if err != nil {
+		return 0, err
+	}
+	// the discussions of this block are not counted yet.
+	for _, dis := range s.newDiscussions {
+		if dis.Proposal == proposal && dis.Speaker == account {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *State) storedDiscussionCount(proposal, account uint64) (uint64, error) {
+	val, err := s.db.Get([]byte(fmt.Sprintf(KeyDiscussionCount, proposal, account)))
+	if err != nil {
+		return 0, err
+	}
+	return new(big.Int).SetBytes(val).Uint64(), nil
+}
+
+// writeDiscussionCounts adds the discussions of the block to the counts of
+// their speakers.
+func (s *State) writeDiscussionCounts() error {
+	added := make(map[string]uint64)
+	for _, dis := range s.newDiscussions {
+		added[fmt.Sprintf(KeyDiscussionCount, dis.Proposal, dis.Speaker)]++
+	}
+	keys := make([]string, 0, len(added))
+	for key := range added {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		val, err := s.db.Get([]byte(key))
+		if err != nil {
+			return err
+		}
+		count := new(big.Int).SetBytes(val).Uint64() + added[key]
+		_, err = s.db.Set([]byte(key), new(big.Int).SetUint64(count).Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+func TestDiscussionLimits(t *testing.T) {
+	params := &hac_types.Params{
+		MaxDiscussionLen:           8,
+		MaxProposalDiscussions:     3,
+		MaxBlockDiscussions:        3,
+		MaxAccountBlockDiscussions: 2,
+	}
+	type step struct {
+		speaker  int
+		proposal uint64
+		len      int
+		err      error
+	}
+	tests := []struct {
+		name   string
+		blocks [][]step
+	}{
+		{"at length", [][]step{{{0, 1, 8, nil}}}},
+		{"too long", [][]step{{{0, 1, 9, ErrTxDiscussionTooLong}}}},
+		{"no proposal", [][]step{{{0, 2, 1, ErrTxProposalNoexists}}}},
+		{"account block cap", [][]step{{{0, 1, 1, nil}, {0, 1, 1, nil}, {0, 1, 1, ErrTxDiscussionAccountBlockCap}, {1, 1, 1, nil}}}},
+		{"block cap", [][]step{{{0, 1, 1, nil}, {1, 1, 1, nil}, {2, 1, 1, nil}, {0, 1, 1, ErrTxDiscussionBlockCap}}}},
+		{"block caps reset", [][]step{
+			{{0, 1, 1, nil}, {0, 1, 1, nil}},
+			{{0, 1, 1, nil}, {1, 1, 1, nil}},
+		}},
+		{"proposal quota", [][]step{
+			{{0, 1, 1, nil}, {0, 1, 1, nil}},
+			{{0, 1, 1, nil}, {0, 1, 1, ErrTxDiscussionQuota}, {1, 1, 1, nil}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, params, 1e12, 1e12, 1e12)
+			st := db.NewState()
+			_, err := st.Proposal(&tx.ProposalTx{Title: "title", ExpireTimestamp: math.MaxUint32}, idxs[0], false, tx.VoteProcessProposal)
+			if err != nil {
+				t.Fatal(err)
+			}
+			commitTestState(t, db, st)
+			for i, block := range tt.blocks {
+				st := db.NewState()
+				for j, s := range block {
+					dis := &tx.DiscussionTx{Proposal: s.proposal, Data: bytes.Repeat([]byte("d"), s.len)}
+					_, err := st.Dicussion(dis, idxs[s.speaker], false)
+					if !errors.Is(err, s.err) {
+						t.Fatalf("block %d discussion %d: err %v, want %v", i, j, err, s.err)
+					}
+				}
+				commitTestState(t, db, st)
+			}
+		})
+	}
+}
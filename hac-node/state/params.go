@@ -0,0 +1,137 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	txtypes "github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// The chain params are kept in the state, as set at genesis and amended by
+// the validators' vote on an AmendParamsTx. A state that has none, of a
+// chain started before they were kept, runs on hac_types.DefaultParams.
+
+// SetParams sets the params of the chain from the next Update on.
+func (s *State) SetParams(params *hac_types.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	s.newParams = params.Clone()
+	return nil
+}
+
+// GetParams returns the params of the chain.
+func (s *State) GetParams() (*hac_types.Params, error) {
+	if s.newParams != nil {
+		return s.newParams.Clone(), nil
+	}
+	return getParams(s.db.Get)
+}
+
+func getParams(get func(key []byte) ([]byte, error)) (*hac_types.Params, error) {
+	val, err := get(ParamsKey())
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return hac_types.DefaultParams(), nil
+	}
+	params := new(hac_types.Params)
+	err = json.Unmarshal(val, params)
+	if err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// writeParams writes the params set in the block.
+func (s *State) writeParams() error {
+	if s.newParams == nil {
+		return nil
+	}
+	paramsBz, _ := json.Marshal(s.newParams)
+	_, err := s.db.Set(ParamsKey(), paramsBz)
+	if err != nil {
+		return err
+	}
+	s.newParams = nil
+	return nil
+}
+
+// ResolveAmendParams returns the active params and the params tx amends
+// them to.
+func (s *State) ResolveAmendParams(tx *tx.AmendParamsTx) (params, amended *hac_types.Params, err error) {
+	err = tx.ValidateBasic()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tx.Reason) > hac_types.MaxRationaleReasonLen {
+		return nil, nil, fmt.Errorf("%w: reason too long", txtypes.ErrInvalidParamsAmendment)
+	}
+	params, err = s.GetParams()
+	if err != nil {
+		return nil, nil, err
+	}
+	amended = params.Clone()
+	for _, c := range tx.Changes {
+		if err = amended.Set(c.Key, c.Value); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", txtypes.ErrInvalidParamsAmendment, err)
+		}
+	}
+	if err = amended.ValidateBasic(); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", txtypes.ErrInvalidParamsAmendment, err)
+	}
+	if *amended == *params {
+		return nil, nil, fmt.Errorf("%w: params unchanged", txtypes.ErrInvalidParamsAmendment)
+	}
+	return params, amended, nil
+}
+
+// AmendParams applies the validators' vote on a params amendment. On
+// VoteAmendParams the amended params hold from the next tx on; any other
+// outcome keeps the active ones.
+func (s *State) AmendParams(tx *tx.AmendParamsTx, validator uint64, checkOnly bool, code tx.VoteCode) (event *hac_types.EventAmendParams, err error) {
+	if code != txtypes.VoteAmendParams && code != txtypes.VoteRejectAmendment && !code.Undecided() {
+		return nil, ErrTxVoteCodeInvalid
+	}
+	s.logger.Debug("apply amend params", "validator", validator, "height", s.header.Height)
+	a, err := s.GetAccount(validator)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		err = ErrTxValidatorNoexists
+		return
+	}
+	if a.Stake == 0 {
+		err = ErrTxNotMembership
+		return
+	}
+	_, amended, err := s.ResolveAmendParams(tx)
+	if err != nil {
+		return nil, err
+	}
+	if !checkOnly {
+		event = &hac_types.EventAmendParams{
+			Proposer:        a.Index,
+			ProposerAddress: a.Address(),
+			Height:          s.header.Height,
+			Reason:          tx.Reason,
+			VoteCode:        int64(code),
+		}
+		if code == txtypes.VoteAmendParams {
+			s.newParams = amended
+			event.Accepted = true
+			event.Params = amended.Clone()
+		}
+
+		a.Nonce += 1
+		v := s.modifiedAcnts[a.Index]
+		v |= ModifiedFlagMod
+		s.modifiedAcnts[a.Index] = v
+		s.acnts[a.Index] = a.Clone()
+	}
+	return
+}
@@ -0,0 +1,59 @@
+package state
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+func TestAmendParams(t *testing.T) {
+	deposit := []tx.ParamChange{{Key: "proposal_deposit", Value: 7}}
+	tests := []struct {
+		name     string
+		changes  []tx.ParamChange
+		code     tx.VoteCode
+		err      error
+		accepted bool
+	}{
+		{"accepted", deposit, tx.VoteAmendParams, nil, true},
+		{"rejected", deposit, tx.VoteRejectAmendment, nil, false},
+		{"abstained", deposit, tx.VoteAbstain, nil, false},
+		{"no quorum", deposit, tx.VoteNoQuorum, nil, false},
+		{"other code", deposit, tx.VoteAmendManifest, ErrTxVoteCodeInvalid, false},
+		{"no changes", nil, tx.VoteAmendParams, tx.ErrInvalidParamsAmendment, false},
+		{"repeated key", append(deposit, deposit...), tx.VoteAmendParams, tx.ErrInvalidParamsAmendment, false},
+		{"unknown key", []tx.ParamChange{{Key: "deposit", Value: 7}}, tx.VoteAmendParams, tx.ErrInvalidParamsAmendment, false},
+		{"invalid", []tx.ParamChange{{Key: "max_discussion_len", Value: 0}}, tx.VoteAmendParams, tx.ErrInvalidParamsAmendment, false},
+		{"unchanged", []tx.ParamChange{{Key: "max_discussion_len", Value: 4096}}, tx.VoteAmendParams, tx.ErrInvalidParamsAmendment, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, 1000)
+			st := db.NewState()
+			event, err := st.AmendParams(&tx.AmendParamsTx{Changes: tt.changes}, idxs[0], false, tt.code)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if event.Accepted != tt.accepted {
+				t.Fatalf("accepted %v, want %v", event.Accepted, tt.accepted)
+			}
+			commitTestState(t, db, st)
+			params, _, err := db.QueryParams(0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := hac_types.DefaultParams()
+			if tt.accepted {
+				want.ProposalDeposit = 7
+			}
+			if *params != *want {
+				t.Fatalf("params %+v, want %+v", params, want)
+			}
+		})
+	}
+}
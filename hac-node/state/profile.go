@@ -0,0 +1,188 @@
+package state
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// An account keeps its index across key rotations. The address of a key it
+// rotated away from stays indexed to it, so that evidence and vote
+// extensions signed before the rotation still find the account, and the
+// key itself is kept under KeyRetiredKey to verify them. A retired key can
+// not be granted or rotated into again.
+
+// UpdateProfile replaces the profile of the validator's account with the
+// one of tx.
+func (s *State) UpdateProfile(tx *tx.UpdateProfileTx, validator uint64, checkOnly bool) (event *hac_types.EventUpdateProfile, err error) {
+	s.logger.Debug("apply update profile", "validator", validator, "height", s.header.Height)
+	err = tx.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.GetAccount(validator)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		err = ErrTxValidatorNoexists
+		return
+	}
+	if checkOnly {
+		return
+	}
+	a.Name = tx.Name
+	a.AgentUrl = tx.AgentUrl
+	a.SelfIntro = tx.SelfIntro
+	a.Nonce += 1
+	v := s.modifiedAcnts[a.Index]
+	v |= ModifiedFlagMod
+	s.modifiedAcnts[a.Index] = v
+	s.acnts[a.Index] = a.Clone()
+	event = &hac_types.EventUpdateProfile{
+		Validator: a.Index,
+		Address:   a.Address(),
+		Name:      a.Name,
+		AgentUrl:  a.AgentUrl,
+		SelfIntro: a.SelfIntro,
+	}
+	return
+}
+
+// RotateKey replaces the key of the validator's account with tx.Pubkey. The
+// tx is signed by the current key; tx.Pop proves the new one is held. The
+// validator set follows at the end of the block, the old key leaving it.
+func (s *State) RotateKey(tx *tx.RotateKeyTx, validator uint64, checkOnly bool) (event *hac_types.EventRotateKey, err error) {
+	s.logger.Debug("apply rotate key", "validator", validator, "height", s.header.Height)
+	err = tx.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.GetAccount(validator)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		err = ErrTxValidatorNoexists
+		return
+	}
+	pk := ed25519.PubKey(tx.Pubkey)
+	if !pk.VerifySignature(tx.PopData([]byte(s.header.ChainId), a.Index), tx.Pop) {
+		err = ErrTxPopInvalid
+		return
+	}
+	exist, err := s.existPubkey(tx.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		err = ErrAccountAlreadyExists
+		return
+	}
+	if checkOnly {
+		return
+	}
+	oldAddress := a.Address()
+	s.retiredKeys[oldAddress] = a.PubKey
+	a.PubKey = tx.Pubkey
+	a.Nonce += 1
+	v := s.modifiedAcnts[a.Index]
+	v |= ModifiedFlagMod | ModifiedFlagPK
+	s.modifiedAcnts[a.Index] = v
+	s.acnts[a.Index] = a.Clone()
+	s.idxs[a.Address()] = a.Index
+	event = &hac_types.EventRotateKey{
+		Validator:  a.Index,
+		OldAddress: oldAddress,
+		Address:    a.Address(),
+		Pubkey:     hex.EncodeToString(tx.Pubkey),
+	}
+	return
+}
+
+// SetSigners puts the validator's account under the control of the signer
+// set of tx, or back under its own key. Every new signer must prove it holds
+// its key.
+func (s *State) SetSigners(tx *tx.SetSignersTx, validator uint64, checkOnly bool) (event *hac_types.EventSetSigners, err error) {
+	s.logger.Debug("apply set signers", "validator", validator, "threshold", tx.Threshold, "height", s.header.Height)
+	err = tx.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.GetAccount(validator)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		err = ErrTxValidatorNoexists
+		return
+	}
+	popData := tx.PopData([]byte(s.header.ChainId), a.Index)
+	for i, signer := range tx.Signers {
+		if !ed25519.PubKey(signer).VerifySignature(popData, tx.Pops[i]) {
+			err = ErrTxPopInvalid
+			return
+		}
+	}
+	if checkOnly {
+		return
+	}
+	a.Signers = tx.Signers
+	a.Threshold = tx.Threshold
+	a.Nonce += 1
+	v := s.modifiedAcnts[a.Index]
+	v |= ModifiedFlagMod
+	s.modifiedAcnts[a.Index] = v
+	s.acnts[a.Index] = a.Clone()
+	event = &hac_types.EventSetSigners{
+		Validator: a.Index,
+		Address:   a.Address(),
+		Threshold: a.Threshold,
+	}
+	for _, signer := range a.Signers {
+		event.Signers = append(event.Signers, hex.EncodeToString(signer))
+	}
+	return
+}
+
+// accountKey returns the key of address addr the account a is found by,
+// either its current key or one it rotated away from. It returns nil if
+// there is no such key.
+func (s *State) accountKey(a *Account, addr []byte) (ed25519.PubKey, error) {
+	if bytes.Equal(a.AddrBytes(), addr) {
+		return a.PubKey, nil
+	}
+	saddr := cmtcrypto.Address(addr).String()
+	key, ok := s.retiredKeys[saddr]
+	if !ok {
+		var err error
+		key, err = s.db.Get([]byte(fmt.Sprintf(KeyRetiredKey, saddr)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// writeRetiredKeys writes the keys retired in the block.
+func (s *State) writeRetiredKeys() (err error) {
+	addresses := make([]string, 0, len(s.retiredKeys))
+	for address := range s.retiredKeys {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		_, err = s.db.Set([]byte(fmt.Sprintf(KeyRetiredKey, address)), s.retiredKeys[address])
+		if err != nil {
+			return
+		}
+	}
+	s.retiredKeys = make(map[string][]byte)
+	return
+}
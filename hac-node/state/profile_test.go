@@ -0,0 +1,192 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+)
+
+func TestUpdateProfile(t *testing.T) {
+	profile := tx.UpdateProfileTx{Name: "agent", AgentUrl: "https://agent.example", SelfIntro: "hello"}
+	tests := []struct {
+		name    string
+		account bool
+		edit    func(ptx *tx.UpdateProfileTx)
+		err     error
+	}{
+		{"updated", true, nil, nil},
+		{"no self introduction", true, func(ptx *tx.UpdateProfileTx) { ptx.SelfIntro = "" }, nil},
+		{"unknown account", false, nil, ErrAccountNoexists},
+		{"no name", true, func(ptx *tx.UpdateProfileTx) { ptx.Name = "" }, tx.ErrInvalidProfile},
+		{"unprintable name", true, func(ptx *tx.UpdateProfileTx) { ptx.Name = "agent\n" }, tx.ErrInvalidProfile},
+		{"bad agent url", true, func(ptx *tx.UpdateProfileTx) { ptx.AgentUrl = "agent.example" }, tx.ErrInvalidProfile},
+		{"self introduction too long", true, func(ptx *tx.UpdateProfileTx) { ptx.SelfIntro = strings.Repeat("a", tx.MaxSelfIntroLen+1) }, tx.ErrInvalidProfile},
+		{"self introduction not utf8", true, func(ptx *tx.UpdateProfileTx) { ptx.SelfIntro = "\xff" }, tx.ErrInvalidProfile},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, 1000)
+			idx := idxs[0]
+			if !tt.account {
+				idx++
+			}
+			ptx := profile
+			if tt.edit != nil {
+				tt.edit(&ptx)
+			}
+			st := db.NewState()
+			if _, err := st.UpdateProfile(&ptx, idx, true); !errors.Is(err, tt.err) {
+				t.Fatalf("check err %v, want %v", err, tt.err)
+			}
+			event, err := st.UpdateProfile(&ptx, idx, false)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if event.Validator != idx || event.Name != ptx.Name || event.SelfIntro != ptx.SelfIntro {
+				t.Fatalf("event %+v", event)
+			}
+			commitTestState(t, db, st)
+
+			// the profile is stored, and comes back with the account.
+			a, _, err := db.GetAccountByIndex(idx)
+			if err != nil || a == nil {
+				t.Fatalf("account %+v: %v", a, err)
+			}
+			if a.Name != ptx.Name || a.AgentUrl != ptx.AgentUrl || a.SelfIntro != ptx.SelfIntro || a.Nonce != 1 || a.Stake != 1000 {
+				t.Fatalf("account %+v, want profile %+v", a, ptx)
+			}
+			bz, err := a.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded := new(Account)
+			if err := decoded.UnmarshalJSON(bz); err != nil || decoded.SelfIntro != ptx.SelfIntro || decoded.Name != ptx.Name {
+				t.Fatalf("decoded %+v: %v", decoded, err)
+			}
+		})
+	}
+}
+
+// rotateTx returns the rotation of the account idx to key on chainId.
+func rotateTx(key ed25519.PrivKey, chainId string, idx uint64) *tx.RotateKeyTx {
+	rtx := &tx.RotateKeyTx{Pubkey: key.PubKey().Bytes()}
+	rtx.Pop, _ = key.Sign(rtx.PopData([]byte(chainId), idx))
+	return rtx
+}
+
+func TestRotateKey(t *testing.T) {
+	tests := []struct {
+		name string
+		// rtx is the rotation of the account idx from old to a key, other
+		// being the key of another account. The account rotated is the one
+		// past the last if unknown.
+		rtx     func(idx uint64, old, other ed25519.PrivKey) *tx.RotateKeyTx
+		unknown bool
+		err     error
+	}{
+		{"rotated", func(idx uint64, old, other ed25519.PrivKey) *tx.RotateKeyTx {
+			return rotateTx(ed25519.GenPrivKey(), "test", idx)
+		}, false, nil},
+		{"unknown account", func(idx uint64, old, other ed25519.PrivKey) *tx.RotateKeyTx {
+			return rotateTx(ed25519.GenPrivKey(), "test", idx)
+		}, true, ErrAccountNoexists},
+		{"pop for another chain", func(idx uint64, old, other ed25519.PrivKey) *tx.RotateKeyTx {
+			return rotateTx(ed25519.GenPrivKey(), "other", idx)
+		}, false, ErrTxPopInvalid},
+		{"pop for another account", func(idx uint64, old, other ed25519.PrivKey) *tx.RotateKeyTx {
+			return rotateTx(ed25519.GenPrivKey(), "test", idx+1)
+		}, false, ErrTxPopInvalid},
+		{"pop by another key", func(idx uint64, old, other ed25519.PrivKey) *tx.RotateKeyTx {
+			rtx := rotateTx(ed25519.GenPrivKey(), "test", idx)
+			rtx.Pubkey = ed25519.GenPrivKey().PubKey().Bytes()
+			return rtx
+		}, false, ErrTxPopInvalid},
+		{"key of another account", func(idx uint64, old, other ed25519.PrivKey) *tx.RotateKeyTx {
+			return rotateTx(other, "test", idx)
+		}, false, ErrAccountAlreadyExists},
+		{"own key", func(idx uint64, old, other ed25519.PrivKey) *tx.RotateKeyTx {
+			return rotateTx(old, "test", idx)
+		}, false, ErrAccountAlreadyExists},
+		{"short key", func(idx uint64, old, other ed25519.PrivKey) *tx.RotateKeyTx {
+			rtx := rotateTx(ed25519.GenPrivKey(), "test", idx)
+			rtx.Pubkey = rtx.Pubkey[1:]
+			return rtx
+		}, false, tx.ErrInvalidTx},
+		{"no pop", func(idx uint64, old, other ed25519.PrivKey) *tx.RotateKeyTx {
+			rtx := rotateTx(ed25519.GenPrivKey(), "test", idx)
+			rtx.Pop = nil
+			return rtx
+		}, false, tx.ErrInvalidTx},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, _ := newTestDB(t, nil)
+			old, other := ed25519.GenPrivKey(), ed25519.GenPrivKey()
+			st := db.NewState()
+			accounts := make([]*Account, 2)
+			for i, key := range []ed25519.PrivKey{old, other} {
+				accounts[i] = &Account{Stake: 1000}
+				accounts[i].SetPubKey(key.PubKey().Bytes())
+				if err := st.AddAccount(accounts[i]); err != nil {
+					t.Fatal(err)
+				}
+			}
+			commitTestState(t, db, st)
+			idx := accounts[0].Index
+			if tt.unknown {
+				idx = accounts[1].Index + 1
+			}
+
+			rtx := tt.rtx(idx, old, other)
+			st = db.NewState()
+			if _, err := st.RotateKey(rtx, idx, true); !errors.Is(err, tt.err) {
+				t.Fatalf("check err %v, want %v", err, tt.err)
+			}
+			event, err := st.RotateKey(rtx, idx, false)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			newAddr := ed25519.PubKey(rtx.Pubkey).Address()
+			if event.Validator != idx || event.OldAddress != old.PubKey().Address().String() || event.Address != newAddr.String() {
+				t.Fatalf("event %+v", event)
+			}
+			commitTestState(t, db, st)
+
+			// the account is found by both keys, no longer signs with the old
+			// one, and the old one is retired for good.
+			st = db.NewState()
+			for _, addr := range [][]byte{old.PubKey().Address(), newAddr} {
+				a, err := st.FindAccount(addr)
+				if err != nil || a == nil || a.Index != idx {
+					t.Fatalf("account of %X %+v: %v", addr, a, err)
+				}
+			}
+			a := testAccount(t, st, idx)
+			if !bytes.Equal(a.PubKey, rtx.Pubkey) || a.Nonce != 1 {
+				t.Fatalf("account %+v", a)
+			}
+			retired, err := st.accountKey(a, old.PubKey().Address())
+			if err != nil || !retired.Equals(old.PubKey()) {
+				t.Fatalf("retired key %X: %v", retired, err)
+			}
+			btx := &tx.HACTx{Version: tx.HACTxVersion2, Type: tx.HACTxTypeUpdateProfile, Validator: idx, Nonce: a.Nonce, Tx: &tx.UpdateProfileTx{Name: "agent", AgentUrl: "https://agent.example"}}
+			btx.Sig = [][]byte{sign(t, old, btx)}
+			if _, err := st.Verify(btx, false); !errors.Is(err, ErrTxSigInvalid) {
+				t.Fatalf("old key verify err %v, want %v", err, ErrTxSigInvalid)
+			}
+			if _, err := st.RotateKey(rotateTx(old, "test", idx), idx, true); !errors.Is(err, ErrAccountAlreadyExists) {
+				t.Fatalf("rotate back err %v, want %v", err, ErrAccountAlreadyExists)
+			}
+		})
+	}
+}
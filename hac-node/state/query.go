@@ -0,0 +1,192 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/iavl"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// ProofOpIAVL is the type of the proof ops returned with queried state: an
+// ics23 commitment proof of the key against the state tree root hash, which
+// the app hash is the keccak256 of.
+const ProofOpIAVL = "ics23:iavl"
+
+var ErrQueryHeightInvalid = errors.New("query height not available")
+
+func ProposalKey(idx uint64) []byte {
+	return []byte(fmt.Sprintf(KeyProposalBody, idx))
+}
+
+func DiscussionKey(idx uint64) []byte {
+	return []byte(fmt.Sprintf(KeyDiscussionBody, idx))
+}
+
+func RationaleKey(proposal uint64) []byte {
+	return []byte(fmt.Sprintf(KeyRationaleBody, proposal))
+}
+
+func ManifestKey() []byte {
+	return []byte(KeyManifest)
+}
+
+func ManifestVersionKey(version uint64) []byte {
+	return []byte(fmt.Sprintf(KeyManifestVersion, version))
+}
+
+func CommunityPoolKey() []byte {
+	return []byte(KeyCommunityPool)
+}
+
+func IssuedKey() []byte {
+	return []byte(KeyIssued)
+}
+
+func ParamsKey() []byte {
+	return []byte(KeyParams)
+}
+
+func ApplicationKey(address string) []byte {
+	return []byte(fmt.Sprintf(KeyApplication, address))
+}
+
+// immutableAt returns the state tree committed at height, the latest if
+// height is 0.
+func (db *StateDB) immutableAt(height uint64) (tree *iavl.ImmutableTree, queryHeight uint64, err error) {
+	db.mtx.RLock()
+	latestHeight := db.state.header.Height
+	latestVersion := db.db.Version()
+	db.mtx.RUnlock()
+	if height > latestHeight {
+		return nil, 0, ErrQueryHeightInvalid
+	}
+	if height == 0 {
+		height = latestHeight
+	}
+	// each block saves one version, so the distance between both is fixed
+	// whatever height the chain started at.
+	version := latestVersion - int64(latestHeight-height)
+	if version <= 0 || !db.db.VersionExists(version) {
+		return nil, 0, ErrQueryHeightInvalid
+	}
+	tree, err = db.db.GetImmutable(version)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tree, height, nil
+}
+
+// Query reads key from the state committed at height, the latest if height
+// is 0. With prove set it also returns the encoded ics23 proof of the key,
+// of membership or of absence, against the state root hash at that height.
+func (db *StateDB) Query(key []byte, height uint64, prove bool) (value []byte, queryHeight uint64, proof []byte, err error) {
+	tree, queryHeight, err := db.immutableAt(height)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	value, err = tree.Get(key)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if prove {
+		p, err := tree.GetProof(key)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		proof, err = p.Marshal()
+		if err != nil {
+			return nil, 0, nil, err
+		}
+	}
+	return value, queryHeight, proof, nil
+}
+
+// QueryProposalDiscussions reads the discussions of a proposal from the
+// state committed at height, the latest if height is 0. Each of them can be
+// proven on its own through Query.
+func (db *StateDB) QueryProposalDiscussions(proposal uint64, height uint64) (discussions []*hac_types.Discussion, queryHeight uint64, err error) {
+	tree, queryHeight, err := db.immutableAt(height)
+	if err != nil {
+		return nil, 0, err
+	}
+	idxs, err := proposalDiscussionIdxs(tree.Iterator, proposal)
+	if err != nil {
+		return nil, 0, err
+	}
+	discussions = make([]*hac_types.Discussion, 0, len(idxs))
+	for _, idx := range idxs {
+		val, err := tree.Get(DiscussionKey(idx))
+		if err != nil {
+			return nil, 0, err
+		}
+		dis := &hac_types.Discussion{}
+		if err = json.Unmarshal(val, dis); err != nil {
+			return nil, 0, err
+		}
+		discussions = append(discussions, dis)
+	}
+	return discussions, queryHeight, nil
+}
+
+// QueryManifestHistory reads every version of the manifest from the state
+// committed at height, the latest if height is 0, oldest first. Each of
+// them can be proven on its own through Query.
+func (db *StateDB) QueryManifestHistory(height uint64) (history []*hac_types.Manifest, queryHeight uint64, err error) {
+	tree, queryHeight, err := db.immutableAt(height)
+	if err != nil {
+		return nil, 0, err
+	}
+	active, err := getManifest(tree.Get, ManifestKey())
+	if err != nil {
+		return nil, 0, err
+	}
+	history, err = manifestHistory(tree.Get, active.Version)
+	if err != nil {
+		return nil, 0, err
+	}
+	return history, queryHeight, nil
+}
+
+// QueryUnbondings reads the unbondings of an account from the state
+// committed at height, the latest if height is 0.
+func (db *StateDB) QueryUnbondings(account uint64, height uint64) (unbondings []hac_types.Unbonding, queryHeight uint64, err error) {
+	tree, queryHeight, err := db.immutableAt(height)
+	if err != nil {
+		return nil, 0, err
+	}
+	unbondings, err = accountUnbondings(tree.Iterator, account)
+	if err != nil {
+		return nil, 0, err
+	}
+	return unbondings, queryHeight, nil
+}
+
+// QuerySessions reads the session keys of an account from the state
+// committed at height, the latest if height is 0.
+func (db *StateDB) QuerySessions(account uint64, height uint64) (sessions []*hac_types.Session, queryHeight uint64, err error) {
+	tree, queryHeight, err := db.immutableAt(height)
+	if err != nil {
+		return nil, 0, err
+	}
+	sessions, err = accountSessions(tree.Iterator, account)
+	if err != nil {
+		return nil, 0, err
+	}
+	return sessions, queryHeight, nil
+}
+
+// QueryParams reads the params from the state committed at height, the
+// latest if height is 0.
+func (db *StateDB) QueryParams(height uint64) (params *hac_types.Params, queryHeight uint64, err error) {
+	tree, queryHeight, err := db.immutableAt(height)
+	if err != nil {
+		return nil, 0, err
+	}
+	params, err = getParams(tree.Get)
+	if err != nil {
+		return nil, 0, err
+	}
+	return params, queryHeight, nil
+}
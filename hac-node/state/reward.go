@@ -0,0 +1,115 @@
+package state
+
+import (
+	"math/big"
+
+	abci_types "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/hetu-project/hetu-chaoschain/config"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// Members are rewarded with stake issued by the chain, on the schedule of
+// config.BlockReward: each block for signing the commit of the block
+// before, for their proposals settled as accepted, and for their first
+// discussions on a proposal. Only members with stake are rewarded, so that
+// a slashed or retiring account gains nothing. The rewards of a block are
+// recorded as EventReward, and their sum in the issued stake.
+
+// Issued returns the stake issued as rewards so far.
+func (s *State) Issued() (uint64, error) {
+	val, err := s.db.Get([]byte(KeyIssued))
+	if err != nil {
+		return 0, err
+	}
+	return new(big.Int).SetBytes(val).Uint64() + s.issued, nil
+}
+
+// reward adds amount to the stake of a for kind. The caller marks a
+// modified.
+func (s *State) reward(a *Account, kind string, proposal uint64, amount uint64) {
+	if amount == 0 || a.Stake == 0 {
+		return
+	}
+	a.Stake += amount
+	s.issued += amount
+	s.rewards = append(s.rewards, &hac_types.EventReward{
+		Account:  a.Index,
+		Address:  a.Address(),
+		Kind:     kind,
+		Proposal: proposal,
+		Amount:   amount,
+		Stake:    a.Stake,
+		Height:   s.header.Height,
+	})
+}
+
+// RewardCommit shares the block reward among the validators who signed the
+// last commit, by their power. What the division leaves is not issued.
+func (s *State) RewardCommit(votes []abci_types.VoteInfo) error {
+	amount := config.BlockReward(s.header.Height)
+	if amount == 0 {
+		return nil
+	}
+	signers := make([]*Account, 0, len(votes))
+	powers := make([]int64, 0, len(votes))
+	var total int64
+	for _, vote := range votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || vote.Validator.Power <= 0 {
+			continue
+		}
+		a, err := s.FindAccount(vote.Validator.Address)
+		if err != nil {
+			return err
+		}
+		if a == nil || a.Stake == 0 {
+			continue
+		}
+		signers = append(signers, a)
+		powers = append(powers, vote.Validator.Power)
+		total += vote.Validator.Power
+	}
+	for i, a := range signers {
+		share := new(big.Int).SetUint64(amount)
+		share.Mul(share, big.NewInt(powers[i]))
+		share.Quo(share, big.NewInt(total))
+		s.reward(a, hac_types.RewardCommit, 0, share.Uint64())
+		v := s.modifiedAcnts[a.Index]
+		v |= ModifiedFlagMod
+		s.modifiedAcnts[a.Index] = v
+		s.acnts[a.Index] = a.Clone()
+	}
+	return nil
+}
+
+// Rewards returns the rewards paid in the block so far, with the stake of
+// their accounts as it is now, since the txs of the block after a reward
+// may change it.
+func (s *State) Rewards() ([]*hac_types.EventReward, error) {
+	for _, event := range s.rewards {
+		a, err := s.GetAccount(event.Account)
+		if err != nil {
+			return nil, err
+		}
+		event.Stake = a.Stake
+	}
+	return s.rewards, nil
+}
+
+// writeIssued adds the rewards issued in the block to the issued stake.
+func (s *State) writeIssued() error {
+	s.rewards = nil
+	if s.issued == 0 {
+		return nil
+	}
+	issued, err := s.Issued()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Set([]byte(KeyIssued), new(big.Int).SetUint64(issued).Bytes())
+	if err != nil {
+		return err
+	}
+	s.issued = 0
+	return nil
+}
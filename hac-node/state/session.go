@@ -0,0 +1,318 @@
+package state
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	dbm "github.com/cosmos/iavl/db"
+	"github.com/hetu-project/hetu-chaoschain/config"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	txtypes "github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// The session keys of an account are kept under KeySession, by account and
+// session address. A session key signs on its own, in place of the signers
+// of the account, and only the delegable txs of its scope. It is live until
+// ExpireHeight; an expired one is dropped when the account registers
+// another.
+
+// GetSessions returns the session keys of the account, expired ones
+// included, by address.
+func (s *State) GetSessions(account uint64) (sessions []*hac_types.Session, err error) {
+	stored, err := accountSessions(s.db.Iterator, account)
+	if err != nil {
+		return nil, err
+	}
+	prefix := fmt.Sprintf(KeySession, account, "")
+	byKey := make(map[string]*hac_types.Session, len(stored))
+	for _, session := range stored {
+		byKey[sessionKey(account, session.Address)] = session
+	}
+	// the sessions of this block are not written yet.
+	for key, session := range s.modSessions {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if session == nil {
+			delete(byKey, key)
+		} else {
+			session := *session
+			byKey[key] = &session
+		}
+	}
+	for _, session := range byKey {
+		sessions = append(sessions, session)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Address < sessions[j].Address
+	})
+	return sessions, nil
+}
+
+// accountSessions reads the session keys of an account off the tree.
+func accountSessions(iterator func(start, end []byte, ascending bool) (dbm.Iterator, error), account uint64) (sessions []*hac_types.Session, err error) {
+	start := []byte(fmt.Sprintf(KeySession, account, ""))
+	end := []byte(fmt.Sprintf(KeySession, account+1, ""))
+	it, err := iterator(start, end, true)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		session := new(hac_types.Session)
+		err = json.Unmarshal(it.Value(), session)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, it.Error()
+}
+
+// sessionKey returns the state key of the session of address for account.
+func sessionKey(account uint64, address string) string {
+	return fmt.Sprintf(KeySession, account, address)
+}
+
+// sessionLive reports whether the session may still sign at the height of
+// the state.
+func (s *State) sessionLive(session *hac_types.Session) bool {
+	return s.header.Height < session.ExpireHeight
+}
+
+// sessionSpent reports whether the session signed its rate cap of txs in
+// the current window.
+func (s *State) sessionSpent(session *hac_types.Session) bool {
+	if session.RateCap == 0 {
+		return false
+	}
+	window := config.SessionRateWindow(s.header.Height)
+	return session.WindowStart == s.header.Height-s.header.Height%window && session.Used >= session.RateCap
+}
+
+// txSession returns the session key of the account that signed a tx of
+// type txType over dat, nil if none did or may. A session key that used up
+// its rate cap fails with ErrTxSessionRateCap.
+func (s *State) txSession(a *Account, txType tx.HACTxType, dat []byte, sigs [][]byte) (*hac_types.Session, error) {
+	if len(sigs) != 1 || !txType.Delegable() {
+		return nil, nil
+	}
+	sessions, err := s.GetSessions(a.Index)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if !s.sessionLive(session) || !session.Permits(int(txType)) {
+			continue
+		}
+		if ed25519.PubKey(session.Pubkey).VerifySignature(dat, sigs[0]) {
+			if s.sessionSpent(session) {
+				return nil, ErrTxSessionRateCap
+			}
+			return session, nil
+		}
+	}
+	return nil, nil
+}
+
+// UseSession counts btx against the rate cap of the session key that signed
+// it, if it is not signed by the signers of the account. The tx is verified
+// again on the state it is applied to, so that a session revoked or spent
+// earlier in the block no longer signs.
+func (s *State) UseSession(btx *tx.HACTx) error {
+	if _, ok := btx.Tx.(*tx.ApplyTx); ok {
+		return nil
+	}
+	a, err := s.GetAccount(btx.Validator)
+	if err != nil {
+		return err
+	}
+	if a == nil {
+		return ErrTxValidatorNoexists
+	}
+	dat, err := btx.SigData([]byte(s.header.ChainId))
+	if err != nil {
+		return err
+	}
+	if a.Verify(dat, btx.Sig) {
+		return nil
+	}
+	session, err := s.txSession(a, btx.Type, dat, btx.Sig)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return ErrTxSigInvalid
+	}
+	if session.RateCap == 0 {
+		return nil
+	}
+	windowStart := s.header.Height - s.header.Height%config.SessionRateWindow(s.header.Height)
+	if session.WindowStart != windowStart {
+		session.WindowStart = windowStart
+		session.Used = 0
+	}
+	session.Used += 1
+	s.modSessions[sessionKey(a.Index, session.Address)] = session
+	return nil
+}
+
+// Session registers the session key of tx for the validator's account, or
+// renews it with the scope, expiry and rate cap of tx. The key must prove it
+// is held and must not be the key of an account.
+func (s *State) Session(tx *tx.SessionTx, validator uint64, checkOnly bool) (event *hac_types.EventSession, err error) {
+	s.logger.Debug("apply session", "validator", validator, "height", s.header.Height)
+	err = tx.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.GetAccount(validator)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		err = ErrTxValidatorNoexists
+		return
+	}
+	if tx.ExpireHeight <= s.header.Height {
+		err = ErrTxSessionExpireHeight
+		return
+	}
+	pk := ed25519.PubKey(tx.Pubkey)
+	if !pk.VerifySignature(tx.PopData([]byte(s.header.ChainId), a.Index), tx.Pop) {
+		err = ErrTxPopInvalid
+		return
+	}
+	exist, err := s.existPubkey(tx.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		err = ErrAccountAlreadyExists
+		return
+	}
+	address := pk.Address().String()
+	sessions, err := s.GetSessions(a.Index)
+	if err != nil {
+		return nil, err
+	}
+	live := 0
+	for _, session := range sessions {
+		if session.Address != address && s.sessionLive(session) {
+			live++
+		}
+	}
+	if live >= txtypes.MaxSessions {
+		err = ErrTooManySessions
+		return
+	}
+	if checkOnly {
+		return
+	}
+	for _, session := range sessions {
+		if !s.sessionLive(session) {
+			s.modSessions[sessionKey(a.Index, session.Address)] = nil
+		}
+	}
+	session := &hac_types.Session{
+		Account:      a.Index,
+		Address:      address,
+		Pubkey:       tx.Pubkey,
+		ExpireHeight: tx.ExpireHeight,
+		RateCap:      tx.RateCap,
+		Height:       s.header.Height,
+	}
+	for _, t := range tx.Scope {
+		session.Scope = append(session.Scope, int(t))
+	}
+	s.modSessions[sessionKey(a.Index, address)] = session
+	a.Nonce += 1
+	v := s.modifiedAcnts[a.Index]
+	v |= ModifiedFlagMod
+	s.modifiedAcnts[a.Index] = v
+	s.acnts[a.Index] = a.Clone()
+	event = &hac_types.EventSession{
+		Validator:    a.Index,
+		Address:      address,
+		Pubkey:       hex.EncodeToString(tx.Pubkey),
+		Scope:        session.Scope,
+		ExpireHeight: session.ExpireHeight,
+		RateCap:      session.RateCap,
+	}
+	return
+}
+
+// RevokeSession removes the session key of tx.Address from the validator's
+// account.
+func (s *State) RevokeSession(tx *tx.RevokeSessionTx, validator uint64, checkOnly bool) (event *hac_types.EventRevokeSession, err error) {
+	s.logger.Debug("apply revoke session", "validator", validator, "address", tx.Address, "height", s.header.Height)
+	a, err := s.GetAccount(validator)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		err = ErrTxValidatorNoexists
+		return
+	}
+	sessions, err := s.GetSessions(a.Index)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, session := range sessions {
+		if session.Address == tx.Address {
+			found = true
+			break
+		}
+	}
+	if !found {
+		err = ErrTxSessionNoexists
+		return
+	}
+	if checkOnly {
+		return
+	}
+	s.modSessions[sessionKey(a.Index, tx.Address)] = nil
+	a.Nonce += 1
+	v := s.modifiedAcnts[a.Index]
+	v |= ModifiedFlagMod
+	s.modifiedAcnts[a.Index] = v
+	s.acnts[a.Index] = a.Clone()
+	event = &hac_types.EventRevokeSession{
+		Validator: a.Index,
+		Address:   tx.Address,
+	}
+	return
+}
+
+// writeSessions writes the sessions registered, used and removed in the
+// block.
+func (s *State) writeSessions() (err error) {
+	keys := make([]string, 0, len(s.modSessions))
+	for key := range s.modSessions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		session := s.modSessions[key]
+		if session == nil {
+			_, _, err = s.db.Remove([]byte(key))
+			if err != nil {
+				return
+			}
+			continue
+		}
+		sessionBz, _ := json.Marshal(session)
+		_, err = s.db.Set([]byte(key), sessionBz)
+		if err != nil {
+			return
+		}
+	}
+	s.modSessions = make(map[string]*hac_types.Session)
+	return
+}
@@ -0,0 +1,163 @@
+package state
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+)
+
+// sessionTx returns a tx of txType for the account idx signed by key.
+func sessionTx(t *testing.T, key ed25519.PrivKey, idx uint64, txType tx.HACTxType) *tx.HACTx {
+	t.Helper()
+	btx := &tx.HACTx{Version: tx.HACTxVersion2, Type: txType, Validator: idx}
+	switch txType {
+	case tx.HACTxTypeDiscussion:
+		btx.Tx = &tx.DiscussionTx{Proposal: 1, Data: []byte("data")}
+	case tx.HACTxTypeProposal:
+		btx.Tx = &tx.ProposalTx{Title: "title", EndHeight: 100}
+	case tx.HACTxTypeRevokeSession:
+		btx.Tx = &tx.RevokeSessionTx{Address: "address"}
+	case tx.HACTxTypeGrant:
+		btx.Tx = &tx.GrantTx{Grants: []tx.GrantSt{{Amount: 1, Name: "member"}}}
+	case tx.HACTxTypeDisqualify:
+		btx.Tx = &tx.DisqualifyTx{Target: idx, Clause: "clause", Evidence: "evidence"}
+	case tx.HACTxTypeAmendManifest:
+		btx.Tx = &tx.AmendManifestTx{Text: "manifest", Reason: "reason"}
+	case tx.HACTxTypeAmendParams:
+		btx.Tx = &tx.AmendParamsTx{Changes: []tx.ParamChange{{Key: "key", Value: 1}}, Reason: "reason"}
+	case tx.HACTxTypeUpdateProfile:
+		btx.Tx = &tx.UpdateProfileTx{Name: "name", AgentUrl: "http://127.0.0.1:3000"}
+	}
+	dat, err := btx.SigData([]byte("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := key.Sign(dat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	btx.Sig = [][]byte{sig}
+	return btx
+}
+
+func TestSessionScopeAndExpiry(t *testing.T) {
+	const lifetime = 3
+	tests := []struct {
+		name   string
+		txType tx.HACTxType
+		// blocks are committed after the session is registered, before
+		// the tx is used.
+		blocks  int
+		rateCap uint64
+		uses    int
+		revoke  bool
+		err     error
+	}{
+		{"in scope", tx.HACTxTypeDiscussion, 0, 0, 1, false, nil},
+		{"last live block", tx.HACTxTypeDiscussion, lifetime - 2, 0, 1, false, nil},
+		{"expired", tx.HACTxTypeDiscussion, lifetime - 1, 0, 1, false, ErrTxSigInvalid},
+		{"delegable out of scope", tx.HACTxTypeProposal, 0, 0, 1, false, ErrTxSigInvalid},
+		{"not delegable", tx.HACTxTypeRevokeSession, 0, 0, 1, false, ErrTxSigInvalid},
+		{"revoked", tx.HACTxTypeDiscussion, 0, 0, 1, true, ErrTxSigInvalid},
+		{"within rate cap", tx.HACTxTypeDiscussion, 0, 2, 2, false, nil},
+		{"over rate cap", tx.HACTxTypeDiscussion, 0, 2, 3, false, ErrTxSessionRateCap},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, 1000)
+			key := ed25519.GenPrivKey()
+			st := db.NewState()
+			stx := &tx.SessionTx{
+				Pubkey:       key.PubKey().Bytes(),
+				Scope:        []tx.HACTxType{tx.HACTxTypeDiscussion},
+				ExpireHeight: st.Header().Height + lifetime,
+				RateCap:      tt.rateCap,
+			}
+			stx.Pop, _ = key.Sign(stx.PopData([]byte("test"), idxs[0]))
+			if _, err := st.Session(stx, idxs[0], false); err != nil {
+				t.Fatal(err)
+			}
+			commitTestState(t, db, st)
+			for i := 0; i < tt.blocks; i++ {
+				commitTestState(t, db, db.NewState())
+			}
+
+			st = db.NewState()
+			if tt.revoke {
+				rtx := &tx.RevokeSessionTx{Address: key.PubKey().Address().String()}
+				if _, err := st.RevokeSession(rtx, idxs[0], false); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var err error
+			for i := 0; i < tt.uses && err == nil; i++ {
+				err = st.UseSession(sessionTx(t, key, idxs[0], tt.txType))
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	t.Run("expire height passed", func(t *testing.T) {
+		db, idxs := newTestDB(t, nil, 1000)
+		key := ed25519.GenPrivKey()
+		st := db.NewState()
+		stx := &tx.SessionTx{
+			Pubkey:       key.PubKey().Bytes(),
+			Scope:        []tx.HACTxType{tx.HACTxTypeDiscussion},
+			ExpireHeight: st.Header().Height,
+		}
+		stx.Pop, _ = key.Sign(stx.PopData([]byte("test"), idxs[0]))
+		if _, err := st.Session(stx, idxs[0], true); !errors.Is(err, ErrTxSessionExpireHeight) {
+			t.Fatalf("err %v, want %v", err, ErrTxSessionExpireHeight)
+		}
+	})
+}
+
+func TestSessionGovernanceNotDelegable(t *testing.T) {
+	tests := []struct {
+		name   string
+		txType tx.HACTxType
+	}{
+		{"grant", tx.HACTxTypeGrant},
+		{"disqualify", tx.HACTxTypeDisqualify},
+		{"amend manifest", tx.HACTxTypeAmendManifest},
+		{"amend params", tx.HACTxTypeAmendParams},
+		{"update profile", tx.HACTxTypeUpdateProfile},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, 1000)
+			key := ed25519.GenPrivKey()
+			st := db.NewState()
+			stx := &tx.SessionTx{
+				Pubkey:       key.PubKey().Bytes(),
+				Scope:        []tx.HACTxType{tt.txType},
+				ExpireHeight: st.Header().Height + 10,
+			}
+			stx.Pop, _ = key.Sign(stx.PopData([]byte("test"), idxs[0]))
+			// the type can not be put in the scope of a session key ...
+			if _, err := st.Session(stx, idxs[0], false); !errors.Is(err, tx.ErrInvalidSession) {
+				t.Fatalf("session err %v, want %v", err, tx.ErrInvalidSession)
+			}
+			stx.Scope = []tx.HACTxType{tx.HACTxTypeDiscussion, tx.HACTxTypeProposal, tx.HACTxTypeSettleProposal}
+			if _, err := st.Session(stx, idxs[0], false); err != nil {
+				t.Fatal(err)
+			}
+			commitTestState(t, db, st)
+
+			// ... so a tx of the type signed by a session key is rejected.
+			st = db.NewState()
+			btx := sessionTx(t, key, idxs[0], tt.txType)
+			if err := st.UseSession(btx); !errors.Is(err, ErrTxSigInvalid) {
+				t.Fatalf("use err %v, want %v", err, ErrTxSigInvalid)
+			}
+			if _, err := st.Verify(btx, false); err == nil {
+				t.Fatal("verified a tx signed by a session key")
+			}
+		})
+	}
+}
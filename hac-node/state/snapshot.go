@@ -0,0 +1,371 @@
+package state
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/iavl"
+	dbm "github.com/cosmos/iavl/db"
+)
+
+// SnapshotFormat is the format of the state snapshots: the nodes of the
+// state tree exported at a version, zlib compressed and cut in chunks.
+const SnapshotFormat uint32 = 1
+
+// snapshotChunkSize is well below the 16MB chunk limit of the statesync
+// reactor.
+const snapshotChunkSize = 4 << 20
+
+// maxSnapshotItemSize bounds the keys and values read from a snapshot, no
+// state entry comes close to it.
+const maxSnapshotItemSize = 16 << 20
+
+var (
+	ErrSnapshotInvalid     = errors.New("snapshot invalid")
+	ErrSnapshotChunkHash   = errors.New("snapshot chunk hash mismatch")
+	ErrSnapshotStateExists = errors.New("state not empty, can not restore snapshot")
+)
+
+// Snapshot describes a snapshot of the state tree taken after the block at
+// Height was committed. It is served as the metadata of the ABCI snapshot.
+type Snapshot struct {
+	Height  uint64 `json:"height"`
+	Format  uint32 `json:"format"`
+	Version int64  `json:"version"`
+	// Hash is the sha256 of the chunk hashes, in order.
+	Hash        []byte   `json:"hash"`
+	ChunkHashes [][]byte `json:"chunk_hashes"`
+}
+
+func snapshotHash(chunkHashes [][]byte) []byte {
+	h := sha256.New()
+	for _, ch := range chunkHashes {
+		h.Write(ch)
+	}
+	return h.Sum(nil)
+}
+
+// Verify checks that the snapshot hash covers its chunk hashes.
+func (s *Snapshot) Verify() error {
+	if s.Format != SnapshotFormat || s.Version <= 0 || len(s.ChunkHashes) == 0 {
+		return ErrSnapshotInvalid
+	}
+	if !bytes.Equal(s.Hash, snapshotHash(s.ChunkHashes)) {
+		return ErrSnapshotInvalid
+	}
+	return nil
+}
+
+// VerifyChunk checks a chunk received from a peer against its hash.
+func (s *Snapshot) VerifyChunk(idx uint32, chunk []byte) error {
+	if int(idx) >= len(s.ChunkHashes) {
+		return ErrSnapshotInvalid
+	}
+	h := sha256.Sum256(chunk)
+	if !bytes.Equal(h[:], s.ChunkHashes[idx]) {
+		return ErrSnapshotChunkHash
+	}
+	return nil
+}
+
+// Version returns the version of the state tree last saved.
+func (db *StateDB) Version() int64 {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+	return db.db.Version()
+}
+
+// ExportSnapshot exports the state tree at version, the state after the
+// block at height, and cuts it in chunks. Saved versions are never changed,
+// so it can run alongside the blocks being committed.
+func (db *StateDB) ExportSnapshot(height uint64, version int64) (snapshot *Snapshot, chunks [][]byte, err error) {
+	db.mtx.RLock()
+	tree, err := db.db.GetImmutable(version)
+	db.mtx.RUnlock()
+	if err != nil {
+		return nil, nil, err
+	}
+	exporter, err := tree.Export()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer exporter.Close()
+
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	w := bufio.NewWriter(zw)
+	for {
+		node, err := exporter.Next()
+		if err == iavl.ErrorExportDone {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = writeExportNode(w, node); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return nil, nil, err
+	}
+	if err = zw.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	snapshot = &Snapshot{
+		Height:  height,
+		Format:  SnapshotFormat,
+		Version: version,
+	}
+	dat := buf.Bytes()
+	for len(dat) > 0 {
+		n := min(len(dat), snapshotChunkSize)
+		chunk := dat[:n:n]
+		dat = dat[n:]
+		h := sha256.Sum256(chunk)
+		chunks = append(chunks, chunk)
+		snapshot.ChunkHashes = append(snapshot.ChunkHashes, h[:])
+	}
+	snapshot.Hash = snapshotHash(snapshot.ChunkHashes)
+	return
+}
+
+// RestoreSnapshot imports the state tree of a snapshot into the empty state
+// db and loads the state from it. It returns the restored state hash, which
+// the caller checks against the app hash of the snapshot height.
+func (db *StateDB) RestoreSnapshot(snapshot *Snapshot, chunks [][]byte) (hash []byte, err error) {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+	if !db.db.IsEmpty() || db.db.Version() != 0 {
+		return nil, ErrSnapshotStateExists
+	}
+	zr, err := zlib.NewReader(io.MultiReader(bytesReaders(chunks)...))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	r := bufio.NewReader(zr)
+
+	importer, err := db.db.Import(snapshot.Version)
+	if err != nil {
+		return nil, err
+	}
+	defer importer.Close()
+	for {
+		node, err := readExportNode(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err = importer.Add(node); err != nil {
+			return nil, err
+		}
+	}
+	if err = importer.Commit(); err != nil {
+		return nil, err
+	}
+
+	st := newState(db.db, db.logger)
+	st.dbVer = snapshot.Version
+	if err = st.load(); err != nil {
+		return nil, err
+	}
+	if st.header.Height != snapshot.Height {
+		return nil, fmt.Errorf("%w: state height %d, snapshot height %d", ErrSnapshotInvalid, st.header.Height, snapshot.Height)
+	}
+	db.state = st
+	db.logger.Info("restore snapshot success", "height", snapshot.Height, "version", snapshot.Version)
+	return st.header.Hash, nil
+}
+
+func bytesReaders(chunks [][]byte) []io.Reader {
+	rs := make([]io.Reader, len(chunks))
+	for i, chunk := range chunks {
+		rs[i] = bytes.NewReader(chunk)
+	}
+	return rs
+}
+
+// writeExportNode encodes a tree node as its height, version, key and,
+// for leaves, value.
+func writeExportNode(w io.Writer, node *iavl.ExportNode) error {
+	buf := make([]byte, 1, 1+3*binary.MaxVarintLen64)
+	buf[0] = byte(node.Height)
+	buf = binary.AppendVarint(buf, node.Version)
+	buf = binary.AppendUvarint(buf, uint64(len(node.Key)))
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+	if _, err := w.Write(node.Key); err != nil {
+		return err
+	}
+	if node.Height != 0 {
+		return nil
+	}
+	buf = binary.AppendUvarint(buf[:0], uint64(len(node.Value)))
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+	_, err := w.Write(node.Value)
+	return err
+}
+
+func readExportNode(r *bufio.Reader) (node *iavl.ExportNode, err error) {
+	height, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	node = &iavl.ExportNode{Height: int8(height)}
+	if node.Version, err = binary.ReadVarint(r); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if node.Key, err = readBytes(r); err != nil {
+		return nil, err
+	}
+	if node.Height == 0 {
+		if node.Value, err = readBytes(r); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+func readBytes(r *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if n > maxSnapshotItemSize {
+		return nil, ErrSnapshotInvalid
+	}
+	dat := make([]byte, n)
+	if _, err = io.ReadFull(r, dat); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return dat, nil
+}
+
+// SnapshotDB is a local store of the snapshots this node serves to peers
+// state syncing, kept next to the state db.
+type SnapshotDB struct {
+	logger cmtlog.Logger
+	db     dbm.DB
+}
+
+func NewSnapshotDB(dir string, logger cmtlog.Logger) (db *SnapshotDB, err error) {
+	logger = logger.With("module", "snapshotdb")
+	ldb, err := dbm.NewDB("snapshot", "goleveldb", dir)
+	if err != nil {
+		return nil, err
+	}
+	db = &SnapshotDB{
+		logger: logger,
+		db:     ldb,
+	}
+	return
+}
+
+func (db *SnapshotDB) Close() (err error) {
+	err = db.db.Close()
+	return
+}
+
+const (
+	prefixSnapshot      = 's'
+	prefixSnapshotChunk = 'c'
+)
+
+func snapshotKey(height uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = prefixSnapshot
+	binary.BigEndian.PutUint64(key[1:], height)
+	return key
+}
+
+func snapshotChunkKey(height uint64, idx uint32) []byte {
+	key := make([]byte, 13)
+	key[0] = prefixSnapshotChunk
+	binary.BigEndian.PutUint64(key[1:], height)
+	binary.BigEndian.PutUint32(key[9:], idx)
+	return key
+}
+
+// Save stores a snapshot with its chunks. The snapshot is written last, so
+// that only complete snapshots are listed.
+func (db *SnapshotDB) Save(snapshot *Snapshot, chunks [][]byte) (err error) {
+	dat, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	batch := db.db.NewBatch()
+	defer batch.Close()
+	for i, chunk := range chunks {
+		err = batch.Set(snapshotChunkKey(snapshot.Height, uint32(i)), chunk)
+		if err != nil {
+			return err
+		}
+	}
+	err = batch.Set(snapshotKey(snapshot.Height), dat)
+	if err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// List returns the stored snapshots, latest first.
+func (db *SnapshotDB) List() (snapshots []*Snapshot, err error) {
+	it, err := db.db.ReverseIterator([]byte{prefixSnapshot}, []byte{prefixSnapshot + 1})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		snapshot := &Snapshot{}
+		if err = json.Unmarshal(it.Value(), snapshot); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, it.Error()
+}
+
+// LoadChunk returns a chunk of the snapshot at height, or nil if there is
+// none.
+func (db *SnapshotDB) LoadChunk(height uint64, idx uint32) ([]byte, error) {
+	return db.db.Get(snapshotChunkKey(height, idx))
+}
+
+// Prune drops all but the keepRecent latest snapshots.
+func (db *SnapshotDB) Prune(keepRecent int) (err error) {
+	snapshots, err := db.List()
+	if err != nil || len(snapshots) <= keepRecent {
+		return err
+	}
+	batch := db.db.NewBatch()
+	defer batch.Close()
+	for _, snapshot := range snapshots[keepRecent:] {
+		err = batch.Delete(snapshotKey(snapshot.Height))
+		if err != nil {
+			return err
+		}
+		for i := range snapshot.ChunkHashes {
+			err = batch.Delete(snapshotChunkKey(snapshot.Height, uint32(i)))
+			if err != nil {
+				return err
+			}
+		}
+		db.logger.Info("prune snapshot", "height", snapshot.Height)
+	}
+	return batch.Write()
+}
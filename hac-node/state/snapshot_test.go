@@ -0,0 +1,96 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// amendTestParams commits a block of db that changes the params, so that
+// the tree has a new version.
+func amendTestParams(t *testing.T, db *StateDB, discussionLen uint64) {
+	t.Helper()
+	st := db.NewState()
+	params := hac_types.DefaultParams()
+	params.MaxDiscussionLen = discussionLen
+	if err := st.SetParams(params); err != nil {
+		t.Fatal(err)
+	}
+	commitTestState(t, db, st)
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	tests := []struct {
+		name   string
+		blocks int
+		// corrupt alters the exported snapshot before it is restored.
+		corrupt func(s *Snapshot, chunks [][]byte)
+		err     error
+	}{
+		{"genesis", 0, nil, nil},
+		{"after blocks", 5, nil, nil},
+		{"chunk altered", 1, func(s *Snapshot, chunks [][]byte) { chunks[0][0] ^= 1 }, ErrSnapshotChunkHash},
+		{"hash altered", 1, func(s *Snapshot, chunks [][]byte) { s.Hash[0] ^= 1 }, ErrSnapshotInvalid},
+		{"height altered", 1, func(s *Snapshot, chunks [][]byte) { s.Height++ }, ErrSnapshotInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, 1000, 2000, 3000)
+			for i := 0; i < tt.blocks; i++ {
+				amendTestParams(t, db, uint64(100+i))
+			}
+			header := db.Header()
+			snapshot, chunks, err := db.ExportSnapshot(header.Height, db.Version())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.corrupt != nil {
+				tt.corrupt(snapshot, chunks)
+			}
+
+			restored, err := NewStateDB(t.TempDir(), cmtlog.NewNopLogger())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer restored.Close()
+			// the app checks the snapshot and its chunks as state sync
+			// offers and applies them.
+			err = snapshot.Verify()
+			for i := 0; err == nil && i < len(chunks); i++ {
+				err = snapshot.VerifyChunk(uint32(i), chunks[i])
+			}
+			var hash []byte
+			if err == nil {
+				hash, err = restored.RestoreSnapshot(snapshot, chunks)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if !bytes.Equal(hash, header.Hash) || !bytes.Equal(restored.Header().Hash, header.Hash) {
+				t.Fatalf("restored hash %x, want %x", hash, header.Hash)
+			}
+			st := restored.NewState()
+			for _, idx := range idxs {
+				if a, b := testAccount(t, st, idx), testAccount(t, db.NewState(), idx); a.Stake != b.Stake || !bytes.Equal(a.PubKey, b.PubKey) {
+					t.Fatalf("account %d restored %+v, want %+v", idx, a, b)
+				}
+			}
+
+			// the restored state carries on with the same hashes.
+			amendTestParams(t, db, 42)
+			amendTestParams(t, restored, 42)
+			if !bytes.Equal(restored.Header().Hash, db.Header().Hash) {
+				t.Fatalf("next hash %x, want %x", restored.Header().Hash, db.Header().Hash)
+			}
+			if _, err := restored.RestoreSnapshot(snapshot, chunks); !errors.Is(err, ErrSnapshotStateExists) {
+				t.Fatalf("restore again err %v, want %v", err, ErrSnapshotStateExists)
+			}
+		})
+	}
+}
@@ -2,11 +2,14 @@ package state
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"sort"
+	"strings"
+	"time"
 
 	"container/heap"
 
@@ -14,7 +17,10 @@ import (
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	cmtlog "github.com/cometbft/cometbft/libs/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/iavl"
+	dbm "github.com/cosmos/iavl/db"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -46,13 +52,37 @@ var (
 	KeyAccountBody           = "a%x"
 	KeyHash                  = "h%x"
 	KeyGrant                 = []byte("k")
-	KeyStakesReleaseHeight   = "stake%x"
-	KeyRetractsReleaseHeight = "retract%x"
+	KeyStakesReleaseHeight   = "stake%016x%016x"
+	KeyRetractsReleaseHeight = "retract%016x%016x"
 	KeyProposalBody          = "p%v"
 	KeyProposalIndex         = "pi"
 	KeyDiscussionBody        = "d%v"
 	KeyDiscussionIndex       = "di"
 	KeyManifest              = "m"
+	KeyRationaleBody         = "r%v"
+	KeyApplication           = "n%s"
+	KeyRetiredKey            = "o%s"
+	KeySession               = "q%016x%s"
+	KeyCommunityPool         = "cp"
+	KeyIssued                = "rw"
+	KeyParams                = "pa"
+	KeyBlockTime             = "bt"
+
+	// KeyProposalDiscussion indexes the discussions of a proposal, fixed
+	// width so that they are read in order by range.
+	KeyProposalDiscussion = "pd%016x%016x"
+	// KeyDiscussionCount counts the discussions of an account on a
+	// proposal, by proposal and account.
+	KeyDiscussionCount = "dc%016x%016x"
+	// KeyProposalEnd indexes the processing proposals, and the ones holding
+	// a deposit, by EndHeight, so the ones past it are found by range.
+	KeyProposalEnd = "pe%016x%016x"
+	// KeyManifestVersion holds every version of the manifest, KeyManifest
+	// the active one.
+	KeyManifestVersion = "mv%016x"
+	// KeyApplicationExpire indexes the pending applications by expire
+	// height, so the ones past it are found by range.
+	KeyApplicationExpire = "ne%016x%s"
 )
 
 var (
@@ -68,9 +98,32 @@ var (
 	ErrUnexpectedWithdrawal         = errors.New("unexpected withdrawal")
 	ErrPayloadProposalHeigtMismatch = errors.New("payload proposal height mismatch")
 	ErrTxProposalNoexists           = errors.New("proposal noexists")
-	ErrTxMoreThanOneProposal        = errors.New("more than one proposal")
 	ErrTxVoteCodeInvalid            = errors.New("vote code invalid")
 	ErrOneActionInOneBlock          = errors.New("one action in one block")
+	ErrTxTooManyOptions             = errors.New("too many proposal options")
+	ErrTxRationaleHeight            = errors.New("rationale height unmatched")
+	ErrTxRationaleInvalid           = errors.New("rationale invalid")
+	ErrTxDisqualifySelf             = errors.New("can not disqualify self")
+	ErrTxClauseNotInManifest        = errors.New("clause not in manifest")
+	ErrTxEvidenceInvalid            = errors.New("evidence invalid")
+	ErrTxManifestNoexists           = errors.New("manifest noexists")
+	ErrTxAmountInvalid              = errors.New("amount invalid")
+	ErrTxPopInvalid                 = errors.New("proof of possession invalid")
+	ErrTxApplicationExists          = errors.New("application already pending")
+	ErrTxApplicationNoexists        = errors.New("application noexists")
+	ErrTxManifestVersion            = errors.New("manifest version not active")
+	ErrTooManyApplications          = errors.New("too many applications in one block")
+	ErrTxSessionNoexists            = errors.New("session noexists")
+	ErrTxSessionExpireHeight        = errors.New("session expire height invalid")
+	ErrTxSessionRateCap             = errors.New("session rate cap reached")
+	ErrTooManySessions              = errors.New("too many sessions")
+	ErrTxDepositInsufficient        = errors.New("stake insufficient for proposal deposit")
+	ErrTxDiscussionTooLong          = errors.New("discussion too long")
+	ErrTxProposalClosed             = errors.New("proposal closed for discussion")
+	ErrTxDiscussionQuota            = errors.New("discussion quota of proposal reached")
+	ErrTxDiscussionBlockCap         = errors.New("too many discussions in one block")
+	ErrTxDiscussionAccountBlockCap  = errors.New("too many discussions of account in one block")
+	ErrTxExpired                    = errors.New("tx expired")
 )
 
 type State struct {
@@ -86,8 +139,26 @@ type State struct {
 	modifiedAcnts      map[uint64]uint32
 	proposalMaxIndex   uint64
 	discussionMaxIndex uint64
-	modProposal        *hac_types.Proposal
+	modProposals       map[uint64]*hac_types.Proposal
 	newDiscussions     map[uint64]hac_types.Discussion
+	newRationales      []hac_types.Rationale
+	newManifests       []hac_types.Manifest
+	newUnbondings      []hac_types.Unbonding
+	delUnbondings      []hac_types.Unbonding
+	modApplications    map[string]*hac_types.Application
+	retiredKeys        map[string][]byte
+	modSessions        map[string]*hac_types.Session
+	// poolDeposits are the deposits forfeited to the community pool in the
+	// block.
+	poolDeposits uint64
+	// rewards are the rewards paid in the block, issued ones the sum.
+	rewards []*hac_types.EventReward
+	issued  uint64
+	// newParams are the params set in the block, nil if unchanged.
+	newParams *hac_types.Params
+
+	// blockTime is the header time of the block being built or applied.
+	blockTime time.Time
 }
 
 func newState(db *iavl.MutableTree, logger cmtlog.Logger) *State {
@@ -102,8 +173,11 @@ func newState(db *iavl.MutableTree, logger cmtlog.Logger) *State {
 		modifiedAcnts:      make(map[uint64]uint32),
 		proposalMaxIndex:   0,
 		discussionMaxIndex: 0,
-		modProposal:        nil,
+		modProposals:       make(map[uint64]*hac_types.Proposal),
 		newDiscussions:     map[uint64]hac_types.Discussion{},
+		modApplications:    make(map[string]*hac_types.Application),
+		retiredKeys:        make(map[string][]byte),
+		modSessions:        make(map[string]*hac_types.Session),
 	}
 	s.header.AccountIdx = StartAccountIdx
 	return s
@@ -119,7 +193,11 @@ func (s *State) nextState() *State {
 		modifiedAcnts:      make(map[uint64]uint32),
 		proposalMaxIndex:   s.proposalMaxIndex,
 		discussionMaxIndex: s.discussionMaxIndex,
+		modProposals:       make(map[uint64]*hac_types.Proposal),
 		newDiscussions:     make(map[uint64]hac_types.Discussion),
+		modApplications:    make(map[string]*hac_types.Application),
+		retiredKeys:        make(map[string][]byte),
+		modSessions:        make(map[string]*hac_types.Session),
 	}
 	n.header = proto.Clone(s.header).(*StateHeader)
 	if s.header.GetHash() != nil {
@@ -136,6 +214,23 @@ func deepCopyMap[K comparable, V any](source map[K]V) map[K]V {
 		case *Account:
 			account := proto.Clone(x).(*Account)
 			res[k] = any(account).(V)
+		case *hac_types.Proposal:
+			proposal := *x
+			res[k] = any(&proposal).(V)
+		case *hac_types.Application:
+			if x != nil {
+				application := *x
+				res[k] = any(&application).(V)
+			} else {
+				res[k] = v
+			}
+		case *hac_types.Session:
+			if x != nil {
+				session := *x
+				res[k] = any(&session).(V)
+			} else {
+				res[k] = v
+			}
 		default:
 			res[k] = v
 		}
@@ -175,8 +270,20 @@ func (s *State) Clone() *State {
 		modifiedAcnts:      deepCopyMap(s.modifiedAcnts),
 		proposalMaxIndex:   s.proposalMaxIndex,
 		discussionMaxIndex: s.discussionMaxIndex,
-		modProposal:        s.modProposal,
+		modProposals:       deepCopyMap(s.modProposals),
 		newDiscussions:     deepCopyMap(s.newDiscussions),
+		newRationales:      deepCopySlice(s.newRationales),
+		newManifests:       deepCopySlice(s.newManifests),
+		newUnbondings:      deepCopySlice(s.newUnbondings),
+		delUnbondings:      deepCopySlice(s.delUnbondings),
+		modApplications:    deepCopyMap(s.modApplications),
+		retiredKeys:        deepCopyMap(s.retiredKeys),
+		modSessions:        deepCopyMap(s.modSessions),
+		poolDeposits:       s.poolDeposits,
+		rewards:            deepCopySlice(s.rewards),
+		issued:             s.issued,
+		newParams:          s.newParams.Clone(),
+		blockTime:          s.blockTime,
 	}
 	n.header = proto.Clone(s.header).(*StateHeader)
 	if s.header.GetHash() != nil {
@@ -200,6 +307,15 @@ func (s *State) load() (err error) {
 		}
 	}
 	s.discussionMaxIndex = new(big.Int).SetBytes(val).Uint64()
+	val, err = s.db.Get([]byte(KeyBlockTime))
+	if err != nil {
+		if err != leveldb.ErrNotFound {
+			return err
+		}
+	}
+	if val != nil {
+		s.blockTime = time.Unix(0, new(big.Int).SetBytes(val).Int64()).UTC()
+	}
 	val, err = s.db.Get([]byte(KeyState))
 	if err != nil {
 		if err == leveldb.ErrNotFound {
@@ -251,27 +367,140 @@ func (s *State) Update() (h common.Hash, err error) {
 	if err != nil {
 		return
 	}
+	// the time of the last block, which txs are checked against until the
+	// next one.
+	if !s.blockTime.IsZero() {
+		_, err = s.db.Set([]byte(KeyBlockTime), big.NewInt(s.blockTime.UnixNano()).Bytes())
+		if err != nil {
+			return
+		}
+	}
 
 	if len(s.newDiscussions) != 0 {
 		_, err = s.db.Set([]byte(KeyDiscussionIndex), big.NewInt(int64(s.discussionMaxIndex)).Bytes())
 		if err != nil {
 			return
 		}
-		//todo: record discussion in state
+		idxs := make([]uint64, 0, len(s.newDiscussions))
+		for idx := range s.newDiscussions {
+			idxs = append(idxs, idx)
+		}
+		sort.Slice(idxs, func(i, j int) bool {
+			return idxs[i] < idxs[j]
+		})
+		for _, idx := range idxs {
+			dis := s.newDiscussions[idx]
+			key := fmt.Sprintf(KeyDiscussionBody, idx)
+			disBz, _ := json.Marshal(dis)
+			_, err = s.db.Set([]byte(key), disBz)
+			if err != nil {
+				return
+			}
+			_, err = s.db.Set([]byte(fmt.Sprintf(KeyProposalDiscussion, dis.Proposal, idx)), []byte(key))
+			if err != nil {
+				return
+			}
+		}
+		err = s.writeDiscussionCounts()
+		if err != nil {
+			return
+		}
 	}
 
-	if s.modProposal != nil {
+	if len(s.modProposals) != 0 {
 		_, err = s.db.Set([]byte(KeyProposalIndex), big.NewInt(int64(s.proposalMaxIndex)).Bytes())
 		if err != nil {
 			return
 		}
-		key := fmt.Sprintf(KeyProposalBody, s.modProposal.Index)
-		proposalBz, _ := json.Marshal(s.modProposal)
-		_, err = s.db.Set([]byte(key), proposalBz)
+		idxs := make([]uint64, 0, len(s.modProposals))
+		for idx := range s.modProposals {
+			idxs = append(idxs, idx)
+		}
+		sort.Slice(idxs, func(i, j int) bool {
+			return idxs[i] < idxs[j]
+		})
+		for _, idx := range idxs {
+			proposal := s.modProposals[idx]
+			key := fmt.Sprintf(KeyProposalBody, idx)
+			proposalBz, _ := json.Marshal(proposal)
+			_, err = s.db.Set([]byte(key), proposalBz)
+			if err != nil {
+				return
+			}
+			endKey := []byte(fmt.Sprintf(KeyProposalEnd, proposal.EndHeight, idx))
+			if proposal.Status == hac_types.ProposalStatusProcessing || proposal.Deposit != 0 {
+				_, err = s.db.Set(endKey, []byte(key))
+			} else {
+				_, _, err = s.db.Remove(endKey)
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	for _, r := range s.newRationales {
+		key := fmt.Sprintf(KeyRationaleBody, r.Proposal)
+		var rationales []hac_types.Rationale
+		rationales, err = s.getRationales(r.Proposal)
+		if err != nil {
+			return
+		}
+		rationaleBz, _ := json.Marshal(append(rationales, r))
+		_, err = s.db.Set([]byte(key), rationaleBz)
+		if err != nil {
+			return
+		}
+	}
+	s.newRationales = nil
+
+	for _, m := range s.newManifests {
+		manifestBz, _ := json.Marshal(m)
+		_, err = s.db.Set([]byte(KeyManifest), manifestBz)
+		if err != nil {
+			return
+		}
+		_, err = s.db.Set([]byte(fmt.Sprintf(KeyManifestVersion, m.Version)), manifestBz)
 		if err != nil {
 			return
 		}
 	}
+	s.newManifests = nil
+
+	err = s.writeUnbondings()
+	if err != nil {
+		return
+	}
+
+	err = s.writeApplications()
+	if err != nil {
+		return
+	}
+
+	err = s.writeRetiredKeys()
+	if err != nil {
+		return
+	}
+
+	err = s.writeSessions()
+	if err != nil {
+		return
+	}
+
+	err = s.writeCommunityPool()
+	if err != nil {
+		return
+	}
+
+	err = s.writeParams()
+	if err != nil {
+		return
+	}
+
+	err = s.writeIssued()
+	if err != nil {
+		return
+	}
 
 	n := len(s.modifiedAcnts)
 	if n > 0 {
@@ -327,6 +556,11 @@ func (s *State) save() (h common.Hash, err error) {
 	return
 }
 
+// ProposalMax returns the index of the latest proposal.
+func (s *State) ProposalMax() uint64 {
+	return s.proposalMaxIndex
+}
+
 func (s *State) getProposalMax() uint64 {
 	return s.proposalMaxIndex
 }
@@ -345,16 +579,51 @@ func (s *State) getProposalByIndex(index uint64) (*hac_types.Proposal, error) {
 	return &proposal, nil
 }
 
+// GetProposal returns the proposal stored at idx.
+func (s *State) GetProposal(idx uint64) (*hac_types.Proposal, error) {
+	return s.getProposal(idx)
+}
+
+// GetRationales returns the vote rationales recorded for a proposal.
+func (s *State) GetRationales(proposal uint64) ([]hac_types.Rationale, error) {
+	return s.getRationales(proposal)
+}
+
+func (s *State) getRationales(proposal uint64) (rationales []hac_types.Rationale, err error) {
+	key := fmt.Sprintf(KeyRationaleBody, proposal)
+	val, err := s.db.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+	err = json.Unmarshal(val, &rationales)
+	return
+}
+
 func (s *State) getDiscussionMax() uint64 {
 	return s.discussionMaxIndex
 }
 
+// GetDiscussion returns the discussion stored at index.
+func (s *State) GetDiscussion(index uint64) (*hac_types.Discussion, error) {
+	return s.getDiscussionByIndex(index)
+}
+
 func (s *State) getDiscussionByIndex(index uint64) (*hac_types.Discussion, error) {
+	// discussions of the block are not stored yet.
+	if dis, ok := s.newDiscussions[index]; ok {
+		return &dis, nil
+	}
 	key := fmt.Sprintf(KeyDiscussionBody, index)
 	val, err := s.db.Get([]byte(key))
 	if err != nil {
 		return nil, err
 	}
+	if val == nil {
+		return nil, ErrNotFound
+	}
 	var dis hac_types.Discussion
 	err = json.Unmarshal(val, &dis)
 	if err != nil {
@@ -363,11 +632,64 @@ func (s *State) getDiscussionByIndex(index uint64) (*hac_types.Discussion, error
 	return &dis, nil
 }
 
+// GetProposalDiscussions returns the discussions of a proposal, in the
+// order they were held.
+func (s *State) GetProposalDiscussions(proposal uint64) (discussions []*hac_types.Discussion, err error) {
+	idxs, err := proposalDiscussionIdxs(s.db.Iterator, proposal)
+	if err != nil {
+		return nil, err
+	}
+	news := make([]uint64, 0)
+	for idx, dis := range s.newDiscussions {
+		if dis.Proposal == proposal {
+			news = append(news, idx)
+		}
+	}
+	sort.Slice(news, func(i, j int) bool {
+		return news[i] < news[j]
+	})
+	for _, idx := range append(idxs, news...) {
+		dis, err := s.getDiscussionByIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+		discussions = append(discussions, dis)
+	}
+	return
+}
+
+// proposalDiscussionIdxs reads the indexes of the discussions of a proposal
+// off the secondary keys.
+func proposalDiscussionIdxs(iterator func(start, end []byte, ascending bool) (dbm.Iterator, error), proposal uint64) (idxs []uint64, err error) {
+	start := []byte(fmt.Sprintf(KeyProposalDiscussion, proposal, uint64(0)))
+	end := []byte(fmt.Sprintf(KeyProposalDiscussion, proposal+1, uint64(0)))
+	it, err := iterator(start, end, true)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var p, idx uint64
+		_, err = fmt.Sscanf(string(it.Key()), KeyProposalDiscussion, &p, &idx)
+		if err != nil {
+			return nil, err
+		}
+		idxs = append(idxs, idx)
+	}
+	return idxs, it.Error()
+}
+
 func (s *State) getProposal(idx uint64) (proposal *hac_types.Proposal, err error) {
 	if idx > s.proposalMaxIndex {
 		err = ErrProposaNoexists
 		return
 	}
+	// proposals created or settled earlier in the block are not stored yet.
+	if p, ok := s.modProposals[idx]; ok {
+		proposal = new(hac_types.Proposal)
+		*proposal = *p
+		return
+	}
 	key := fmt.Sprintf(KeyProposalBody, idx)
 	val, err := s.db.Get([]byte(key))
 	if err != nil {
@@ -462,20 +784,83 @@ func (s *State) FindAccount(addr []byte) (acnt *Account, err error) {
 	return
 }
 
+// SetManifest sets the manifest of the genesis, the first version.
 func (s *State) SetManifest(manifest string) error {
-	_, err := s.db.Set([]byte(KeyManifest), []byte(manifest))
-	return err
+	s.newManifests = append(s.newManifests, hac_types.Manifest{
+		Version: 1,
+		Text:    manifest,
+		Height:  s.header.Height,
+	})
+	return nil
 }
 
+// GetManifest returns the text of the active manifest, empty if there is
+// none.
 func (s *State) GetManifest() (manifest string, err error) {
-	val, err := s.db.Get([]byte(KeyManifest))
+	m, err := s.GetActiveManifest()
 	if err != nil {
-		if err != leveldb.ErrNotFound {
-			return "", err
+		if err == ErrNotFound {
+			return "", nil
 		}
+		return "", err
 	}
-	manifest = string(val)
-	return
+	return m.Text, nil
+}
+
+// GetActiveManifest returns the active version of the manifest.
+func (s *State) GetActiveManifest() (*hac_types.Manifest, error) {
+	if n := len(s.newManifests); n != 0 {
+		manifest := s.newManifests[n-1]
+		return &manifest, nil
+	}
+	return getManifest(s.db.Get, []byte(KeyManifest))
+}
+
+// GetManifestHistory returns every version of the manifest, oldest first.
+func (s *State) GetManifestHistory() ([]*hac_types.Manifest, error) {
+	active, err := s.GetActiveManifest()
+	if err != nil {
+		return nil, err
+	}
+	// the versions of this block are not written yet.
+	history, err := manifestHistory(s.db.Get, active.Version-uint64(len(s.newManifests)))
+	if err != nil {
+		return nil, err
+	}
+	for i := range s.newManifests {
+		manifest := s.newManifests[i]
+		history = append(history, &manifest)
+	}
+	return history, nil
+}
+
+func getManifest(get func(key []byte) ([]byte, error), key []byte) (*hac_types.Manifest, error) {
+	val, err := get(key)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, ErrNotFound
+	}
+	manifest := new(hac_types.Manifest)
+	err = json.Unmarshal(val, manifest)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// manifestHistory reads the manifest versions up to version, oldest first.
+func manifestHistory(get func(key []byte) ([]byte, error), version uint64) (history []*hac_types.Manifest, err error) {
+	history = make([]*hac_types.Manifest, 0, version+1)
+	for v := uint64(1); v <= version; v++ {
+		manifest, err := getManifest(get, []byte(fmt.Sprintf(KeyManifestVersion, v)))
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, manifest)
+	}
+	return history, nil
 }
 
 func (s *State) ValidatorAccounts() (acounts []*Account, height uint64, err error) {
@@ -503,6 +888,15 @@ func (s *State) Hash() (h common.Hash) {
 	return
 }
 
+// SetBlockTime sets the header time of the block the state is applied for.
+func (s *State) SetBlockTime(t time.Time) {
+	s.blockTime = t
+}
+
+func (s *State) BlockTime() time.Time {
+	return s.blockTime
+}
+
 func (s *State) SetChainId(chainId string) {
 	s.header.ChainId = chainId
 }
@@ -524,6 +918,9 @@ func (s *State) AddAccount(acnt *Account) (err error) {
 }
 
 func (s *State) Verify(tx *tx.HACTx, allowNonceGap bool) (succ bool, err error) {
+	if atx, ok := tx.Tx.(*txtypes.ApplyTx); ok {
+		return s.verifyApplication(tx, atx)
+	}
 	a, err := s.GetAccount(tx.Validator)
 	if err != nil {
 		return succ, err
@@ -541,6 +938,16 @@ func (s *State) Verify(tx *tx.HACTx, allowNonceGap bool) (succ bool, err error)
 		return succ, err
 	}
 	succ = a.Verify(dat, tx.Sig)
+	if !succ {
+		// a tx the account's signers did not sign may be signed by one of
+		// its session keys, if the session permits the type.
+		var session *hac_types.Session
+		session, err = s.txSession(a, tx.Type, dat, tx.Sig)
+		if err != nil {
+			return false, err
+		}
+		succ = session != nil
+	}
 	if !succ {
 		err = ErrTxSigInvalid
 	}
@@ -548,7 +955,7 @@ func (s *State) Verify(tx *tx.HACTx, allowNonceGap bool) (succ bool, err error)
 }
 
 func (s *State) Proposal(tx *tx.ProposalTx, validator uint64, checkOnly bool, code tx.VoteCode) (event *hac_types.EventProposal, err error) {
-	if code != txtypes.VoteIgnoreProposal && code != txtypes.VoteProcessProposal {
+	if code != txtypes.VoteIgnoreProposal && code != txtypes.VoteProcessProposal && !code.Undecided() {
 		return nil, ErrTxVoteCodeInvalid
 	}
 	s.logger.Debug("apply proposal", "validator", validator, "height", s.header.Height)
@@ -564,33 +971,55 @@ func (s *State) Proposal(tx *tx.ProposalTx, validator uint64, checkOnly bool, co
 		err = ErrTxNotMembership
 		return
 	}
-	if s.modProposal != nil && s.modProposal.Index != 0 {
-		err = ErrTxMoreThanOneProposal
+	if len(tx.Options) > txtypes.MaxProposalOptions {
+		err = ErrTxTooManyOptions
 		return
 	}
-	if tx.Title == "" {
-		err = errors.New("proposal title is empty")
+	err = tx.ValidateBasic()
+	if err != nil {
+		return
+	}
+	err = tx.ValidateEndHeight(s.header.Height)
+	if err != nil {
+		return
+	}
+	deposit, err := s.checkDeposit(a)
+	if err != nil {
+		return nil, err
+	}
+	// an expired proposal is kept out of the mempool; one that expires
+	// while in a block is ignored when applied.
+	if checkOnly && tx.Expired(s.blockTime) {
+		err = ErrTxExpired
 		return
 	}
 	if !checkOnly {
 		s.proposalMaxIndex += 1
+		endHeight := tx.EndHeight
+		if endHeight == 0 {
+			endHeight = s.header.Height + txtypes.DefaultProposalBlocks
+		}
 		proposal := hac_types.Proposal{
 			Index:           s.proposalMaxIndex,
 			Proposer:        a.Index,
 			ProposerAddress: a.Address(),
 			Data:            tx.Data,
 			Height:          s.header.Height,
-			EndHeight:       tx.EndHeight,
+			EndHeight:       endHeight,
 			ImageUrl:        tx.ImageUrl,
 			Title:           tx.Title,
 			Link:            tx.Link,
+			Options:         tx.Options,
 		}
-		if code == txtypes.VoteIgnoreProposal {
-			proposal.Status = hac_types.ProposalStatusIgnore
-		} else {
+		// a proposal only goes on to discussion when the validators agreed
+		// to process it; abstaining or failing to agree ignores it.
+		if code == txtypes.VoteProcessProposal && !tx.Expired(s.blockTime) {
 			proposal.Status = hac_types.ProposalStatusProcessing
+		} else {
+			proposal.Status = hac_types.ProposalStatusIgnore
 		}
-		s.modProposal = &proposal
+		depositStatus := s.takeDeposit(a, &proposal, deposit, code)
+		s.modProposals[proposal.Index] = &proposal
 
 		a.Nonce += 1
 		v := s.modifiedAcnts[a.Index]
@@ -602,26 +1031,26 @@ func (s *State) Proposal(tx *tx.ProposalTx, validator uint64, checkOnly bool, co
 			ProposalIndex:   proposal.Index,
 			Proposer:        a.Index,
 			ProposerAddress: a.Address(),
-			EndHeight:       tx.EndHeight,
+			EndHeight:       proposal.EndHeight,
 			Status:          uint64(proposal.Status),
 			Data:            proposal.Data,
 			Title:           proposal.Title,
 			Link:            proposal.Link,
 			ImageUrl:        proposal.ImageUrl,
+			Deposit:         deposit,
+			DepositStatus:   depositStatus,
+			Stake:           a.Stake,
 		}
 	}
 	return
 }
 
 func (s *State) SettleProposal(tx *tx.SettleProposalTx, validator uint64, checkOnly bool, code tx.VoteCode) (event *hac_types.EventSettleProposal, err error) {
-	if code != txtypes.VoteAcceptProposal && code != txtypes.VoteRejectProposal {
+	option, isOption := code.Option()
+	if code != txtypes.VoteAcceptProposal && code != txtypes.VoteRejectProposal && !isOption && !code.Undecided() {
 		return nil, ErrTxVoteCodeInvalid
 	}
 	s.logger.Debug("apply settle proposal", "validator", validator, "height", s.header.Height)
-	if s.modProposal != nil && s.modProposal.Index != 0 {
-		err = ErrTxMoreThanOneProposal
-		return
-	}
 	a, err := s.GetAccount(validator)
 	if err != nil {
 		return nil, err
@@ -640,13 +1069,37 @@ func (s *State) SettleProposal(tx *tx.SettleProposalTx, validator uint64, checkO
 	if proposal.Status != hac_types.ProposalStatusProcessing {
 		return nil, fmt.Errorf("proposal not processing status is %v", proposal.Status)
 	}
+	// a multi-option proposal is accepted by voting one of its options,
+	// a plain one by VoteAcceptProposal.
+	if isOption && option >= len(proposal.Options) {
+		return nil, ErrTxVoteCodeInvalid
+	}
+	if code == txtypes.VoteAcceptProposal && len(proposal.Options) != 0 {
+		return nil, ErrTxVoteCodeInvalid
+	}
+	// an expired settlement is kept out of the mempool; one that expires
+	// while in a block rejects the proposal when applied.
+	if checkOnly && tx.Expired(s.blockTime) {
+		return nil, ErrTxExpired
+	}
 	if !checkOnly {
-		if code == txtypes.VoteAcceptProposal {
+		switch {
+		case tx.Expired(s.blockTime):
+			proposal.Status = hac_types.ProposalStatusRejected
+		case code == txtypes.VoteAcceptProposal:
 			proposal.Status = hac_types.ProposalStatusAccepted
-		} else {
+		case isOption:
+			proposal.Status = hac_types.ProposalStatusAccepted
+			proposal.Option = proposal.Options[option]
+		case code.Undecided():
+			proposal.Status = hac_types.ProposalStatusUndecided
+		default:
 			proposal.Status = hac_types.ProposalStatusRejected
 		}
-		s.modProposal = proposal
+		s.modProposals[proposal.Index] = proposal
+		if proposal.Status == hac_types.ProposalStatusAccepted {
+			s.reward(a, hac_types.RewardProposal, proposal.Index, config.ProposalReward(s.header.Height))
+		}
 
 		a.Nonce += 1
 		v := s.modifiedAcnts[a.Index]
@@ -658,11 +1111,67 @@ func (s *State) SettleProposal(tx *tx.SettleProposalTx, validator uint64, checkO
 			Proposer: proposal.Proposer,
 			Proposal: tx.Proposal,
 			State:    int64(proposal.Status),
+			VoteCode: int64(code),
+			Option:   proposal.Option,
+		}
+	}
+	return
+}
+
+// ExpireProposals expires the processing proposals whose EndHeight has
+// passed, so that none is left open when its proposer never settles it. It
+// runs after the txs of the block, a settle in the last block still counts.
+func (s *State) ExpireProposals() (events []*hac_types.EventProposalExpired, err error) {
+	if s.header.Height == 0 {
+		return nil, nil
+	}
+	idxs, err := s.endedProposals()
+	if err != nil {
+		return nil, err
+	}
+	for _, idx := range idxs {
+		proposal, err := s.getProposal(idx)
+		if err != nil {
+			return nil, err
 		}
+		if proposal.Status != hac_types.ProposalStatusProcessing {
+			continue
+		}
+		proposal.Status = hac_types.ProposalStatusExpired
+		s.modProposals[proposal.Index] = proposal
+		events = append(events, &hac_types.EventProposalExpired{
+			Proposal:  proposal.Index,
+			Proposer:  proposal.Proposer,
+			EndHeight: proposal.EndHeight,
+			Height:    s.header.Height,
+		})
 	}
 	return
 }
 
+// endedProposals returns the indexes of the proposals indexed under
+// KeyProposalEnd whose EndHeight is past.
+func (s *State) endedProposals() (idxs []uint64, err error) {
+	start := []byte(fmt.Sprintf(KeyProposalEnd, uint64(0), uint64(0)))
+	end := []byte(fmt.Sprintf(KeyProposalEnd, s.header.Height, uint64(0)))
+	it, err := s.db.Iterator(start, end, true)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var endHeight, idx uint64
+		_, err = fmt.Sscanf(string(it.Key()), KeyProposalEnd, &endHeight, &idx)
+		if err != nil {
+			return nil, err
+		}
+		idxs = append(idxs, idx)
+	}
+	return idxs, it.Error()
+}
+
+// Dicussion adds the discussion of tx to its proposal, within the limits of
+// checkDiscussion.
 func (s *State) Dicussion(tx *tx.DiscussionTx, validator uint64, checkOnly bool) (event *hac_types.EventDiscussion, err error) {
 	s.logger.Debug("apply discussion", "validator", validator, "height", s.header.Height)
 	a, err := s.GetAccount(validator)
@@ -677,12 +1186,19 @@ func (s *State) Dicussion(tx *tx.DiscussionTx, validator uint64, checkOnly bool)
 		err = ErrTxNotMembership
 		return
 	}
-	if tx.Proposal > s.getProposalMax() {
-		err = ErrTxProposalNoexists
+	err = s.checkDiscussion(tx, a.Index)
+	if err != nil {
 		return
 	}
 
 	if !checkOnly {
+		count, err := s.DiscussionCount(tx.Proposal, a.Index)
+		if err != nil {
+			return nil, err
+		}
+		if count < config.RewardedProposalDiscussions(s.header.Height) {
+			s.reward(a, hac_types.RewardDiscussion, tx.Proposal, config.DiscussionReward(s.header.Height))
+		}
 		s.discussionMaxIndex += 1
 		dis := hac_types.Discussion{
 			Index:          s.discussionMaxIndex,
@@ -710,8 +1226,14 @@ func (s *State) Dicussion(tx *tx.DiscussionTx, validator uint64, checkOnly bool)
 	return
 }
 
-func (s *State) Grant(proposer uint64, pk []byte, amount uint64, agentUrl, name string, code tx.VoteCode) (event *hac_types.EventGrant, err error) {
-	if code != txtypes.VoteGrantNewMember && code != txtypes.VoteRejectNewMember {
+// Grant applies the validators' vote on granting membership to the holder
+// of grant.Pubkey. The grant must come with the new key's proof of
+// possession, or be of a pending application, give it some power within
+// MaxGrantPower, and not reuse the key of an account. Only a granted
+// member gets an account; a rejected or undecided grant is recorded by its
+// event alone. Either way the application is no longer pending.
+func (s *State) Grant(proposer uint64, grant *tx.GrantSt, checkOnly bool, code tx.VoteCode) (event *hac_types.EventGrant, err error) {
+	if code != txtypes.VoteGrantNewMember && code != txtypes.VoteRejectNewMember && !code.Undecided() {
 		return nil, ErrTxVoteCodeInvalid
 	}
 	proposerAcc, err := s.GetAccount(proposer)
@@ -726,59 +1248,151 @@ func (s *State) Grant(proposer uint64, pk []byte, amount uint64, agentUrl, name
 		err = ErrTxNotMembership
 		return
 	}
-	addr := ed25519.PubKey(pk).Address()
-	a, err := s.FindAccount(addr)
+	grant, err = s.ResolveGrant(grant)
 	if err != nil {
 		return nil, err
 	}
-	if a != nil {
-		return nil, errors.New("account already exists")
-	}
-	if code != txtypes.VoteGrantNewMember {
-		a = &Account{
-			Index:    s.header.AccountIdx,
-			PubKey:   pk,
-			Stake:    amount,
-			AgentUrl: agentUrl,
-			Name:     name,
-			Nonce:    0,
-		}
-		event = &hac_types.EventGrant{
-			Validator:       s.header.AccountIdx,
-			Address:         addr.String(),
-			Amount:          amount,
-			Nonce:           0,
-			Grant:           false,
-			AgentUrl:        agentUrl,
-			ProposerIndex:   proposer,
-			ProposerAddress: proposerAcc.Address(),
-		}
-	} else {
-		a = &Account{
-			Index:    s.header.AccountIdx,
-			PubKey:   pk,
-			Stake:    amount,
-			AgentUrl: agentUrl,
-			Name:     name,
-			Nonce:    0,
-		}
-		event = &hac_types.EventGrant{
-			Validator:       a.Index,
-			Address:         a.Address(),
-			Amount:          amount,
-			Nonce:           a.Nonce,
-			Grant:           true,
-			AgentUrl:        agentUrl,
-			ProposerIndex:   proposer,
-			ProposerAddress: proposerAcc.Address(),
-		}
+	err = grant.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	power := config.PowerPerStake(grant.Amount, s.header.Height)
+	if power <= 0 || power > txtypes.MaxGrantPower {
+		err = ErrTxAmountInvalid
+		return
+	}
+	pk := ed25519.PubKey(grant.Pubkey)
+	// an application is signed by the applicant's key already.
+	if grant.Application == "" && !pk.VerifySignature(grant.PopData([]byte(s.header.ChainId)), grant.Pop) {
+		err = ErrTxPopInvalid
+		return
 	}
+	exist, err := s.existPubkey(grant.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		err = ErrAccountAlreadyExists
+		return
+	}
+	if checkOnly {
+		return
+	}
+	// the grant is spent whatever the outcome, so that it cannot be replayed.
+	proposerAcc.Nonce += 1
+	v := s.modifiedAcnts[proposerAcc.Index]
+	v |= ModifiedFlagMod
+	s.modifiedAcnts[proposerAcc.Index] = v
+	s.acnts[proposerAcc.Index] = proposerAcc.Clone()
+	event = &hac_types.EventGrant{
+		Address:         pk.Address().String(),
+		Amount:          grant.Amount,
+		Grant:           code == txtypes.VoteGrantNewMember,
+		AgentUrl:        grant.AgentUrl,
+		ProposerIndex:   proposer,
+		ProposerAddress: proposerAcc.Address(),
+	}
+	if grant.Application != "" {
+		s.modApplications[grant.Application] = nil
+	}
+	if !event.Grant {
+		return
+	}
+	a := &Account{
+		Index:    s.header.AccountIdx,
+		PubKey:   grant.Pubkey,
+		Stake:    grant.Amount,
+		AgentUrl: grant.AgentUrl,
+		Name:     grant.Name,
+		Nonce:    0,
+	}
+	event.Validator = a.Index
 	s.header.AccountIdx += 1
 	s.modifiedAcnts[a.Index] = ModifiedFlagNew
 	s.acnts[a.Index] = a.Clone()
 	return
 }
 
+// Rationale records the vote rationales carried by the vote extensions of
+// the last commit. Every extension must verify against the signature of the
+// validator that cast it, so the record does not rest on the proposer's word.
+func (s *State) Rationale(tx *tx.RationaleTx) (events []*hac_types.EventRationale, err error) {
+	if tx.Height+1 != int64(s.header.Height) {
+		return nil, ErrTxRationaleHeight
+	}
+	seen := make(map[string]bool, len(tx.Votes))
+	rationales := make([]hac_types.Rationale, 0, len(tx.Votes))
+	for _, vote := range tx.Votes {
+		if seen[vote.Validator] {
+			return nil, fmt.Errorf("%w: duplicate validator %v", ErrTxRationaleInvalid, vote.Validator)
+		}
+		seen[vote.Validator] = true
+		addr, err := hex.DecodeString(vote.Validator)
+		if err != nil {
+			return nil, err
+		}
+		a, err := s.FindAccount(addr)
+		if err != nil {
+			return nil, err
+		}
+		if a == nil {
+			return nil, ErrTxValidatorNoexists
+		}
+		signBytes := cmttypes.VoteExtensionSignBytes(s.header.ChainId, &cmtproto.Vote{
+			Extension: vote.Extension,
+			Height:    tx.Height,
+			Round:     tx.Round,
+		})
+		// the extension may be signed by a key the account rotated since.
+		pk, err := s.accountKey(a, addr)
+		if err != nil {
+			return nil, err
+		}
+		if pk == nil || !pk.VerifySignature(signBytes, vote.Signature) {
+			return nil, ErrTxSigInvalid
+		}
+		var rs []hac_types.VoteRationale
+		err = json.Unmarshal(vote.Extension, &rs)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrTxRationaleInvalid, err)
+		}
+		if len(rs) > txtypes.MaxGovernanceTxs {
+			return nil, fmt.Errorf("%w: too many rationales", ErrTxRationaleInvalid)
+		}
+		for _, r := range rs {
+			if len(r.Reason) > hac_types.MaxRationaleReasonLen {
+				return nil, fmt.Errorf("%w: reason too long", ErrTxRationaleInvalid)
+			}
+			if r.Proposal == 0 || r.Proposal > s.proposalMaxIndex {
+				return nil, ErrTxProposalNoexists
+			}
+			rationales = append(rationales, hac_types.Rationale{
+				VoteRationale:    r,
+				Height:           uint64(tx.Height),
+				Validator:        a.Index,
+				ValidatorAddress: a.Address(),
+			})
+		}
+	}
+	for _, r := range rationales {
+		events = append(events, &hac_types.EventRationale{
+			Proposal:         r.Proposal,
+			Height:           r.Height,
+			Validator:        r.Validator,
+			ValidatorAddress: r.ValidatorAddress,
+			VoteCode:         r.VoteCode,
+			Reason:           r.Reason,
+			Context:          r.Context,
+		})
+	}
+	s.newRationales = append(s.newRationales, rationales...)
+	return
+}
+
+// UnStake retracts tx.Amount of the validator's stake, part of it or all.
+// The amount leaves the validator's power at the end of the block but waits
+// in the unbonding queue for config.UnbondingBlocks, slashable, before it
+// is released.
 func (s *State) UnStake(tx *tx.RetractTx, validator uint64, checkOnly bool) (event *hac_types.EventUnStake, err error) {
 	s.logger.Debug("apply retract", "validator", validator, "amount", tx.Amount, "height", s.header.Height)
 	a, err := s.GetAccount(validator)
@@ -793,17 +1407,218 @@ func (s *State) UnStake(tx *tx.RetractTx, validator uint64, checkOnly bool) (eve
 		err = ErrTxNotMembership
 		return
 	}
-	if a.Stake != tx.Amount {
-		err = fmt.Errorf("must retract all")
+	if tx.Amount == 0 || tx.Amount > a.Stake {
+		err = ErrTxAmountInvalid
 		return
 	}
 	if !checkOnly {
+		u := hac_types.Unbonding{
+			Account:       a.Index,
+			Amount:        tx.Amount,
+			Height:        s.header.Height,
+			ReleaseHeight: s.header.Height + config.UnbondingBlocks(s.header.Height),
+		}
+		err = s.addUnbonding(u)
+		if err != nil {
+			return nil, err
+		}
+		a.Stake -= tx.Amount
+		a.Nonce += 1
+		v := s.modifiedAcnts[a.Index]
+		v |= ModifiedFlagMod
+		s.modifiedAcnts[a.Index] = v
+		s.acnts[a.Index] = a.Clone()
 		event = &hac_types.EventUnStake{
-			Validator: validator,
+			Validator:     validator,
+			Address:       a.Address(),
+			Amount:        tx.Amount,
+			Stake:         a.Stake,
+			ReleaseHeight: u.ReleaseHeight,
+		}
+	}
+	return
+}
+
+// TopUpStake grows the validator's stake by tx.Amount, which joins the
+// validator's power at the end of the block. Only a member may top up.
+func (s *State) TopUpStake(tx *tx.StakeTx, validator uint64, checkOnly bool) (event *hac_types.EventStake, err error) {
+	s.logger.Debug("apply stake", "validator", validator, "amount", tx.Amount, "height", s.header.Height)
+	a, err := s.GetAccount(validator)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		err = ErrTxValidatorNoexists
+		return
+	}
+	if a.Stake == 0 {
+		err = ErrTxNotMembership
+		return
+	}
+	if tx.Amount == 0 || a.Stake+tx.Amount < a.Stake {
+		err = ErrTxAmountInvalid
+		return
+	}
+	if !checkOnly {
+		a.Stake += tx.Amount
+		event = &hac_types.EventStake{
+			Validator: a.Index,
 			Address:   a.Address(),
 			Amount:    tx.Amount,
+			Stake:     a.Stake,
+			Statement: tx.Statement,
 		}
-		a.Stake -= tx.Amount
+		a.Nonce += 1
+		v := s.modifiedAcnts[a.Index]
+		v |= ModifiedFlagMod
+		s.modifiedAcnts[a.Index] = v
+		s.acnts[a.Index] = a.Clone()
+	}
+	return
+}
+
+// Disqualify applies the validators' vote on expelling the target of tx.
+// On VoteDisqualifyMember the target's stake, unbonding stake and held
+// deposits are slashed, which drops it from the validator set at the end of
+// the block; any other outcome keeps it.
+func (s *State) Disqualify(tx *tx.DisqualifyTx, accuser uint64, checkOnly bool, code tx.VoteCode) (event *hac_types.EventDisqualify, err error) {
+	if code != txtypes.VoteDisqualifyMember && code != txtypes.VoteKeepMember && !code.Undecided() {
+		return nil, ErrTxVoteCodeInvalid
+	}
+	s.logger.Debug("apply disqualify", "accuser", accuser, "target", tx.Target, "height", s.header.Height)
+	a, err := s.GetAccount(accuser)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		err = ErrTxValidatorNoexists
+		return
+	}
+	if a.Stake == 0 {
+		err = ErrTxNotMembership
+		return
+	}
+	if tx.Target == accuser {
+		err = ErrTxDisqualifySelf
+		return
+	}
+	t, err := s.GetAccount(tx.Target)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		err = ErrAccountNoexists
+		return
+	}
+	unbondings, err := s.GetUnbondings(t.Index)
+	if err != nil {
+		return nil, err
+	}
+	// a member retracting all its stake is still answerable while it
+	// unbonds.
+	if t.Stake == 0 && len(unbondings) == 0 {
+		err = ErrTxNotMembership
+		return
+	}
+	if tx.Evidence == "" || len(tx.Evidence) > txtypes.MaxEvidenceLen {
+		err = ErrTxEvidenceInvalid
+		return
+	}
+	manifest, err := s.GetManifest()
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(tx.Clause) == "" || !strings.Contains(manifest, tx.Clause) {
+		err = ErrTxClauseNotInManifest
+		return
+	}
+	if !checkOnly {
+		event = &hac_types.EventDisqualify{
+			Target:         t.Index,
+			TargetAddress:  t.Address(),
+			Accuser:        a.Index,
+			AccuserAddress: a.Address(),
+			Clause:         tx.Clause,
+			Evidence:       tx.Evidence,
+			VoteCode:       int64(code),
+		}
+		a.Nonce += 1
+		v := s.modifiedAcnts[a.Index]
+		v |= ModifiedFlagMod
+		s.modifiedAcnts[a.Index] = v
+		s.acnts[a.Index] = a.Clone()
+
+		if code == txtypes.VoteDisqualifyMember {
+			event.Stake = t.Stake
+			event.Unbonding = s.slashUnbondings(unbondings, 0)
+			event.Expelled = true
+			t.Stake = 0
+			s.forfeitDeposit(t)
+			v = s.modifiedAcnts[t.Index]
+			v |= ModifiedFlagMod
+			s.modifiedAcnts[t.Index] = v
+			s.acnts[t.Index] = t.Clone()
+		}
+	}
+	return
+}
+
+// AmendManifest applies the validators' vote on a manifest amendment. On
+// VoteAmendManifest the amended manifest becomes the next version, active
+// from this block on; any other outcome keeps the active one.
+func (s *State) AmendManifest(tx *tx.AmendManifestTx, validator uint64, checkOnly bool, code tx.VoteCode) (event *hac_types.EventAmendManifest, err error) {
+	if code != txtypes.VoteAmendManifest && code != txtypes.VoteRejectAmendment && !code.Undecided() {
+		return nil, ErrTxVoteCodeInvalid
+	}
+	s.logger.Debug("apply amend manifest", "validator", validator, "height", s.header.Height)
+	a, err := s.GetAccount(validator)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		err = ErrTxValidatorNoexists
+		return
+	}
+	if a.Stake == 0 {
+		err = ErrTxNotMembership
+		return
+	}
+	if len(tx.Reason) > hac_types.MaxRationaleReasonLen {
+		err = fmt.Errorf("%w: reason too long", txtypes.ErrInvalidAmendment)
+		return
+	}
+	active, err := s.GetActiveManifest()
+	if err != nil {
+		if err == ErrNotFound {
+			err = ErrTxManifestNoexists
+		}
+		return nil, err
+	}
+	amended, err := tx.Amend(active.Text)
+	if err != nil {
+		return nil, err
+	}
+	if !checkOnly {
+		event = &hac_types.EventAmendManifest{
+			Proposer:        a.Index,
+			ProposerAddress: a.Address(),
+			Height:          s.header.Height,
+			Reason:          tx.Reason,
+			VoteCode:        int64(code),
+		}
+		if code == txtypes.VoteAmendManifest {
+			s.newManifests = append(s.newManifests, hac_types.Manifest{
+				Version:         active.Version + 1,
+				Text:            amended,
+				Height:          s.header.Height,
+				Proposer:        a.Index,
+				ProposerAddress: a.Address(),
+				Reason:          tx.Reason,
+			})
+			event.Accepted = true
+			event.Version = active.Version + 1
+		}
+
 		a.Nonce += 1
 		v := s.modifiedAcnts[a.Index]
 		v |= ModifiedFlagMod
@@ -0,0 +1,454 @@
+package state
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/hetu-project/hetu-chaoschain/config"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// newTestDB returns a state db at the genesis of a chain with params and
+// a member of each stake, and the indexes of the members.
+func newTestDB(t *testing.T, params *hac_types.Params, stakes ...uint64) (*StateDB, []uint64) {
+	t.Helper()
+	db, err := NewStateDB(t.TempDir(), cmtlog.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	st := db.NewState()
+	st.SetChainId("test")
+	idxs := make([]uint64, len(stakes))
+	for i, stake := range stakes {
+		a := &Account{Stake: stake}
+		a.SetPubKey(ed25519.GenPrivKey().PubKey().Bytes())
+		if err := st.AddAccount(a); err != nil {
+			t.Fatal(err)
+		}
+		idxs[i] = a.Index
+	}
+	if err := st.SetManifest("manifest"); err != nil {
+		t.Fatal(err)
+	}
+	if params == nil {
+		params = hac_types.DefaultParams()
+	}
+	if err := st.SetParams(params); err != nil {
+		t.Fatal(err)
+	}
+	commitTestState(t, db, st)
+	return db, idxs
+}
+
+// commitTestState commits st as the next block of db.
+func commitTestState(t *testing.T, db *StateDB, st *State) {
+	t.Helper()
+	if _, err := st.Update(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.SetState(st); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccount(t *testing.T, st *State, idx uint64) *Account {
+	t.Helper()
+	a, err := st.GetAccount(idx)
+	if err != nil || a == nil {
+		t.Fatalf("account %d: %v", idx, err)
+	}
+	return a
+}
+
+func TestGrant(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    tx.VoteCode
+		granted bool
+	}{
+		{"granted", tx.VoteGrantNewMember, true},
+		{"rejected", tx.VoteRejectNewMember, false},
+		{"abstained", tx.VoteAbstain, false},
+		{"no quorum", tx.VoteNoQuorum, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, 1000)
+			key := ed25519.GenPrivKey()
+			grant := &tx.GrantSt{Amount: 2 * config.GWeiPerPower(0), Name: "member", AgentUrl: "http://127.0.0.1:3000", Pubkey: key.PubKey().Bytes()}
+			grant.Pop, _ = key.Sign(grant.PopData([]byte("test")))
+			st := db.NewState()
+			next := st.Header().AccountIdx
+			event, err := st.Grant(idxs[0], grant, false, tt.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if event.Grant != tt.granted || event.Address != key.PubKey().Address().String() {
+				t.Fatalf("event %+v, want grant %v", event, tt.granted)
+			}
+			commitTestState(t, db, st)
+
+			// only a granted member takes an account index, a rejected key
+			// may be granted again.
+			st = db.NewState()
+			a, err := st.FindAccount(key.PubKey().Address())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.granted {
+				if a == nil || a.Index != next || event.Validator != next || st.Header().AccountIdx != next+1 {
+					t.Fatalf("account %+v event %d next %d, want %d", a, event.Validator, st.Header().AccountIdx, next)
+				}
+				if _, err := st.Grant(idxs[0], grant, true, tt.code); !errors.Is(err, ErrAccountAlreadyExists) {
+					t.Fatalf("regrant err %v, want %v", err, ErrAccountAlreadyExists)
+				}
+				return
+			}
+			if a != nil || event.Validator != 0 || st.Header().AccountIdx != next {
+				t.Fatalf("account %+v event %d next %d, want none", a, event.Validator, st.Header().AccountIdx)
+			}
+			if _, err := st.Grant(idxs[0], grant, true, tx.VoteGrantNewMember); err != nil {
+				t.Fatalf("regrant err %v", err)
+			}
+		})
+	}
+}
+
+func TestGrantReplay(t *testing.T) {
+	tests := []struct {
+		name string
+		code tx.VoteCode
+	}{
+		{"granted", tx.VoteGrantNewMember},
+		{"rejected", tx.VoteRejectNewMember},
+		{"no quorum", tx.VoteNoQuorum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, _ := newTestDB(t, nil, 1000)
+			proposerKey := ed25519.GenPrivKey()
+			proposer := &Account{Stake: 1000}
+			proposer.SetPubKey(proposerKey.PubKey().Bytes())
+			st := db.NewState()
+			if err := st.AddAccount(proposer); err != nil {
+				t.Fatal(err)
+			}
+			commitTestState(t, db, st)
+
+			key := ed25519.GenPrivKey()
+			grant := tx.GrantSt{Amount: 2 * config.GWeiPerPower(0), Name: "member", AgentUrl: "http://127.0.0.1:3000", Pubkey: key.PubKey().Bytes()}
+			grant.Pop, _ = key.Sign(grant.PopData([]byte("test")))
+			btx := &tx.HACTx{Version: tx.HACTxVersion2, Type: tx.HACTxTypeGrant, Validator: proposer.Index, Tx: &tx.GrantTx{Grants: []tx.GrantSt{grant}}}
+			dat, err := btx.SigData([]byte("test"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			sig, err := proposerKey.Sign(dat)
+			if err != nil {
+				t.Fatal(err)
+			}
+			btx.Sig = [][]byte{sig}
+
+			st = db.NewState()
+			if _, err := st.Verify(btx, false); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := st.Grant(proposer.Index, &grant, false, tt.code); err != nil {
+				t.Fatal(err)
+			}
+			commitTestState(t, db, st)
+
+			st = db.NewState()
+			if _, err := st.Verify(btx, false); !errors.Is(err, ErrTxNonceInvalid) {
+				t.Fatalf("replay err %v, want %v", err, ErrTxNonceInvalid)
+			}
+		})
+	}
+}
+
+func TestRationale(t *testing.T) {
+	params := hac_types.DefaultParams()
+	params.ProposalDeposit = 0
+	db, _ := newTestDB(t, params)
+	key := ed25519.GenPrivKey()
+	voter := &Account{Stake: 1000}
+	voter.SetPubKey(key.PubKey().Bytes())
+	st := db.NewState()
+	if err := st.AddAccount(voter); err != nil {
+		t.Fatal(err)
+	}
+	ptx := &tx.ProposalTx{Title: "title", EndHeight: 100, ExpireTimestamp: math.MaxUint32}
+	if _, err := st.Proposal(ptx, voter.Index, false, tx.VoteProcessProposal); err != nil {
+		t.Fatal(err)
+	}
+	commitTestState(t, db, st)
+	height := int64(db.Header().Height)
+
+	// vote builds the rationale vote of key on the last block, signing ext.
+	vote := func(key ed25519.PrivKey, ext []byte) tx.RationaleVote {
+		sig, err := key.Sign(cmttypes.VoteExtensionSignBytes("test", &cmtproto.Vote{Extension: ext, Height: height, Round: 0}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx.RationaleVote{Validator: hex.EncodeToString(key.PubKey().Address()), Extension: ext, Signature: sig}
+	}
+	extension := func(rs ...hac_types.VoteRationale) []byte {
+		bz, err := json.Marshal(rs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bz
+	}
+	valid := hac_types.VoteRationale{Proposal: 1, VoteCode: int64(tx.VoteProcessProposal), Reason: "worth it"}
+	tooMany := make([]hac_types.VoteRationale, tx.MaxGovernanceTxs+1)
+	for i := range tooMany {
+		tooMany[i] = valid
+	}
+	forged := vote(key, extension(valid))
+	forged.Extension = extension(hac_types.VoteRationale{Proposal: 1, VoteCode: int64(tx.VoteIgnoreProposal)})
+
+	tests := []struct {
+		name   string
+		height int64
+		votes  []tx.RationaleVote
+		err    error
+	}{
+		{"recorded", height, []tx.RationaleVote{vote(key, extension(valid))}, nil},
+		{"empty extension list", height, []tx.RationaleVote{vote(key, extension())}, nil},
+		{"stale height", height - 1, []tx.RationaleVote{vote(key, extension(valid))}, ErrTxRationaleHeight},
+		{"duplicate validator", height, []tx.RationaleVote{vote(key, extension(valid)), vote(key, extension(valid))}, ErrTxRationaleInvalid},
+		{"unknown validator", height, []tx.RationaleVote{vote(ed25519.GenPrivKey(), extension(valid))}, ErrTxValidatorNoexists},
+		{"forged extension", height, []tx.RationaleVote{forged}, ErrTxSigInvalid},
+		{"malformed extension", height, []tx.RationaleVote{vote(key, []byte("{"))}, ErrTxRationaleInvalid},
+		{"too many rationales", height, []tx.RationaleVote{vote(key, extension(tooMany...))}, ErrTxRationaleInvalid},
+		{"reason too long", height, []tx.RationaleVote{vote(key, extension(hac_types.VoteRationale{Proposal: 1, Reason: strings.Repeat("a", hac_types.MaxRationaleReasonLen+1)}))}, ErrTxRationaleInvalid},
+		{"unknown proposal", height, []tx.RationaleVote{vote(key, extension(hac_types.VoteRationale{Proposal: 2}))}, ErrTxProposalNoexists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := db.NewState()
+			before, err := st.GetRationales(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			events, err := st.Rationale(&tx.RationaleTx{Height: tt.height, Votes: tt.votes})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			var want []hac_types.VoteRationale
+			_ = json.Unmarshal(tt.votes[0].Extension, &want)
+			if len(events) != len(want) {
+				t.Fatalf("%d events, want %d", len(events), len(want))
+			}
+			if _, err := st.Update(); err != nil {
+				t.Fatal(err)
+			}
+			// the rationales are appended to the ones of the proposal.
+			rationales, err := st.GetRationales(1)
+			if err != nil || len(rationales) != len(before)+len(want) {
+				t.Fatalf("recorded %+v: %v, want %d more", rationales, err, len(want))
+			}
+			for i, r := range rationales[len(before):] {
+				if r.Validator != voter.Index || r.Height != uint64(height) || r.Reason != want[i].Reason || r.VoteCode != want[i].VoteCode {
+					t.Fatalf("recorded %+v, want %+v", r, want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDisqualify(t *testing.T) {
+	const (
+		accuser = iota
+		target
+		outsider
+	)
+	tests := []struct {
+		name             string
+		accuser, target  int
+		retract          bool
+		clause, evidence string
+		code             tx.VoteCode
+		err              error
+		expelled         bool
+	}{
+		{"expelled", accuser, target, false, "manifest", "evidence", tx.VoteDisqualifyMember, nil, true},
+		{"expelled while unbonding", accuser, target, true, "manifest", "evidence", tx.VoteDisqualifyMember, nil, true},
+		{"kept", accuser, target, false, "manifest", "evidence", tx.VoteKeepMember, nil, false},
+		{"kept while unbonding", accuser, target, true, "manifest", "evidence", tx.VoteKeepMember, nil, false},
+		{"no quorum", accuser, target, false, "manifest", "evidence", tx.VoteNoQuorum, nil, false},
+		{"abstained", accuser, target, false, "manifest", "evidence", tx.VoteAbstain, nil, false},
+		{"invalid code", accuser, target, false, "manifest", "evidence", tx.VoteGrantNewMember, ErrTxVoteCodeInvalid, false},
+		{"self", accuser, accuser, false, "manifest", "evidence", tx.VoteDisqualifyMember, ErrTxDisqualifySelf, false},
+		{"accuser not a member", outsider, target, false, "manifest", "evidence", tx.VoteDisqualifyMember, ErrTxNotMembership, false},
+		{"target not a member", accuser, outsider, false, "manifest", "evidence", tx.VoteDisqualifyMember, ErrTxNotMembership, false},
+		{"unknown target", accuser, 3, false, "manifest", "evidence", tx.VoteDisqualifyMember, ErrAccountNoexists, false},
+		{"no evidence", accuser, target, false, "manifest", "", tx.VoteDisqualifyMember, ErrTxEvidenceInvalid, false},
+		{"evidence too long", accuser, target, false, "manifest", strings.Repeat("e", tx.MaxEvidenceLen+1), tx.VoteDisqualifyMember, ErrTxEvidenceInvalid, false},
+		{"clause not in manifest", accuser, target, false, "genesis contract", "evidence", tx.VoteDisqualifyMember, ErrTxClauseNotInManifest, false},
+		{"blank clause", accuser, target, false, " ", "evidence", tx.VoteDisqualifyMember, ErrTxClauseNotInManifest, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const stake = 500
+			db, idxs := newTestDB(t, nil, 1000, stake, 0)
+			idxs = append(idxs, idxs[len(idxs)-1]+1)
+			if tt.retract {
+				retractAt(t, db, idxs[target], db.NewState().Header().Height, stake)
+			}
+			dtx := &tx.DisqualifyTx{Target: idxs[tt.target], Clause: tt.clause, Evidence: tt.evidence}
+			st := db.NewState()
+			if _, err := st.Disqualify(dtx, idxs[tt.accuser], true, tt.code); !errors.Is(err, tt.err) {
+				t.Fatalf("check err %v, want %v", err, tt.err)
+			}
+			event, err := st.Disqualify(dtx, idxs[tt.accuser], false, tt.code)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if event.Expelled != tt.expelled || event.VoteCode != int64(tt.code) {
+				t.Fatalf("event %+v, want expelled %v", event, tt.expelled)
+			}
+			commitTestState(t, db, st)
+
+			st = db.NewState()
+			a, tgt := testAccount(t, st, idxs[tt.accuser]), testAccount(t, st, idxs[tt.target])
+			if a.Nonce != 1 {
+				t.Fatalf("accuser nonce %d, want 1", a.Nonce)
+			}
+			unbondings, err := st.GetUnbondings(tgt.Index)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.expelled {
+				// a kept target keeps its stake, or its unbonding stake.
+				if tt.retract && (tgt.Stake != 0 || len(unbondings) != 1) || !tt.retract && tgt.Stake != stake {
+					t.Fatalf("kept target stake %d unbondings %+v", tgt.Stake, unbondings)
+				}
+				return
+			}
+			if tgt.Stake != 0 || len(unbondings) != 0 {
+				t.Fatalf("expelled target stake %d unbondings %+v", tgt.Stake, unbondings)
+			}
+			if tt.retract && event.Unbonding != stake || !tt.retract && event.Stake != stake {
+				t.Fatalf("event stake %d unbonding %d, want %d slashed", event.Stake, event.Unbonding, stake)
+			}
+		})
+	}
+}
+
+func TestAmendManifest(t *testing.T) {
+	edit := &tx.AmendManifestTx{Edits: []tx.ManifestEdit{{Old: "manifest", New: "manifest v2"}}, Reason: "reason"}
+	tests := []struct {
+		name     string
+		member   bool
+		amend    *tx.AmendManifestTx
+		code     tx.VoteCode
+		err      error
+		accepted bool
+	}{
+		{"accepted", true, edit, tx.VoteAmendManifest, nil, true},
+		{"rejected", true, edit, tx.VoteRejectAmendment, nil, false},
+		{"abstained", true, edit, tx.VoteAbstain, nil, false},
+		{"no quorum", true, edit, tx.VoteNoQuorum, nil, false},
+		{"other code", true, edit, tx.VoteAmendParams, ErrTxVoteCodeInvalid, false},
+		{"not a member", false, edit, tx.VoteAmendManifest, ErrTxNotMembership, false},
+		{"reason too long", true, &tx.AmendManifestTx{Text: "manifest v2", Reason: strings.Repeat("r", hac_types.MaxRationaleReasonLen+1)}, tx.VoteAmendManifest, tx.ErrInvalidAmendment, false},
+		{"edit not matching", true, &tx.AmendManifestTx{Edits: []tx.ManifestEdit{{Old: "contract", New: "manifest"}}}, tx.VoteAmendManifest, tx.ErrInvalidAmendment, false},
+		{"unchanged", true, &tx.AmendManifestTx{Text: "manifest"}, tx.VoteAmendManifest, tx.ErrInvalidAmendment, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, 1000, 0)
+			proposer := idxs[0]
+			if !tt.member {
+				proposer = idxs[1]
+			}
+			st := db.NewState()
+			if _, err := st.AmendManifest(tt.amend, proposer, true, tt.code); !errors.Is(err, tt.err) {
+				t.Fatalf("check err %v, want %v", err, tt.err)
+			}
+			event, err := st.AmendManifest(tt.amend, proposer, false, tt.code)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if event.Accepted != tt.accepted {
+				t.Fatalf("accepted %v, want %v", event.Accepted, tt.accepted)
+			}
+			commitTestState(t, db, st)
+
+			// an accepted amendment is the next version, the first one kept
+			// in the history.
+			st = db.NewState()
+			history, err := st.GetManifestHistory()
+			if err != nil {
+				t.Fatal(err)
+			}
+			active, err := st.GetActiveManifest()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.accepted {
+				if len(history) != 1 || active.Version != 1 || active.Text != "manifest" {
+					t.Fatalf("active %+v history %d, want version 1", active, len(history))
+				}
+				return
+			}
+			if len(history) != 2 || history[0].Text != "manifest" || event.Version != 2 {
+				t.Fatalf("history %+v event version %d", history, event.Version)
+			}
+			if active.Version != 2 || active.Text != "manifest v2" || active.Proposer != proposer || active.Reason != "reason" {
+				t.Fatalf("active %+v", active)
+			}
+		})
+	}
+}
+
+func TestAmendManifestTwiceInABlock(t *testing.T) {
+	db, idxs := newTestDB(t, nil, 1000)
+	st := db.NewState()
+	// the second amendment edits the version the first one made.
+	amendments := []struct {
+		edit tx.ManifestEdit
+		text string
+	}{
+		{tx.ManifestEdit{Old: "manifest", New: "manifest v2"}, "manifest v2"},
+		{tx.ManifestEdit{Old: "v2", New: "v3"}, "manifest v3"},
+	}
+	for i, a := range amendments {
+		amend := &tx.AmendManifestTx{Edits: []tx.ManifestEdit{a.edit}}
+		event, err := st.AmendManifest(amend, idxs[0], false, tx.VoteAmendManifest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if active, _ := st.GetActiveManifest(); event.Version != uint64(i+2) || active.Text != a.text {
+			t.Fatalf("version %d active %+v, want %q", event.Version, active, a.text)
+		}
+	}
+	commitTestState(t, db, st)
+	history, _, err := db.QueryManifestHistory(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 3 || history[1].Text != "manifest v2" || history[2].Text != "manifest v3" {
+		t.Fatalf("history %+v", history)
+	}
+}
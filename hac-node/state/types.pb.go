@@ -104,12 +104,16 @@ type Account struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index    uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	PubKey   []byte `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
-	Stake    uint64 `protobuf:"varint,3,opt,name=stake,proto3" json:"stake,omitempty"`
-	Nonce    uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	AgentUrl string `protobuf:"bytes,5,opt,name=agentUrl,proto3" json:"agentUrl,omitempty"`
-	Name     string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Index     uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PubKey    []byte   `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Stake     uint64   `protobuf:"varint,3,opt,name=stake,proto3" json:"stake,omitempty"`
+	Nonce     uint64   `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	AgentUrl  string   `protobuf:"bytes,5,opt,name=agentUrl,proto3" json:"agentUrl,omitempty"`
+	Name      string   `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	SelfIntro string   `protobuf:"bytes,7,opt,name=selfIntro,proto3" json:"selfIntro,omitempty"`
+	Signers   [][]byte `protobuf:"bytes,8,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold uint32   `protobuf:"varint,9,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Deposit   uint64   `protobuf:"varint,10,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
 func (x *Account) Reset() {
@@ -186,6 +190,34 @@ func (x *Account) GetName() string {
 	return ""
 }
 
+func (x *Account) GetSelfIntro() string {
+	if x != nil {
+		return x.SelfIntro
+	}
+	return ""
+}
+
+func (x *Account) GetSigners() [][]byte {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *Account) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Account) GetDeposit() uint64 {
+	if x != nil {
+		return x.Deposit
+	}
+	return 0
+}
+
 var File_types_proto protoreflect.FileDescriptor
 
 var file_types_proto_rawDesc = []byte{
@@ -199,7 +231,7 @@ var file_types_proto_rawDesc = []byte{
 	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
 	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x49, 0x64, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x64, 0x78, 0x22, 0x93, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x78, 0x22, 0x83, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b,
 	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
@@ -208,7 +240,14 @@ var file_types_proto_rawDesc = []byte{
 	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
 	0x61, 0x67, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x61, 0x67, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x5a, 0x08,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x0a, 0x5a, 0x08,
 	0x2e, 0x2e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -0,0 +1,226 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+
+	dbm "github.com/cosmos/iavl/db"
+	hac_types "github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// The unbonding stake of an account is kept under KeyStakesReleaseHeight,
+// by account and release height. KeyRetractsReleaseHeight queues it across
+// accounts by release height and points at the former, so the unbondings
+// due are found by range.
+
+// GetUnbondings returns the stake of the account waiting in the unbonding
+// queue, by release height.
+func (s *State) GetUnbondings(account uint64) (unbondings []hac_types.Unbonding, err error) {
+	stored, err := accountUnbondings(s.db.Iterator, account)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range stored {
+		if !s.unbondingRemoved(u) {
+			unbondings = append(unbondings, u)
+		}
+	}
+	// the unbondings of this block are not written yet.
+	for _, u := range s.newUnbondings {
+		if u.Account == account {
+			unbondings = append(unbondings, u)
+		}
+	}
+	return unbondings, nil
+}
+
+// accountUnbondings reads the unbondings of an account off the tree.
+func accountUnbondings(iterator func(start, end []byte, ascending bool) (dbm.Iterator, error), account uint64) (unbondings []hac_types.Unbonding, err error) {
+	start := []byte(fmt.Sprintf(KeyStakesReleaseHeight, account, uint64(0)))
+	end := []byte(fmt.Sprintf(KeyStakesReleaseHeight, account+1, uint64(0)))
+	it, err := iterator(start, end, true)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var u hac_types.Unbonding
+		err = json.Unmarshal(it.Value(), &u)
+		if err != nil {
+			return nil, err
+		}
+		unbondings = append(unbondings, u)
+	}
+	return unbondings, it.Error()
+}
+
+// addUnbonding queues u, merged with the unbonding of the account already
+// released at the same height if any.
+func (s *State) addUnbonding(u hac_types.Unbonding) error {
+	unbondings, err := s.GetUnbondings(u.Account)
+	if err != nil {
+		return err
+	}
+	for _, prev := range unbondings {
+		if prev.ReleaseHeight == u.ReleaseHeight {
+			s.removeUnbonding(prev)
+			u.Amount += prev.Amount
+		}
+	}
+	s.newUnbondings = append(s.newUnbondings, u)
+	return nil
+}
+
+func (s *State) removeUnbonding(u hac_types.Unbonding) {
+	for i, n := range s.newUnbondings {
+		if n.Account == u.Account && n.ReleaseHeight == u.ReleaseHeight {
+			s.newUnbondings = append(s.newUnbondings[:i], s.newUnbondings[i+1:]...)
+			return
+		}
+	}
+	s.delUnbondings = append(s.delUnbondings, u)
+}
+
+func (s *State) unbondingRemoved(u hac_types.Unbonding) bool {
+	for _, d := range s.delUnbondings {
+		if d.Account == u.Account && d.ReleaseHeight == u.ReleaseHeight {
+			return true
+		}
+	}
+	return false
+}
+
+// slashUnbondings removes the unbondings retracted at height since or later
+// from the queue and returns the stake they held.
+func (s *State) slashUnbondings(unbondings []hac_types.Unbonding, since uint64) (amount uint64) {
+	for _, u := range unbondings {
+		if u.Height < since {
+			continue
+		}
+		s.removeUnbonding(u)
+		amount += u.Amount
+	}
+	return
+}
+
+// ReleaseUnbondings releases the unbondings whose release height is
+// reached. It runs after the txs and the slashing of the block, so stake
+// due now can still be slashed by them.
+func (s *State) ReleaseUnbondings() (events []*hac_types.EventStakeReleased, err error) {
+	start := []byte(fmt.Sprintf(KeyRetractsReleaseHeight, uint64(0), uint64(0)))
+	end := []byte(fmt.Sprintf(KeyRetractsReleaseHeight, s.header.Height+1, uint64(0)))
+	it, err := s.db.Iterator(start, end, true)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([][]byte, 0)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Value())
+	}
+	err = it.Error()
+	it.Close()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		val, err := s.db.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		var u hac_types.Unbonding
+		err = json.Unmarshal(val, &u)
+		if err != nil {
+			return nil, err
+		}
+		if s.unbondingRemoved(u) {
+			continue
+		}
+		a, err := s.GetAccount(u.Account)
+		if err != nil {
+			return nil, err
+		}
+		s.removeUnbonding(u)
+		events = append(events, &hac_types.EventStakeReleased{
+			Validator:     u.Account,
+			Address:       a.Address(),
+			Amount:        u.Amount,
+			RetractHeight: u.Height,
+			Height:        s.header.Height,
+		})
+	}
+	return
+}
+
+// Slash expels the validator of addr for the misbehavior committed at
+// infractionHeight, as a disqualification does: its stake is zeroed along
+// with the unbonding stake that still backed its power then, and its held
+// deposits are forfeited. A retract
+// leaves the validator set two blocks after it is applied. It returns nil
+// if there is nothing left to slash.
+func (s *State) Slash(addr []byte, infractionHeight uint64, reason string) (event *hac_types.EventSlash, err error) {
+	a, err := s.FindAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		return nil, nil
+	}
+	unbondings, err := s.GetUnbondings(a.Index)
+	if err != nil {
+		return nil, err
+	}
+	since := infractionHeight
+	if since > 0 {
+		since--
+	}
+	unbonding := s.slashUnbondings(unbondings, since)
+	if a.Stake == 0 && unbonding == 0 && a.Deposit == 0 {
+		return nil, nil
+	}
+	s.logger.Info("slash", "validator", a.Index, "stake", a.Stake, "unbonding", unbonding, "reason", reason)
+	event = &hac_types.EventSlash{
+		Validator:        a.Index,
+		Address:          a.Address(),
+		Stake:            a.Stake,
+		Unbonding:        unbonding,
+		Reason:           reason,
+		InfractionHeight: infractionHeight,
+		Height:           s.header.Height,
+	}
+	a.Stake = 0
+	s.forfeitDeposit(a)
+	v := s.modifiedAcnts[a.Index]
+	v |= ModifiedFlagMod
+	s.modifiedAcnts[a.Index] = v
+	s.acnts[a.Index] = a.Clone()
+	return
+}
+
+// writeUnbondings writes the unbonding queue changes of the block.
+func (s *State) writeUnbondings() (err error) {
+	for _, u := range s.delUnbondings {
+		_, _, err = s.db.Remove([]byte(fmt.Sprintf(KeyStakesReleaseHeight, u.Account, u.ReleaseHeight)))
+		if err != nil {
+			return
+		}
+		_, _, err = s.db.Remove([]byte(fmt.Sprintf(KeyRetractsReleaseHeight, u.ReleaseHeight, u.Account)))
+		if err != nil {
+			return
+		}
+	}
+	for _, u := range s.newUnbondings {
+		key := fmt.Sprintf(KeyStakesReleaseHeight, u.Account, u.ReleaseHeight)
+		unbondingBz, _ := json.Marshal(u)
+		_, err = s.db.Set([]byte(key), unbondingBz)
+		if err != nil {
+			return
+		}
+		_, err = s.db.Set([]byte(fmt.Sprintf(KeyRetractsReleaseHeight, u.ReleaseHeight, u.Account)), []byte(key))
+		if err != nil {
+			return
+		}
+	}
+	s.delUnbondings = nil
+	s.newUnbondings = nil
+	return
+}
@@ -0,0 +1,146 @@
+package state
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hetu-project/hetu-chaoschain/config"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+)
+
+// retractAt retracts amount of the stake of idx in a block of db at height.
+func retractAt(t *testing.T, db *StateDB, idx uint64, height uint64, amount uint64) {
+	t.Helper()
+	st := db.NewState()
+	st.Header().Height = height
+	if _, err := st.UnStake(&tx.RetractTx{Amount: amount}, idx, false); err != nil {
+		t.Fatal(err)
+	}
+	commitTestState(t, db, st)
+}
+
+func TestSlashUnbondings(t *testing.T) {
+	const (
+		stake  = 1000
+		first  = 100
+		second = 200
+		gap    = 10
+	)
+	tests := []struct {
+		name string
+		// infraction is the height of the misbehavior past the first
+		// retract.
+		infraction uint64
+		// slashed is the unbonding stake slashed, the rest is released.
+		slashed uint64
+	}{
+		{"before both retracts", 0, first + second},
+		{"the block after a retract", 1, first + second},
+		{"two blocks after a retract", 2, second},
+		{"the block after the last retract", gap + 1, second},
+		{"after both retracts", gap + 2, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, stake)
+			h := db.NewState().Header().Height
+			retractAt(t, db, idxs[0], h, first)
+			retractAt(t, db, idxs[0], h+gap, second)
+
+			st := db.NewState()
+			a := testAccount(t, st, idxs[0])
+			event, err := st.Slash(a.AddrBytes(), h+tt.infraction, "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if event == nil || event.Stake != stake-first-second || event.Unbonding != tt.slashed {
+				t.Fatalf("slash %+v, want stake %d unbonding %d", event, stake-first-second, tt.slashed)
+			}
+			// what is left to slash is slashed once.
+			if again, err := st.Slash(a.AddrBytes(), h+tt.infraction, "test"); err != nil || again != nil {
+				t.Fatalf("slash again %+v, err %v", again, err)
+			}
+			commitTestState(t, db, st)
+
+			// the unbondings not slashed are released when due, each once.
+			var released uint64
+			for _, at := range []uint64{h, h + gap} {
+				st = db.NewState()
+				st.Header().Height = at + config.UnbondingBlocks(at)
+				events, err := st.ReleaseUnbondings()
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, e := range events {
+					if e.RetractHeight != at {
+						t.Fatalf("released %+v at %d", e, st.Header().Height)
+					}
+					released += e.Amount
+				}
+				commitTestState(t, db, st)
+			}
+			if released != first+second-tt.slashed {
+				t.Fatalf("released %d, want %d", released, first+second-tt.slashed)
+			}
+			st = db.NewState()
+			if a := testAccount(t, st, idxs[0]); a.Stake != 0 {
+				t.Fatalf("stake %d after slash", a.Stake)
+			}
+			if unbondings, err := st.GetUnbondings(idxs[0]); err != nil || len(unbondings) != 0 {
+				t.Fatalf("unbondings %+v left, err %v", unbondings, err)
+			}
+		})
+	}
+
+	t.Run("unknown validator", func(t *testing.T) {
+		db, _ := newTestDB(t, nil, stake)
+		event, err := db.NewState().Slash([]byte("unknown address"), 1, "test")
+		if err != nil || event != nil {
+			t.Fatalf("slash %+v, err %v", event, err)
+		}
+	})
+}
+
+func TestTopUpStake(t *testing.T) {
+	const stake = 1000
+	tests := []struct {
+		name   string
+		amount uint64
+		err    error
+	}{
+		{"top up", 500, nil},
+		{"zero amount", 0, ErrTxAmountInvalid},
+		{"overflow", math.MaxUint64, ErrTxAmountInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, idxs := newTestDB(t, nil, stake)
+			st := db.NewState()
+			event, err := st.TopUpStake(&tx.StakeTx{Amount: tt.amount, Statement: "more"}, idxs[0], false)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			// a top up applies directly, without a vote.
+			if event.Stake != stake+tt.amount || event.Amount != tt.amount || event.Statement != "more" {
+				t.Fatalf("event %+v", event)
+			}
+			commitTestState(t, db, st)
+			a := testAccount(t, db.NewState(), idxs[0])
+			if a.Stake != stake+tt.amount || a.Nonce != 1 {
+				t.Fatalf("account stake %d nonce %d", a.Stake, a.Nonce)
+			}
+		})
+	}
+
+	t.Run("not a member", func(t *testing.T) {
+		db, idxs := newTestDB(t, nil, stake)
+		retractAt(t, db, idxs[0], db.NewState().Header().Height, stake)
+		if _, err := db.NewState().TopUpStake(&tx.StakeTx{Amount: 1}, idxs[0], true); !errors.Is(err, ErrTxNotMembership) {
+			t.Fatalf("err %v, want %v", err, ErrTxNotMembership)
+		}
+	})
+}
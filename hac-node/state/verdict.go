@@ -0,0 +1,115 @@
+package state
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	dbm "github.com/cosmos/iavl/db"
+)
+
+// Verdict is the agent's judgement this node voted with on a governance tx
+// proposed in a round of a height.
+type Verdict struct {
+	Height   uint64 `json:"height"`
+	Round    int32  `json:"round"`
+	TxHash   string `json:"tx_hash"`
+	Code     int64  `json:"code"`
+	Reason   string `json:"reason"`
+	Fallback string `json:"fallback,omitempty"`
+}
+
+// VerdictDB is a local store of the verdicts this node voted with, kept
+// next to the state db. It is not part of the consensus state: it lets a
+// node replaying ProcessProposal after a crash vote as it already signed
+// instead of asking the agent again.
+type VerdictDB struct {
+	logger cmtlog.Logger
+	db     dbm.DB
+}
+
+func NewVerdictDB(dir string, logger cmtlog.Logger) (db *VerdictDB, err error) {
+	logger = logger.With("module", "verdictdb")
+	ldb, err := dbm.NewDB("verdict", "goleveldb", dir)
+	if err != nil {
+		return nil, err
+	}
+	db = &VerdictDB{
+		logger: logger,
+		db:     ldb,
+	}
+	return
+}
+
+func (db *VerdictDB) Close() (err error) {
+	err = db.db.Close()
+	return
+}
+
+// verdictKey orders verdicts by height so they can be pruned by range. A
+// tx proposed again in a later round of the same height is judged again,
+// each round's vote is signed on its own.
+func verdictKey(height uint64, round int32, txHash string) []byte {
+	key := make([]byte, 12, 12+len(txHash)/2)
+	binary.BigEndian.PutUint64(key, height)
+	binary.BigEndian.PutUint32(key[8:], uint32(round))
+	hash, _ := hex.DecodeString(txHash)
+	return append(key, hash...)
+}
+
+// Get returns the verdict on a tx in round of height, or nil if there is
+// none.
+func (db *VerdictDB) Get(height uint64, round int32, txHash string) (v *Verdict, err error) {
+	dat, err := db.db.Get(verdictKey(height, round, txHash))
+	if err != nil || dat == nil {
+		return nil, err
+	}
+	v = &Verdict{}
+	err = json.Unmarshal(dat, v)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Put stores a verdict, synced to disk before the vote using it is signed.
+func (db *VerdictDB) Put(v *Verdict) (err error) {
+	dat, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	batch := db.db.NewBatch()
+	defer batch.Close()
+	err = batch.Set(verdictKey(v.Height, v.Round, v.TxHash), dat)
+	if err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// Prune drops the verdicts below height, which are settled by committed
+// blocks and never asked for again.
+func (db *VerdictDB) Prune(height uint64) (err error) {
+	end := make([]byte, 8)
+	binary.BigEndian.PutUint64(end, height)
+	it, err := db.db.Iterator(nil, end)
+	if err != nil {
+		return err
+	}
+	batch := db.db.NewBatch()
+	defer batch.Close()
+	for ; it.Valid(); it.Next() {
+		err = batch.Delete(it.Key())
+		if err != nil {
+			it.Close()
+			return err
+		}
+	}
+	err = it.Error()
+	it.Close()
+	if err != nil {
+		return err
+	}
+	return batch.Write()
+}
@@ -0,0 +1,68 @@
+package state
+
+import (
+	"testing"
+
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+)
+
+func TestVerdictDB(t *testing.T) {
+	const txHash = "aabbcc"
+	tests := []struct {
+		name   string
+		height uint64
+		round  int32
+		txHash string
+		// prune is the height the verdicts are pruned below first, 0 for
+		// none.
+		prune uint64
+		found bool
+	}{
+		{"stored", 5, 1, txHash, 0, true},
+		{"other round", 5, 0, txHash, 0, false},
+		{"other height", 6, 1, txHash, 0, false},
+		{"other tx", 5, 1, "ddeeff", 0, false},
+		{"kept by prune", 5, 1, txHash, 5, true},
+		{"pruned", 5, 1, txHash, 6, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			db, err := NewVerdictDB(dir, cmtlog.NewNopLogger())
+			if err != nil {
+				t.Fatal(err)
+			}
+			v := &Verdict{Height: 5, Round: 1, TxHash: txHash, Code: 201, Reason: "reason", Fallback: "agent_error"}
+			if err := db.Put(v); err != nil {
+				t.Fatal(err)
+			}
+			if tt.prune != 0 {
+				if err := db.Prune(tt.prune); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// the verdicts outlive a restart.
+			if err := db.Close(); err != nil {
+				t.Fatal(err)
+			}
+			db, err = NewVerdictDB(dir, cmtlog.NewNopLogger())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			got, err := db.Get(tt.height, tt.round, tt.txHash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.found {
+				if got != nil {
+					t.Fatalf("verdict %+v, want none", got)
+				}
+				return
+			}
+			if got == nil || *got != *v {
+				t.Fatalf("verdict %+v, want %+v", got, v)
+			}
+		})
+	}
+}
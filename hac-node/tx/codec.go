@@ -0,0 +1,495 @@
+package tx
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// A version 2 tx is laid out as
+//
+//	version | type | encoding | compress | envelope
+//
+// one byte each for the HACExtTxHeader, then the TxEnvelope, compressed
+// when it is large. The signers sign the SignDoc of the tx, made of the
+// same deterministic protobuf encoding of its body, so the sign bytes do
+// not depend on how the tx is compressed or on any JSON encoder.
+//
+// A tx has a single encoding, hence a single hash, so that it can not sit
+// in the mempool or be replayed under several: the envelope is zstd
+// compressed, with the settings of zstdEncoder, exactly when it is at least
+// MinCompressLen long and compresses, and a tx is only accepted as
+// marshalBinary encodes it.
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault), zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxTxDecodedLen))
+)
+
+// Bytes returns the header as it prefixes a version 2 tx.
+func (h *HACExtTxHeader) Bytes() []byte {
+	return []byte{h.Version, uint8(h.Type), uint8(h.Encoding), uint8(h.Compress)}
+}
+
+// parseExtTxHeader reads the header of a version 2 tx.
+func parseExtTxHeader(dat []byte) (h HACExtTxHeader, err error) {
+	if len(dat) < HACTxPrefixLen {
+		return h, ErrInvalidTx
+	}
+	h.Version = dat[0]
+	h.Type = HACTxType(dat[1])
+	h.Encoding = HACTxEncodingType(dat[2])
+	h.Compress = HACTxCompressType(dat[3])
+	if h.Version != HACTxVersion2 {
+		return h, ErrUnsupportedTxVersion
+	}
+	if h.Encoding != HACTxEncodingProto {
+		return h, ErrUnsupportedTxEncoding
+	}
+	return h, nil
+}
+
+// isBinaryTx reports whether dat is a version 2 tx rather than JSON, which
+// starts with '{'.
+func isBinaryTx(dat []byte) bool {
+	return len(dat) >= HACTxPrefixLen && dat[0] == HACTxVersion2
+}
+
+var marshalOptions = proto.MarshalOptions{Deterministic: true}
+
+// sigDataBinary returns the sign bytes of a version 2 tx.
+func (tx *HACTx) sigDataBinary(chainId []byte) ([]byte, error) {
+	body, err := encodeBody(tx.Type, tx.Tx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalOptions.Marshal(&SignDoc{
+		ChainId:   string(chainId),
+		Version:   uint32(tx.Version),
+		Type:      uint32(tx.Type),
+		Nonce:     tx.Nonce,
+		Validator: tx.Validator,
+		Body:      body,
+	})
+}
+
+// marshalBinary encodes a version 2 tx in its canonical form. tx.Compress
+// is not taken into account.
+func marshalBinary(tx *HACTx) ([]byte, error) {
+	body, err := encodeBody(tx.Type, tx.Tx)
+	if err != nil {
+		return nil, err
+	}
+	envelope, err := marshalOptions.Marshal(&TxEnvelope{
+		Nonce:     tx.Nonce,
+		Validator: tx.Validator,
+		Body:      body,
+		Sig:       tx.Sig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	h := HACExtTxHeader{
+		HACTxHeader: HACTxHeader{Version: HACTxVersion2, Type: tx.Type},
+		Encoding:    HACTxEncodingProto,
+		Compress:    HACTxCompressNone,
+	}
+	if len(envelope) >= MinCompressLen {
+		compressed := zstdEncoder.EncodeAll(envelope, nil)
+		if len(compressed) < len(envelope) {
+			h.Compress = HACTxCompressZstd
+			envelope = compressed
+		}
+	}
+	return append(h.Bytes(), envelope...), nil
+}
+
+// unmarshalBinary decodes a version 2 tx, refusing it unless dat is its
+// canonical encoding.
+func unmarshalBinary(dat []byte) (*HACTx, error) {
+	h, err := parseExtTxHeader(dat)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := decompress(h.Compress, dat[HACTxPrefixLen:])
+	if err != nil {
+		return nil, err
+	}
+	var envelope TxEnvelope
+	err = unmarshalCanonical(raw, &envelope)
+	if err != nil {
+		return nil, err
+	}
+	body, err := decodeBody(h.Type, envelope.Body)
+	if err != nil {
+		return nil, err
+	}
+	tx := &HACTx{
+		Version:   h.Version,
+		Type:      h.Type,
+		Nonce:     envelope.Nonce,
+		Validator: envelope.Validator,
+		Tx:        body,
+		Sig:       envelope.Sig,
+		Encoding:  h.Encoding,
+		Compress:  h.Compress,
+	}
+	canonical, err := marshalBinary(tx)
+	if err != nil || !bytes.Equal(canonical, dat) {
+		return nil, fmt.Errorf("%w: not canonical", ErrUnsupportedTxData)
+	}
+	return tx, nil
+}
+
+// unmarshalCanonical decodes dat into msg, refusing any encoding of it but
+// the deterministic one: unknown fields, fields out of order or default
+// values written out.
+func unmarshalCanonical(dat []byte, msg proto.Message) error {
+	err := proto.Unmarshal(dat, msg)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnsupportedTxData, err)
+	}
+	if hasUnknown(msg.ProtoReflect()) {
+		return fmt.Errorf("%w: unknown fields", ErrUnsupportedTxData)
+	}
+	canonical, err := marshalOptions.Marshal(msg)
+	if err != nil || !bytes.Equal(canonical, dat) {
+		return fmt.Errorf("%w: not canonical", ErrUnsupportedTxData)
+	}
+	return nil
+}
+
+// hasUnknown reports whether m or any message within it holds unknown
+// fields.
+func hasUnknown(m protoreflect.Message) bool {
+	if len(m.GetUnknown()) != 0 {
+		return true
+	}
+	unknown := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil || fd.IsMap() {
+			return true
+		}
+		if fd.IsList() {
+			for i := 0; i < v.List().Len() && !unknown; i++ {
+				unknown = hasUnknown(v.List().Get(i).Message())
+			}
+		} else {
+			unknown = hasUnknown(v.Message())
+		}
+		return !unknown
+	})
+	return unknown
+}
+
+func decompress(c HACTxCompressType, dat []byte) ([]byte, error) {
+	switch c {
+	case HACTxCompressNone:
+		return dat, nil
+	case HACTxCompressZstd:
+		raw, err := zstdDecoder.DecodeAll(dat, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnsupportedTxData, err)
+		}
+		if len(raw) > MaxTxDecodedLen {
+			return nil, ErrUnsupportedTxData
+		}
+		return raw, nil
+	default:
+		return nil, ErrUnsupportedTxCompress
+	}
+}
+
+// encodeBody returns the protobuf encoding of the tx of type t.
+func encodeBody(t HACTxType, tx any) ([]byte, error) {
+	var msg proto.Message
+	switch t {
+	case HACTxTypeProposal:
+		b, ok := tx.(*ProposalTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &ProposalBody{
+			EndHeight:       b.EndHeight,
+			ImageUrl:        b.ImageUrl,
+			Title:           b.Title,
+			Link:            b.Link,
+			Data:            b.Data,
+			ExpireTimestamp: uint64(b.ExpireTimestamp),
+			Options:         b.Options,
+		}
+	case HACTxTypeDiscussion:
+		b, ok := tx.(*DiscussionTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &DiscussionBody{Proposal: b.Proposal, Data: b.Data}
+	case HACTxTypeGrant:
+		b, ok := tx.(*GrantTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		body := &GrantBody{}
+		for _, g := range b.Grants {
+			body.Grants = append(body.Grants, &GrantStBody{
+				Statement:   g.Statement,
+				Amount:      g.Amount,
+				AgentUrl:    g.AgentUrl,
+				Name:        g.Name,
+				Pubkey:      g.Pubkey,
+				Pop:         g.Pop,
+				Application: g.Application,
+			})
+		}
+		msg = body
+	case HACTxTypeRetract:
+		b, ok := tx.(*RetractTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &RetractBody{Amount: b.Amount}
+	case HACTxTypeSettleProposal:
+		b, ok := tx.(*SettleProposalTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &SettleProposalBody{Proposal: b.Proposal, ExpireTimestamp: uint64(b.ExpireTimestamp)}
+	case HACTxTypeRationale:
+		b, ok := tx.(*RationaleTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		body := &RationaleBody{Height: b.Height, Round: b.Round}
+		for _, v := range b.Votes {
+			body.Votes = append(body.Votes, &RationaleVoteBody{
+				Validator: v.Validator,
+				Extension: v.Extension,
+				Signature: v.Signature,
+			})
+		}
+		msg = body
+	case HACTxTypeDisqualify:
+		b, ok := tx.(*DisqualifyTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &DisqualifyBody{Target: b.Target, Clause: b.Clause, Evidence: b.Evidence}
+	case HACTxTypeAmendManifest:
+		b, ok := tx.(*AmendManifestTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		body := &AmendManifestBody{Text: b.Text, Reason: b.Reason}
+		for _, e := range b.Edits {
+			body.Edits = append(body.Edits, &ManifestEditBody{Old: e.Old, New: e.New})
+		}
+		msg = body
+	case HACTxTypeStake:
+		b, ok := tx.(*StakeTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &StakeBody{Amount: b.Amount, Statement: b.Statement}
+	case HACTxTypeApply:
+		b, ok := tx.(*ApplyTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &ApplyBody{
+			Pubkey:          b.Pubkey,
+			Statement:       b.Statement,
+			AgentUrl:        b.AgentUrl,
+			Name:            b.Name,
+			ManifestVersion: b.ManifestVersion,
+		}
+	case HACTxTypeUpdateProfile:
+		b, ok := tx.(*UpdateProfileTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &UpdateProfileBody{Name: b.Name, AgentUrl: b.AgentUrl, SelfIntro: b.SelfIntro}
+	case HACTxTypeRotateKey:
+		b, ok := tx.(*RotateKeyTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &RotateKeyBody{Pubkey: b.Pubkey, Pop: b.Pop}
+	case HACTxTypeSetSigners:
+		b, ok := tx.(*SetSignersTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &SetSignersBody{Signers: b.Signers, Threshold: b.Threshold, Pops: b.Pops}
+	case HACTxTypeSession:
+		b, ok := tx.(*SessionTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		body := &SessionBody{Pubkey: b.Pubkey, ExpireHeight: b.ExpireHeight, RateCap: b.RateCap, Pop: b.Pop}
+		for _, t := range b.Scope {
+			body.Scope = append(body.Scope, uint32(t))
+		}
+		msg = body
+	case HACTxTypeRevokeSession:
+		b, ok := tx.(*RevokeSessionTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &RevokeSessionBody{Address: b.Address}
+	case HACTxTypeAmendParams:
+		b, ok := tx.(*AmendParamsTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		body := &AmendParamsBody{Reason: b.Reason}
+		for _, c := range b.Changes {
+			body.Changes = append(body.Changes, &ParamChangeBody{Key: c.Key, Value: c.Value})
+		}
+		msg = body
+	case HACTxTypeGeneric:
+		b, ok := tx.(*GenericTx)
+		if !ok {
+			return nil, ErrUnmatchedTxType
+		}
+		msg = &GenericBody{Subtype: b.Subtype, Data: b.Data}
+	default:
+		return nil, ErrUnsupportedTxType
+	}
+	return marshalOptions.Marshal(msg)
+}
+
+// decodeBody returns the tx of type t encoded in dat.
+func decodeBody(t HACTxType, dat []byte) (any, error) {
+	var msg proto.Message
+	switch t {
+	case HACTxTypeProposal:
+		msg = &ProposalBody{}
+	case HACTxTypeDiscussion:
+		msg = &DiscussionBody{}
+	case HACTxTypeGrant:
+		msg = &GrantBody{}
+	case HACTxTypeRetract:
+		msg = &RetractBody{}
+	case HACTxTypeSettleProposal:
+		msg = &SettleProposalBody{}
+	case HACTxTypeRationale:
+		msg = &RationaleBody{}
+	case HACTxTypeDisqualify:
+		msg = &DisqualifyBody{}
+	case HACTxTypeAmendManifest:
+		msg = &AmendManifestBody{}
+	case HACTxTypeStake:
+		msg = &StakeBody{}
+	case HACTxTypeApply:
+		msg = &ApplyBody{}
+	case HACTxTypeUpdateProfile:
+		msg = &UpdateProfileBody{}
+	case HACTxTypeRotateKey:
+		msg = &RotateKeyBody{}
+	case HACTxTypeSetSigners:
+		msg = &SetSignersBody{}
+	case HACTxTypeSession:
+		msg = &SessionBody{}
+	case HACTxTypeRevokeSession:
+		msg = &RevokeSessionBody{}
+	case HACTxTypeAmendParams:
+		msg = &AmendParamsBody{}
+	case HACTxTypeGeneric:
+		msg = &GenericBody{}
+	default:
+		return nil, ErrUnsupportedTxType
+	}
+	err := unmarshalCanonical(dat, msg)
+	if err != nil {
+		return nil, err
+	}
+	switch b := msg.(type) {
+	case *ProposalBody:
+		return &ProposalTx{
+			EndHeight:       b.EndHeight,
+			ImageUrl:        b.ImageUrl,
+			Title:           b.Title,
+			Link:            b.Link,
+			Data:            b.Data,
+			ExpireTimestamp: uint(b.ExpireTimestamp),
+			Options:         b.Options,
+		}, nil
+	case *DiscussionBody:
+		return &DiscussionTx{Proposal: b.Proposal, Data: b.Data}, nil
+	case *GrantBody:
+		grant := &GrantTx{}
+		for _, g := range b.Grants {
+			grant.Grants = append(grant.Grants, GrantSt{
+				Statement:   g.Statement,
+				Amount:      g.Amount,
+				AgentUrl:    g.AgentUrl,
+				Name:        g.Name,
+				Pubkey:      g.Pubkey,
+				Pop:         g.Pop,
+				Application: g.Application,
+			})
+		}
+		return grant, nil
+	case *RetractBody:
+		return &RetractTx{Amount: b.Amount}, nil
+	case *SettleProposalBody:
+		return &SettleProposalTx{Proposal: b.Proposal, ExpireTimestamp: uint(b.ExpireTimestamp)}, nil
+	case *RationaleBody:
+		rationale := &RationaleTx{Height: b.Height, Round: b.Round}
+		for _, v := range b.Votes {
+			rationale.Votes = append(rationale.Votes, RationaleVote{
+				Validator: v.Validator,
+				Extension: v.Extension,
+				Signature: v.Signature,
+			})
+		}
+		return rationale, nil
+	case *DisqualifyBody:
+		return &DisqualifyTx{Target: b.Target, Clause: b.Clause, Evidence: b.Evidence}, nil
+	case *AmendManifestBody:
+		amend := &AmendManifestTx{Text: b.Text, Reason: b.Reason}
+		for _, e := range b.Edits {
+			amend.Edits = append(amend.Edits, ManifestEdit{Old: e.Old, New: e.New})
+		}
+		return amend, nil
+	case *StakeBody:
+		return &StakeTx{Amount: b.Amount, Statement: b.Statement}, nil
+	case *ApplyBody:
+		return &ApplyTx{
+			Pubkey:          b.Pubkey,
+			Statement:       b.Statement,
+			AgentUrl:        b.AgentUrl,
+			Name:            b.Name,
+			ManifestVersion: b.ManifestVersion,
+		}, nil
+	case *UpdateProfileBody:
+		return &UpdateProfileTx{Name: b.Name, AgentUrl: b.AgentUrl, SelfIntro: b.SelfIntro}, nil
+	case *RotateKeyBody:
+		return &RotateKeyTx{Pubkey: b.Pubkey, Pop: b.Pop}, nil
+	case *SetSignersBody:
+		return &SetSignersTx{Signers: b.Signers, Threshold: b.Threshold, Pops: b.Pops}, nil
+	case *SessionBody:
+		session := &SessionTx{Pubkey: b.Pubkey, ExpireHeight: b.ExpireHeight, RateCap: b.RateCap, Pop: b.Pop}
+		for _, t := range b.Scope {
+			if t > 0xff {
+				return nil, ErrUnsupportedTxData
+			}
+			session.Scope = append(session.Scope, HACTxType(t))
+		}
+		return session, nil
+	case *RevokeSessionBody:
+		return &RevokeSessionTx{Address: b.Address}, nil
+	case *AmendParamsBody:
+		amend := &AmendParamsTx{Reason: b.Reason}
+		for _, c := range b.Changes {
+			amend.Changes = append(amend.Changes, ParamChange{Key: c.Key, Value: c.Value})
+		}
+		return amend, nil
+	case *GenericBody:
+		return &GenericTx{Subtype: b.Subtype, Data: b.Data}, nil
+	}
+	return nil, ErrUnsupportedTxType
+}
@@ -0,0 +1,130 @@
+package tx
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+func testProposalTx(data []byte) *HACTx {
+	return &HACTx{
+		Version:   HACTxVersion2,
+		Type:      HACTxTypeProposal,
+		Nonce:     7,
+		Validator: 65536,
+		Tx: &ProposalTx{
+			EndHeight: 100,
+			Title:     "title",
+			Data:      data,
+			Options:   []string{"a", "b"},
+		},
+		Sig: [][]byte{bytes.Repeat([]byte{1}, 64)},
+	}
+}
+
+func randomBytes(t *testing.T, n int) []byte {
+	dat := make([]byte, n)
+	if _, err := rand.Read(dat); err != nil {
+		t.Fatal(err)
+	}
+	return dat
+}
+
+func TestBinaryTxRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		compress HACTxCompressType
+	}{
+		{"small", []byte("data"), HACTxCompressNone},
+		{"large", bytes.Repeat([]byte("data"), 1024), HACTxCompressZstd},
+		{"large incompressible", randomBytes(t, 2*MinCompressLen), HACTxCompressNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			btx := testProposalTx(tt.data)
+			dat, err := MarshalHACTx(btx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if HACTxCompressType(dat[3]) != tt.compress {
+				t.Fatalf("compress %d, want %d", dat[3], tt.compress)
+			}
+			got, err := UnmarshalHACTx(dat)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Compress != tt.compress || got.Nonce != btx.Nonce || got.Validator != btx.Validator ||
+				!reflect.DeepEqual(got.Tx, btx.Tx) || !reflect.DeepEqual(got.Sig, btx.Sig) {
+				t.Fatalf("decoded %+v, want %+v", got, btx)
+			}
+			sig, err := btx.SigData([]byte("chain"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotSig, err := got.SigData([]byte("chain"))
+			if err != nil || !bytes.Equal(sig, gotSig) {
+				t.Fatalf("sign bytes differ, err %v", err)
+			}
+		})
+	}
+}
+
+// envelopeOf returns the uncompressed envelope of a tx encoded by
+// MarshalHACTx.
+func envelopeOf(t *testing.T, dat []byte) []byte {
+	raw, err := decompress(HACTxCompressType(dat[3]), dat[HACTxPrefixLen:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func withHeader(dat []byte, c HACTxCompressType, envelope []byte) []byte {
+	out := append([]byte{}, dat[:HACTxPrefixLen]...)
+	out[3] = byte(c)
+	return append(out, envelope...)
+}
+
+func TestBinaryTxRejectsNonCanonical(t *testing.T) {
+	small, err := MarshalHACTx(testProposalTx([]byte("data")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	large, err := MarshalHACTx(testProposalTx(bytes.Repeat([]byte("data"), 1024)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	noCRC, err := zstd.NewWriter(nil, zstd.WithEncoderCRC(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// field 100, varint 1
+	unknown := append(append([]byte{}, envelopeOf(t, small)...), 0xa0, 0x06, 0x01)
+
+	tests := []struct {
+		name string
+		dat  []byte
+		err  error
+	}{
+		{"large uncompressed", withHeader(large, HACTxCompressNone, envelopeOf(t, large)), ErrUnsupportedTxData},
+		{"small compressed", withHeader(small, HACTxCompressZstd, zstdEncoder.EncodeAll(envelopeOf(t, small), nil)), ErrUnsupportedTxData},
+		{"other zstd frame", withHeader(large, HACTxCompressZstd, noCRC.EncodeAll(envelopeOf(t, large), nil)), ErrUnsupportedTxData},
+		{"unknown codec", withHeader(small, 2, envelopeOf(t, small)), ErrUnsupportedTxCompress},
+		{"unknown field", withHeader(small, HACTxCompressNone, unknown), ErrUnsupportedTxData},
+		{"trailing bytes", append(append([]byte{}, large...), 0), ErrUnsupportedTxData},
+		{"short", small[:HACTxPrefixLen-1], ErrUnsupportedTxType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalHACTx(tt.dat)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package tx
+
+import (
+	"fmt"
+	"sync"
+)
+
+// A generic tx carries a tx type added by a module rather than by this
+// package. Its Subtype names the module and its Data is decoded by the
+// decoder the module registered, so that a module adds a tx type without
+// changing the encoding of txs.
+
+// GenericDecoder decodes the data of a generic tx of a subtype into the
+// payload its handler works on.
+type GenericDecoder func(data []byte) (any, error)
+
+type genericType struct {
+	decode     GenericDecoder
+	governance bool
+}
+
+var (
+	genericMtx   sync.RWMutex
+	genericTypes = make(map[string]*genericType)
+)
+
+// RegisterGenericTx registers the decoder of the generic txs of subtype.
+// Txs of a governance subtype are voted on by the validators like the
+// governance txs of this package. A subtype is registered once, before the
+// first tx of it is decoded.
+func RegisterGenericTx(subtype string, decode GenericDecoder, governance bool) error {
+	err := validSubtype(subtype)
+	if err != nil {
+		return err
+	}
+	if decode == nil {
+		return fmt.Errorf("%w: no decoder for %s", ErrInvalidGeneric, subtype)
+	}
+	genericMtx.Lock()
+	defer genericMtx.Unlock()
+	if _, ok := genericTypes[subtype]; ok {
+		return fmt.Errorf("%w: %s", ErrGenericTxRegistered, subtype)
+	}
+	genericTypes[subtype] = &genericType{decode: decode, governance: governance}
+	return nil
+}
+
+func lookupGenericTx(subtype string) *genericType {
+	genericMtx.RLock()
+	defer genericMtx.RUnlock()
+	return genericTypes[subtype]
+}
+
+// validSubtype checks that subtype is 1 to 32 lower case letters, digits or
+// underscores, so that it can name the events of its txs.
+func validSubtype(subtype string) error {
+	if len(subtype) == 0 || len(subtype) > 32 {
+		return fmt.Errorf("%w: subtype length", ErrInvalidGeneric)
+	}
+	for _, c := range subtype {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '_' {
+			return fmt.Errorf("%w: subtype %q", ErrInvalidGeneric, subtype)
+		}
+	}
+	return nil
+}
+
+// GenericTx is a tx of the module registered for Subtype.
+type GenericTx struct {
+	Subtype string `json:"subtype"`
+	Data    []byte `json:"data"`
+	// Payload is Data as decoded by the decoder of Subtype.
+	Payload any `json:"-"`
+}
+
+// ValidateBasic checks the subtype name and the data size.
+func (tx *GenericTx) ValidateBasic() error {
+	err := validSubtype(tx.Subtype)
+	if err != nil {
+		return err
+	}
+	if len(tx.Data) > MaxGenericDataLen {
+		return fmt.Errorf("%w: data too long", ErrInvalidGeneric)
+	}
+	return nil
+}
+
+// decode sets the payload of the tx with the decoder of its subtype. A tx
+// of a subtype no module registered is not supported.
+func (tx *GenericTx) decode() error {
+	err := tx.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	t := lookupGenericTx(tx.Subtype)
+	if t == nil {
+		return fmt.Errorf("%w: generic subtype %s", ErrUnsupportedTxType, tx.Subtype)
+	}
+	tx.Payload, err = t.decode(tx.Data)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidGeneric, err)
+	}
+	return nil
+}
+
+// Governance reports whether the tx is decided by the validators' vote. It
+// extends HACTxType.Governance to the subtypes of generic txs.
+func (btx *HACTx) Governance() bool {
+	if btx.Type != HACTxTypeGeneric {
+		return btx.Type.Governance()
+	}
+	gtx, ok := btx.Tx.(*GenericTx)
+	if !ok {
+		return false
+	}
+	t := lookupGenericTx(gtx.Subtype)
+	return t != nil && t.governance
+}
@@ -0,0 +1,96 @@
+package tx
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testGenericDecoder decodes data as a string, failing on "bad".
+func testGenericDecoder(data []byte) (any, error) {
+	if string(data) == "bad" {
+		return nil, errors.New("bad data")
+	}
+	return string(data), nil
+}
+
+func TestRegisterGenericTx(t *testing.T) {
+	if err := RegisterGenericTx("test_registered", testGenericDecoder, false); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		subtype string
+		decode  GenericDecoder
+		err     error
+	}{
+		{"registered", "test_register", testGenericDecoder, nil},
+		{"twice", "test_registered", testGenericDecoder, ErrGenericTxRegistered},
+		{"no decoder", "test_no_decoder", nil, ErrInvalidGeneric},
+		{"empty subtype", "", testGenericDecoder, ErrInvalidGeneric},
+		{"subtype too long", strings.Repeat("a", 33), testGenericDecoder, ErrInvalidGeneric},
+		{"upper case subtype", "Test", testGenericDecoder, ErrInvalidGeneric},
+		{"dotted subtype", "test.dot", testGenericDecoder, ErrInvalidGeneric},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterGenericTx(tt.subtype, tt.decode, false)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+			if registered := lookupGenericTx(tt.subtype) != nil; registered != (tt.err == nil || tt.err == ErrGenericTxRegistered) {
+				t.Fatalf("registered %v", registered)
+			}
+		})
+	}
+}
+
+func TestGenericTxDecode(t *testing.T) {
+	if err := RegisterGenericTx("test_decode", testGenericDecoder, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterGenericTx("test_decode_gov", testGenericDecoder, true); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		gtx        *GenericTx
+		governance bool
+		err        error
+	}{
+		{"decoded", &GenericTx{Subtype: "test_decode", Data: []byte("data")}, false, nil},
+		{"governance", &GenericTx{Subtype: "test_decode_gov", Data: []byte("data")}, true, nil},
+		{"max data", &GenericTx{Subtype: "test_decode", Data: bytes.Repeat([]byte("a"), MaxGenericDataLen)}, false, nil},
+		{"data too long", &GenericTx{Subtype: "test_decode", Data: bytes.Repeat([]byte("a"), MaxGenericDataLen+1)}, false, ErrInvalidGeneric},
+		{"decoder fails", &GenericTx{Subtype: "test_decode", Data: []byte("bad")}, false, ErrInvalidGeneric},
+		{"unregistered subtype", &GenericTx{Subtype: "test_unregistered", Data: []byte("data")}, false, ErrUnsupportedTxType},
+		{"invalid subtype", &GenericTx{Subtype: "Test", Data: []byte("data")}, false, ErrInvalidGeneric},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Both the JSON and the binary encoding decode the payload.
+			for _, version := range []uint8{HACTxVersion1, HACTxVersion2} {
+				btx := &HACTx{Version: version, Type: HACTxTypeGeneric, Validator: 1, Tx: tt.gtx}
+				dat, err := MarshalHACTx(btx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, err := UnmarshalHACTx(dat)
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("version %d: err %v, want %v", version, err, tt.err)
+				}
+				if err != nil {
+					continue
+				}
+				gtx := got.Tx.(*GenericTx)
+				if gtx.Payload != string(tt.gtx.Data) {
+					t.Fatalf("version %d: payload %v", version, gtx.Payload)
+				}
+				if got.Governance() != tt.governance {
+					t.Fatalf("version %d: governance %v, want %v", version, got.Governance(), tt.governance)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+type AmendManifestTxHandler struct {
+	logger cmtlog.Logger
+}
+
+func NewAmendManifestTxHandler(logger cmtlog.Logger) (h *AmendManifestTxHandler) {
+	logger = logger.With("module", "amendManifestTx")
+	h = &AmendManifestTxHandler{
+		logger: logger,
+	}
+	return
+}
+
+func (h *AmendManifestTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 0}
+	atx := btx.Tx.(*tx.AmendManifestTx)
+	_, err1 := st.AmendManifest(atx, btx.Validator, true, tx.VoteRejectAmendment)
+	if err1 != nil {
+		h.logger.Info("CheckTx AmendManifestTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
+	return
+}
+
+func (h *AmendManifestTxHandler) NewContext(ctx context.Context) {}
+
+func (h *AmendManifestTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	atx := btx.Tx.(*tx.AmendManifestTx)
+	event, err := st.AmendManifest(atx, btx.Validator, false, code)
+	if err != nil {
+		return nil, err
+	}
+	res = &abcitypes.ExecTxResult{}
+	if event != nil {
+		res.Events = []abcitypes.Event{types.EncodeEventAmendManifest(event)}
+	}
+	return
+}
+
+func (h *AmendManifestTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx, code)
+}
+
+func (h *AmendManifestTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx, code)
+}
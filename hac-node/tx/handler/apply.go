@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+type ApplyTxHandler struct {
+	logger cmtlog.Logger
+
+	applications int
+}
+
+func NewApplyTxHandler(logger cmtlog.Logger) (h *ApplyTxHandler) {
+	logger = logger.With("module", "applyTx")
+	h = &ApplyTxHandler{
+		logger: logger,
+	}
+	return
+}
+
+func (h *ApplyTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 0}
+	atx := btx.Tx.(*tx.ApplyTx)
+	_, err1 := st.Apply(atx, true)
+	if err1 != nil {
+		h.logger.Info("CheckTx ApplyTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
+	return
+}
+
+func (h *ApplyTxHandler) NewContext(ctx context.Context) {
+	h.applications = 0
+}
+
+// handle bounds the applications in a block, as they come from keys that
+// are no accounts yet.
+func (h *ApplyTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ExecTxResult, err error) {
+	if h.applications >= tx.MaxApplicationsPerBlock {
+		return nil, state.ErrTooManyApplications
+	}
+	atx := btx.Tx.(*tx.ApplyTx)
+	event, err := st.Apply(atx, false)
+	if err != nil {
+		return nil, err
+	}
+	h.applications++
+	res = &abcitypes.ExecTxResult{}
+	if event != nil {
+		res.Events = []abcitypes.Event{types.EncodeEventApply(event)}
+	}
+	return
+}
+
+func (h *ApplyTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
+
+func (h *ApplyTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+)
+
+func TestApplicationsPerBlock(t *testing.T) {
+	tests := []struct {
+		name string
+		// applied is the count of applications before the one tested in
+		// the block, of which the first one repeated if repeat.
+		applied  int
+		repeat   bool
+		newBlock bool
+		err      error
+	}{
+		{"first", 0, false, false, nil},
+		{"last", tx.MaxApplicationsPerBlock - 1, false, false, nil},
+		{"one too many", tx.MaxApplicationsPerBlock, false, false, state.ErrTooManyApplications},
+		{"next block", tx.MaxApplicationsPerBlock, false, true, nil},
+		{"failed ones do not count", tx.MaxApplicationsPerBlock + 1, true, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, _ := newTestState(t)
+			h := NewApplyTxHandler(cmtlog.NewNopLogger())
+			h.NewContext(context.Background())
+			apply := func(key ed25519.PrivKey, name string) error {
+				_, err := h.Process(context.Background(), st, &tx.HACTx{Type: tx.HACTxTypeApply, Tx: &tx.ApplyTx{
+					Pubkey: key.PubKey().Bytes(), Statement: "statement", Name: name, AgentUrl: "http://127.0.0.1:3000", ManifestVersion: 1,
+				}}, 0)
+				return err
+			}
+			first := ed25519.GenPrivKey()
+			for i := 0; i < tt.applied; i++ {
+				key := ed25519.GenPrivKey()
+				if i == 0 || tt.repeat {
+					key = first
+				}
+				err := apply(key, fmt.Sprintf("applicant %d", i))
+				if want := i > 0 && tt.repeat; errors.Is(err, state.ErrTxApplicationExists) != want || (err != nil) != want {
+					t.Fatalf("application %d err %v", i, err)
+				}
+			}
+			if tt.newBlock {
+				h.NewContext(context.Background())
+			}
+			if err := apply(ed25519.GenPrivKey(), "applicant"); !errors.Is(err, tt.err) {
+				t.Fatalf("err %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -27,8 +27,8 @@ func (h *DiscussionTxHandler) Check(ctx context.Context, st *state.State, btx *t
 	stx := btx.Tx.(*tx.DiscussionTx)
 	_, err1 := st.Dicussion(stx, btx.Validator, true)
 	if err1 != nil {
-		h.logger.Info("CheckTx stake fail", "err", err1)
-		res.Code = 1
+		h.logger.Info("CheckTx discussion fail", "err", err1)
+		res.Code = txCode(err1)
 		res.Log = err1.Error()
 	}
 	return
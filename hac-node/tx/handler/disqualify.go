@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+type DisqualifyTxHandler struct {
+	logger cmtlog.Logger
+
+	targetSet map[uint64]bool
+}
+
+func NewDisqualifyTxHandler(logger cmtlog.Logger) (h *DisqualifyTxHandler) {
+	logger = logger.With("module", "disqualifyTx")
+	h = &DisqualifyTxHandler{
+		logger:    logger,
+		targetSet: make(map[uint64]bool),
+	}
+	return
+}
+
+func (h *DisqualifyTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 0}
+	dtx := btx.Tx.(*tx.DisqualifyTx)
+	_, err1 := st.Disqualify(dtx, btx.Validator, true, tx.VoteKeepMember)
+	if err1 != nil {
+		h.logger.Info("CheckTx DisqualifyTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
+	return
+}
+
+func (h *DisqualifyTxHandler) NewContext(ctx context.Context) {
+	h.targetSet = make(map[uint64]bool)
+}
+
+// handle allows one disqualification per target in a block, so a member
+// is not voted out twice over.
+func (h *DisqualifyTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	dtx := btx.Tx.(*tx.DisqualifyTx)
+	if _, ok := h.targetSet[dtx.Target]; ok {
+		return nil, state.ErrOneActionInOneBlock
+	}
+	event, err := st.Disqualify(dtx, btx.Validator, false, code)
+	if err != nil {
+		return nil, err
+	}
+	h.targetSet[dtx.Target] = true
+	res = &abcitypes.ExecTxResult{}
+	if event != nil {
+		res.Events = []abcitypes.Event{types.EncodeEventDisqualify(event)}
+	}
+	return
+}
+
+func (h *DisqualifyTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx, code)
+}
+
+func (h *DisqualifyTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx, code)
+}
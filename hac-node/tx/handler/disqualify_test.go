@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+)
+
+func TestDisqualifyOnePerTarget(t *testing.T) {
+	st, idxs := newTestState(t, 1000, 1000, 500, 500)
+	h := NewDisqualifyTxHandler(cmtlog.NewNopLogger())
+	h.NewContext(context.Background())
+	dq := func(accuser, target uint64) *tx.HACTx {
+		return &tx.HACTx{Type: tx.HACTxTypeDisqualify, Validator: accuser, Tx: &tx.DisqualifyTx{Target: target, Clause: "manifest", Evidence: "evidence"}}
+	}
+	// the steps run in order in the same block, unless a new block starts.
+	tests := []struct {
+		name     string
+		newBlock bool
+		btx      *tx.HACTx
+		code     tx.VoteCode
+		err      error
+	}{
+		{"first of a target", false, dq(idxs[0], idxs[2]), tx.VoteKeepMember, nil},
+		{"again by another accuser", false, dq(idxs[1], idxs[2]), tx.VoteKeepMember, state.ErrOneActionInOneBlock},
+		{"other target", false, dq(idxs[0], idxs[3]), tx.VoteKeepMember, nil},
+		{"failed one", false, dq(idxs[0], idxs[0]), tx.VoteKeepMember, state.ErrTxDisqualifySelf},
+		{"target of a failed one", false, dq(idxs[1], idxs[0]), tx.VoteKeepMember, nil},
+		{"next block", true, dq(idxs[1], idxs[2]), tx.VoteDisqualifyMember, nil},
+		{"expelled target", false, dq(idxs[0], idxs[2]), tx.VoteDisqualifyMember, state.ErrOneActionInOneBlock},
+	}
+	for _, tt := range tests {
+		if tt.newBlock {
+			h.NewContext(context.Background())
+		}
+		res, err := h.Process(context.Background(), st, tt.btx, tt.code)
+		if !errors.Is(err, tt.err) {
+			t.Fatalf("%s: err %v, want %v", tt.name, err, tt.err)
+		}
+		if err == nil && (res == nil || len(res.Events) != 1) {
+			t.Fatalf("%s: result %+v", tt.name, res)
+		}
+	}
+}
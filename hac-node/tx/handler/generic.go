@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// GenericTxHandler routes generic txs to the handler of their subtype. The
+// events a subtype handler emits are renamed under the subtype, so that a
+// module can not pass its events off as the chain's.
+type GenericTxHandler struct {
+	logger   cmtlog.Logger
+	handlers map[string]TxHandler
+}
+
+func NewGenericTxHandler(logger cmtlog.Logger, handlers map[string]TxHandler) (h *GenericTxHandler) {
+	logger = logger.With("module", "genericTx")
+	h = &GenericTxHandler{
+		logger:   logger,
+		handlers: handlers,
+	}
+	return
+}
+
+func (h *GenericTxHandler) route(btx *tx.HACTx) (*tx.GenericTx, TxHandler, error) {
+	gtx := btx.Tx.(*tx.GenericTx)
+	sh, ok := h.handlers[gtx.Subtype]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: generic subtype %s", tx.ErrUnsupportedTxType, gtx.Subtype)
+	}
+	return gtx, sh, nil
+}
+
+func (h *GenericTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	_, sh, err1 := h.route(btx)
+	if err1 != nil {
+		h.logger.Info("CheckTx GenericTx fail", "err", err1)
+		res = &abcitypes.ResponseCheckTx{Code: 1, Log: err1.Error()}
+		return
+	}
+	return sh.Check(ctx, st, btx)
+}
+
+func (h *GenericTxHandler) NewContext(ctx context.Context) {
+	for _, sh := range h.handlers {
+		sh.NewContext(ctx)
+	}
+}
+
+// namespace renames the events of res under subtype.
+func namespace(subtype string, res *abcitypes.ExecTxResult) {
+	if res == nil {
+		return
+	}
+	for i := range res.Events {
+		res.Events[i].Type = types.GenericEventType(subtype, res.Events[i].Type)
+	}
+}
+
+func (h *GenericTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	gtx, sh, err := h.route(btx)
+	if err != nil {
+		return nil, err
+	}
+	res, err = sh.Prepare(ctx, st, btx, code)
+	namespace(gtx.Subtype, res)
+	return
+}
+
+func (h *GenericTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	gtx, sh, err := h.route(btx)
+	if err != nil {
+		return nil, err
+	}
+	res, err = sh.Process(ctx, st, btx, code)
+	namespace(gtx.Subtype, res)
+	return
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// testSubtypeHandler emits an event of type "done" and records the code it
+// was last given.
+type testSubtypeHandler struct {
+	code tx.VoteCode
+}
+
+func (h *testSubtypeHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (*abcitypes.ResponseCheckTx, error) {
+	return &abcitypes.ResponseCheckTx{Code: 0}, nil
+}
+
+func (h *testSubtypeHandler) NewContext(ctx context.Context) {}
+
+func (h *testSubtypeHandler) exec(code tx.VoteCode) (*abcitypes.ExecTxResult, error) {
+	h.code = code
+	return &abcitypes.ExecTxResult{Events: []abcitypes.Event{{Type: "done"}}}, nil
+}
+
+func (h *testSubtypeHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (*abcitypes.ExecTxResult, error) {
+	return h.exec(code)
+}
+
+func (h *testSubtypeHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (*abcitypes.ExecTxResult, error) {
+	return h.exec(code)
+}
+
+func TestGenericTxHandler(t *testing.T) {
+	st, idxs := newTestState(t, 1)
+	sh := &testSubtypeHandler{}
+	h := NewGenericTxHandler(cmtlog.NewNopLogger(), map[string]TxHandler{"echo": sh})
+	tests := []struct {
+		name    string
+		subtype string
+		code    tx.VoteCode
+		err     error
+	}{
+		{"routed", "echo", tx.VoteAcceptGeneric, nil},
+		{"rejected", "echo", tx.VoteRejectGeneric, nil},
+		{"unknown subtype", "other", tx.VoteAcceptGeneric, tx.ErrUnsupportedTxType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			btx := &tx.HACTx{Type: tx.HACTxTypeGeneric, Validator: idxs[0], Tx: &tx.GenericTx{Subtype: tt.subtype}}
+			ctx := context.Background()
+			check, err := h.Check(ctx, st, btx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (check.Code == 0) != (tt.err == nil) {
+				t.Fatalf("check code %d, err %v", check.Code, tt.err)
+			}
+			exec := map[string]func(context.Context, *state.State, *tx.HACTx, tx.VoteCode) (*abcitypes.ExecTxResult, error){
+				"prepare": h.Prepare,
+				"process": h.Process,
+			}
+			for stage, f := range exec {
+				sh.code = 0
+				res, err := f(ctx, st, btx, tt.code)
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("%s: err %v, want %v", stage, err, tt.err)
+				}
+				if err != nil {
+					continue
+				}
+				if sh.code != tt.code {
+					t.Fatalf("%s: code %d, want %d", stage, sh.code, tt.code)
+				}
+				if typ := res.Events[0].Type; typ != types.GenericEventType(tt.subtype, "done") {
+					t.Fatalf("%s: event type %s", stage, typ)
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"fmt"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtlog "github.com/cometbft/cometbft/libs/log"
@@ -22,8 +23,23 @@ func NewGrantTxHandler(logger cmtlog.Logger) (h *GrantTxHandler) {
 	return
 }
 
+// Check rejects a malformed grant before it takes an agent vote and a place
+// in a block.
 func (h *GrantTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
 	res = &abcitypes.ResponseCheckTx{Code: 0}
+	wtx := btx.Tx.(*tx.GrantTx)
+	if len(wtx.Grants) != 1 {
+		h.logger.Info("CheckTx GrantTx fail", "grants", len(wtx.Grants))
+		res.Code = 1
+		res.Log = fmt.Sprintf("%v: one grant per tx", tx.ErrInvalidGrant)
+		return
+	}
+	_, err1 := st.Grant(btx.Validator, &wtx.Grants[0], true, tx.VoteRejectNewMember)
+	if err1 != nil {
+		h.logger.Info("CheckTx GrantTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
 	return
 }
 
@@ -32,8 +48,8 @@ func (h *GrantTxHandler) NewContext(ctx context.Context) {}
 func (h *GrantTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
 	wtx := btx.Tx.(*tx.GrantTx)
 	res = &abcitypes.ExecTxResult{}
-	for _, grant := range wtx.Grants {
-		event, err1 := st.Grant(btx.Validator, grant.Pubkey, grant.Amount, grant.AgentUrl, grant.Name, code)
+	for i := range wtx.Grants {
+		event, err1 := st.Grant(btx.Validator, &wtx.Grants[i], false, code)
 		if err1 != nil {
 			err = err1
 			return
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/hetu-project/hetu-chaoschain/state"
@@ -14,3 +15,40 @@ type TxHandler interface {
 	Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error)
 	Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error)
 }
+
+// Codes of the txs CheckTx rejects. A rejection without a code of its own
+// is CodeTxFail.
+const (
+	CodeTxFail uint32 = 1
+
+	CodeDiscussionTooLong         uint32 = 20
+	CodeProposalClosed            uint32 = 21
+	CodeDiscussionQuota           uint32 = 22
+	CodeDiscussionBlockCap        uint32 = 23
+	CodeDiscussionAccountBlockCap uint32 = 24
+	CodeProposalNoexists          uint32 = 25
+	CodeDepositInsufficient       uint32 = 26
+)
+
+var txCodes = []struct {
+	err  error
+	code uint32
+}{
+	{state.ErrTxDiscussionTooLong, CodeDiscussionTooLong},
+	{state.ErrTxProposalClosed, CodeProposalClosed},
+	{state.ErrTxDiscussionQuota, CodeDiscussionQuota},
+	{state.ErrTxDiscussionBlockCap, CodeDiscussionBlockCap},
+	{state.ErrTxDiscussionAccountBlockCap, CodeDiscussionAccountBlockCap},
+	{state.ErrTxProposalNoexists, CodeProposalNoexists},
+	{state.ErrTxDepositInsufficient, CodeDepositInsufficient},
+}
+
+// txCode returns the code CheckTx rejects a tx failing with err with.
+func txCode(err error) uint32 {
+	for _, c := range txCodes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return CodeTxFail
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+// newTestState returns the state of the block after the genesis of a chain
+// with a member of each stake, and the indexes of the members.
+func newTestState(t *testing.T, stakes ...uint64) (*state.State, []uint64) {
+	t.Helper()
+	db, err := state.NewStateDB(t.TempDir(), cmtlog.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	st := db.NewState()
+	st.SetChainId("test")
+	idxs := make([]uint64, len(stakes))
+	for i, stake := range stakes {
+		a := &state.Account{Stake: stake}
+		a.SetPubKey(ed25519.GenPrivKey().PubKey().Bytes())
+		if err := st.AddAccount(a); err != nil {
+			t.Fatal(err)
+		}
+		idxs[i] = a.Index
+	}
+	if err := st.SetManifest("manifest"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := st.Update(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.SetState(st); err != nil {
+		t.Fatal(err)
+	}
+	return db.NewState(), idxs
+}
+
+func TestTxCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code uint32
+	}{
+		{"discussion too long", state.ErrTxDiscussionTooLong, CodeDiscussionTooLong},
+		{"proposal closed", state.ErrTxProposalClosed, CodeProposalClosed},
+		{"discussion quota", state.ErrTxDiscussionQuota, CodeDiscussionQuota},
+		{"discussion block cap", state.ErrTxDiscussionBlockCap, CodeDiscussionBlockCap},
+		{"discussion account block cap", state.ErrTxDiscussionAccountBlockCap, CodeDiscussionAccountBlockCap},
+		{"proposal noexists", state.ErrTxProposalNoexists, CodeProposalNoexists},
+		{"deposit insufficient", state.ErrTxDepositInsufficient, CodeDepositInsufficient},
+		{"wrapped", fmt.Errorf("check: %w", state.ErrTxProposalClosed), CodeProposalClosed},
+		{"uncoded", state.ErrAccountNoexists, CodeTxFail},
+		{"unknown", errors.New("unknown"), CodeTxFail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := txCode(tt.err); code != tt.code {
+				t.Fatalf("code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestCheckTxCode(t *testing.T) {
+	st, idxs := newTestState(t, 1)
+	logger := cmtlog.NewNopLogger()
+	tests := []struct {
+		name    string
+		handler TxHandler
+		btx     *tx.HACTx
+		code    uint32
+	}{
+		{"discussion on no proposal", NewDiscussionTxHandler(logger),
+			&tx.HACTx{Validator: idxs[0], Tx: &tx.DiscussionTx{Proposal: 1, Data: []byte("data")}}, CodeProposalNoexists},
+		{"discussion too long", NewDiscussionTxHandler(logger),
+			&tx.HACTx{Validator: idxs[0], Tx: &tx.DiscussionTx{Proposal: 1, Data: bytes.Repeat([]byte("a"), int(types.DefaultParams().MaxDiscussionLen)+1)}}, CodeDiscussionTooLong},
+		{"proposal short of deposit", NewProposalTxHandler(logger),
+			&tx.HACTx{Validator: idxs[0], Tx: &tx.ProposalTx{Title: "title", Data: []byte("data")}}, CodeDepositInsufficient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.handler.Check(context.Background(), st, tt.btx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Code != tt.code {
+				t.Fatalf("code %d (%s), want %d", res.Code, res.Log, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+type AmendParamsTxHandler struct {
+	logger cmtlog.Logger
+}
+
+func NewAmendParamsTxHandler(logger cmtlog.Logger) (h *AmendParamsTxHandler) {
+	logger = logger.With("module", "amendParamsTx")
+	h = &AmendParamsTxHandler{
+		logger: logger,
+	}
+	return
+}
+
+func (h *AmendParamsTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 0}
+	atx := btx.Tx.(*tx.AmendParamsTx)
+	_, err1 := st.AmendParams(atx, btx.Validator, true, tx.VoteRejectAmendment)
+	if err1 != nil {
+		h.logger.Info("CheckTx AmendParamsTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
+	return
+}
+
+func (h *AmendParamsTxHandler) NewContext(ctx context.Context) {}
+
+func (h *AmendParamsTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	atx := btx.Tx.(*tx.AmendParamsTx)
+	event, err := st.AmendParams(atx, btx.Validator, false, code)
+	if err != nil {
+		return nil, err
+	}
+	res = &abcitypes.ExecTxResult{}
+	if event != nil {
+		res.Events = []abcitypes.Event{types.EncodeEventAmendParams(event)}
+	}
+	return
+}
+
+func (h *AmendParamsTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx, code)
+}
+
+func (h *AmendParamsTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx, code)
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+type UpdateProfileTxHandler struct {
+	logger cmtlog.Logger
+}
+
+func NewUpdateProfileTxHandler(logger cmtlog.Logger) (h *UpdateProfileTxHandler) {
+	logger = logger.With("module", "updateProfileTx")
+	h = &UpdateProfileTxHandler{
+		logger: logger,
+	}
+	return
+}
+
+func (h *UpdateProfileTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 0}
+	ptx := btx.Tx.(*tx.UpdateProfileTx)
+	_, err1 := st.UpdateProfile(ptx, btx.Validator, true)
+	if err1 != nil {
+		h.logger.Info("CheckTx UpdateProfileTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
+	return
+}
+
+func (h *UpdateProfileTxHandler) NewContext(ctx context.Context) {
+}
+
+func (h *UpdateProfileTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ExecTxResult, err error) {
+	ptx := btx.Tx.(*tx.UpdateProfileTx)
+	event, err := st.UpdateProfile(ptx, btx.Validator, false)
+	if err != nil {
+		return nil, err
+	}
+	res = &abcitypes.ExecTxResult{}
+	if event != nil {
+		res.Events = []abcitypes.Event{types.EncodeEventUpdateProfile(event)}
+	}
+	return
+}
+
+func (h *UpdateProfileTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
+
+func (h *UpdateProfileTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
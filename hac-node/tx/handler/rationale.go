@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+var (
+	ErrRationaleNotInjected   = errors.New("rationale tx is injected by the proposer")
+	ErrMoreThanOneRationaleTx = errors.New("more than one rationale tx")
+)
+
+type RationaleTxHandler struct {
+	logger cmtlog.Logger
+
+	applied bool
+}
+
+func NewRationaleTxHandler(logger cmtlog.Logger) (h *RationaleTxHandler) {
+	logger = logger.With("module", "rationaleTx")
+	h = &RationaleTxHandler{
+		logger: logger,
+	}
+	return
+}
+
+func (h *RationaleTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 1, Log: ErrRationaleNotInjected.Error()}
+	return
+}
+
+func (h *RationaleTxHandler) NewContext(ctx context.Context) {
+	h.applied = false
+}
+
+func (h *RationaleTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ExecTxResult, err error) {
+	if h.applied {
+		return nil, ErrMoreThanOneRationaleTx
+	}
+	wtx := btx.Tx.(*tx.RationaleTx)
+	events, err := st.Rationale(wtx)
+	if err != nil {
+		return nil, err
+	}
+	h.applied = true
+	res = &abcitypes.ExecTxResult{}
+	for _, event := range events {
+		res.Events = append(res.Events, types.EncodeEventRationale(event))
+	}
+	return
+}
+
+func (h *RationaleTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
+
+func (h *RationaleTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+type RotateKeyTxHandler struct {
+	logger cmtlog.Logger
+
+	validatorSet map[uint64]bool
+}
+
+func NewRotateKeyTxHandler(logger cmtlog.Logger) (h *RotateKeyTxHandler) {
+	logger = logger.With("module", "rotateKeyTx")
+	h = &RotateKeyTxHandler{
+		logger:       logger,
+		validatorSet: make(map[uint64]bool),
+	}
+	return
+}
+
+func (h *RotateKeyTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 0}
+	rtx := btx.Tx.(*tx.RotateKeyTx)
+	_, err1 := st.RotateKey(rtx, btx.Validator, true)
+	if err1 != nil {
+		h.logger.Info("CheckTx RotateKeyTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
+	return
+}
+
+func (h *RotateKeyTxHandler) NewContext(ctx context.Context) {
+	h.validatorSet = make(map[uint64]bool)
+}
+
+// handle rotates the key of an account once a block at most, so that one
+// validator update a block follows from it.
+func (h *RotateKeyTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ExecTxResult, err error) {
+	if _, ok := h.validatorSet[btx.Validator]; ok {
+		return nil, state.ErrOneActionInOneBlock
+	}
+	rtx := btx.Tx.(*tx.RotateKeyTx)
+	event, err := st.RotateKey(rtx, btx.Validator, false)
+	if err != nil {
+		return nil, err
+	}
+	h.validatorSet[btx.Validator] = true
+	res = &abcitypes.ExecTxResult{}
+	if event != nil {
+		res.Events = []abcitypes.Event{types.EncodeEventRotateKey(event)}
+	}
+	return
+}
+
+func (h *RotateKeyTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
+
+func (h *RotateKeyTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
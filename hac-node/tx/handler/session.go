@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+type SessionTxHandler struct {
+	logger cmtlog.Logger
+}
+
+func NewSessionTxHandler(logger cmtlog.Logger) (h *SessionTxHandler) {
+	logger = logger.With("module", "sessionTx")
+	h = &SessionTxHandler{
+		logger: logger,
+	}
+	return
+}
+
+func (h *SessionTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 0}
+	stx := btx.Tx.(*tx.SessionTx)
+	_, err1 := st.Session(stx, btx.Validator, true)
+	if err1 != nil {
+		h.logger.Info("CheckTx SessionTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
+	return
+}
+
+func (h *SessionTxHandler) NewContext(ctx context.Context) {
+}
+
+func (h *SessionTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ExecTxResult, err error) {
+	stx := btx.Tx.(*tx.SessionTx)
+	event, err := st.Session(stx, btx.Validator, false)
+	if err != nil {
+		return nil, err
+	}
+	res = &abcitypes.ExecTxResult{}
+	if event != nil {
+		res.Events = []abcitypes.Event{types.EncodeEventSession(event)}
+	}
+	return
+}
+
+func (h *SessionTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
+
+func (h *SessionTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
+
+type RevokeSessionTxHandler struct {
+	logger cmtlog.Logger
+}
+
+func NewRevokeSessionTxHandler(logger cmtlog.Logger) (h *RevokeSessionTxHandler) {
+	logger = logger.With("module", "revokeSessionTx")
+	h = &RevokeSessionTxHandler{
+		logger: logger,
+	}
+	return
+}
+
+func (h *RevokeSessionTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 0}
+	stx := btx.Tx.(*tx.RevokeSessionTx)
+	_, err1 := st.RevokeSession(stx, btx.Validator, true)
+	if err1 != nil {
+		h.logger.Info("CheckTx RevokeSessionTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
+	return
+}
+
+func (h *RevokeSessionTxHandler) NewContext(ctx context.Context) {
+}
+
+func (h *RevokeSessionTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ExecTxResult, err error) {
+	stx := btx.Tx.(*tx.RevokeSessionTx)
+	event, err := st.RevokeSession(stx, btx.Validator, false)
+	if err != nil {
+		return nil, err
+	}
+	res = &abcitypes.ExecTxResult{}
+	if event != nil {
+		res.Events = []abcitypes.Event{types.EncodeEventRevokeSession(event)}
+	}
+	return
+}
+
+func (h *RevokeSessionTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
+
+func (h *RevokeSessionTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
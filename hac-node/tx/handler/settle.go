@@ -28,13 +28,9 @@ func NewSettleProposalTxHandler(logger cmtlog.Logger) (h *SettleProposalTxHandle
 func (h *SettleProposalTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
 	res = &abcitypes.ResponseCheckTx{Code: 0}
 	stx := btx.Tx.(*tx.SettleProposalTx)
-	_, err1 := st.SettleProposal(stx, btx.Validator, true, tx.VoteAcceptProposal)
-	if err1 != nil {
-		h.logger.Info("CheckTx SettleProposalTx fail", "err", err1)
-		res.Code = 1
-		res.Log = err1.Error()
-	}
-	_, err1 = st.SettleProposal(stx, btx.Validator, true, tx.VoteRejectProposal)
+	// rejecting is the one outcome open to every proposal, plain or
+	// multi-option, so it is what the tx is checked against.
+	_, err1 := st.SettleProposal(stx, btx.Validator, true, tx.VoteRejectProposal)
 	if err1 != nil {
 		h.logger.Info("CheckTx SettleProposalTx fail", "err", err1)
 		res.Code = 1
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+type SetSignersTxHandler struct {
+	logger cmtlog.Logger
+}
+
+func NewSetSignersTxHandler(logger cmtlog.Logger) (h *SetSignersTxHandler) {
+	logger = logger.With("module", "setSignersTx")
+	h = &SetSignersTxHandler{
+		logger: logger,
+	}
+	return
+}
+
+func (h *SetSignersTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 0}
+	stx := btx.Tx.(*tx.SetSignersTx)
+	_, err1 := st.SetSigners(stx, btx.Validator, true)
+	if err1 != nil {
+		h.logger.Info("CheckTx SetSignersTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
+	return
+}
+
+func (h *SetSignersTxHandler) NewContext(ctx context.Context) {
+}
+
+func (h *SetSignersTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ExecTxResult, err error) {
+	stx := btx.Tx.(*tx.SetSignersTx)
+	event, err := st.SetSigners(stx, btx.Validator, false)
+	if err != nil {
+		return nil, err
+	}
+	res = &abcitypes.ExecTxResult{}
+	if event != nil {
+		res.Events = []abcitypes.Event{types.EncodeEventSetSigners(event)}
+	}
+	return
+}
+
+func (h *SetSignersTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
+
+func (h *SetSignersTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
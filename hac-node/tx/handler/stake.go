@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hetu-project/hetu-chaoschain/state"
+	"github.com/hetu-project/hetu-chaoschain/tx"
+	"github.com/hetu-project/hetu-chaoschain/types"
+)
+
+type StakeTxHandler struct {
+	logger cmtlog.Logger
+}
+
+func NewStakeTxHandler(logger cmtlog.Logger) (h *StakeTxHandler) {
+	logger = logger.With("module", "stakeTx")
+	h = &StakeTxHandler{
+		logger: logger,
+	}
+	return
+}
+
+func (h *StakeTxHandler) Check(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ResponseCheckTx, err error) {
+	res = &abcitypes.ResponseCheckTx{Code: 0}
+	stx := btx.Tx.(*tx.StakeTx)
+	_, err1 := st.TopUpStake(stx, btx.Validator, true)
+	if err1 != nil {
+		h.logger.Info("CheckTx StakeTx fail", "err", err1)
+		res.Code = 1
+		res.Log = err1.Error()
+	}
+	return
+}
+
+func (h *StakeTxHandler) NewContext(ctx context.Context) {}
+
+func (h *StakeTxHandler) handle(ctx context.Context, st *state.State, btx *tx.HACTx) (res *abcitypes.ExecTxResult, err error) {
+	stx := btx.Tx.(*tx.StakeTx)
+	event, err := st.TopUpStake(stx, btx.Validator, false)
+	if err != nil {
+		return nil, err
+	}
+	res = &abcitypes.ExecTxResult{}
+	if event != nil {
+		res.Events = []abcitypes.Event{types.EncodeEventStake(event)}
+	}
+	return
+}
+
+func (h *StakeTxHandler) Prepare(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
+
+func (h *StakeTxHandler) Process(ctx context.Context, st *state.State, btx *tx.HACTx, code tx.VoteCode) (res *abcitypes.ExecTxResult, err error) {
+	return h.handle(ctx, st, btx)
+}
@@ -61,6 +61,8 @@ func (h *UnStakeTxHandler) handle(ctx context.Context, st *state.State, btx *tx.
 			{Key: "validator", Value: strconv.FormatUint(event.Validator, 10), Index: true},
 			{Key: "amount", Value: fmt.Sprintf("%d", event.Amount), Index: false},
 			{Key: "addr", Value: fmt.Sprintf("%v", event.Address), Index: false},
+			{Key: "stake", Value: fmt.Sprintf("%d", event.Stake), Index: false},
+			{Key: "releaseHeight", Value: fmt.Sprintf("%d", event.ReleaseHeight), Index: false},
 		},
 	})
 	return
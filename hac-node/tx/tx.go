@@ -3,27 +3,46 @@ package tx
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
 )
 
+// HACTx is a tx of any version. Encoding and Compress only apply to
+// version 2: Compress is how a decoded tx was compressed, which follows
+// from its size and is left out of what is signed.
 type HACTx struct {
-	Version   uint8     `json:"version"`
-	Type      HACTxType `json:"type"`
-	Nonce     uint64    `json:"nonce"`
-	Validator uint64    `json:"validator"`
-	Tx        any       `json:"tx"`
-	Sig       [][]byte  `json:"sig"`
+	Version   uint8             `json:"version"`
+	Type      HACTxType         `json:"type"`
+	Nonce     uint64            `json:"nonce"`
+	Validator uint64            `json:"validator"`
+	Tx        any               `json:"tx"`
+	Sig       [][]byte          `json:"sig"`
+	Encoding  HACTxEncodingType `json:"-"`
+	Compress  HACTxCompressType `json:"-"`
 }
 
 type GrantTx struct {
 	Grants []GrantSt `json:"grants"`
 }
 
+// GrantSt grants membership to the holder of Pubkey. Pop proves the holder
+// consents: it is the signature of the new key over PopData. A grant of the
+// pending Application of an address takes the applicant's key, statement,
+// name and agent url instead, the application being signed by that key.
 type GrantSt struct {
-	Statement string `json:"statement"`
-	Amount    uint64 `json:"amount"`
-	AgentUrl  string `json:"agentUrl"`
-	Name      string `json:"name"`
-	Pubkey    []byte `json:"pubkey"`
+	Statement   string `json:"statement"`
+	Amount      uint64 `json:"amount"`
+	AgentUrl    string `json:"agentUrl"`
+	Name        string `json:"name"`
+	Pubkey      []byte `json:"pubkey"`
+	Pop         []byte `json:"pop"`
+	Application string `json:"application,omitempty"`
 }
 
 func (d *GrantSt) Equal(grant GrantSt) bool {
@@ -33,18 +52,98 @@ func (d *GrantSt) Equal(grant GrantSt) bool {
 	return true
 }
 
+// PopData returns the data the new key signs to prove its possession, bound
+// to the chain and the statement of the grant.
+func (d *GrantSt) PopData(chainId []byte) []byte {
+	dat, _ := json.Marshal(struct {
+		Domain    string `json:"domain"`
+		ChainId   string `json:"chainId"`
+		Statement string `json:"statement"`
+	}{"grant_pop", string(chainId), d.Statement})
+	return dat
+}
+
+// ValidateBasic checks the fields of the grant that need no state.
+func (d *GrantSt) ValidateBasic() error {
+	if d.Application == "" && len(d.Pop) != ed25519.SignatureSize {
+		return fmt.Errorf("%w: proof of possession size", ErrInvalidGrant)
+	}
+	if d.Amount == 0 {
+		return fmt.Errorf("%w: zero amount", ErrInvalidGrant)
+	}
+	return validateMember(d.Pubkey, d.Statement, d.Name, d.AgentUrl)
+}
+
+// validateMember checks the fields a member is known by.
+func validateMember(pubkey []byte, statement, name, agentUrl string) error {
+	if len(pubkey) != ed25519.PubKeySize {
+		return fmt.Errorf("%w: pubkey size", ErrInvalidGrant)
+	}
+	if len(statement) > MaxStatementLen {
+		return fmt.Errorf("%w: statement too long", ErrInvalidGrant)
+	}
+	return validateProfile(ErrInvalidGrant, name, agentUrl)
+}
+
+// validateProfile checks the name and agent url of a member, wrapping kind
+// in the error.
+func validateProfile(kind error, name, agentUrl string) error {
+	if name == "" || len(name) > MaxGrantNameLen || !utf8.ValidString(name) ||
+		strings.IndexFunc(name, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0 {
+		return fmt.Errorf("%w: name", kind)
+	}
+	if len(agentUrl) > MaxAgentUrlLen {
+		return fmt.Errorf("%w: agent url too long", kind)
+	}
+	u, err := url.Parse(agentUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: agent url", kind)
+	}
+	return nil
+}
+
 type DiscussionTx struct {
 	Proposal uint64 `json:"proposal"`
 	Data     []byte `json:"data"`
 }
 
 type ProposalTx struct {
-	EndHeight       uint64 `json:"endHeight"`
-	ImageUrl        string `json:"imageUrl"`
-	Title           string `json:"title"`
-	Link            string `json:"link"`
-	Data            []byte `json:"data"`
-	ExpireTimestamp uint   `json:"expire_timestamp"`
+	EndHeight       uint64   `json:"endHeight"`
+	ImageUrl        string   `json:"imageUrl"`
+	Title           string   `json:"title"`
+	Link            string   `json:"link"`
+	Data            []byte   `json:"data"`
+	ExpireTimestamp uint     `json:"expire_timestamp"`
+	Options         []string `json:"options,omitempty"`
+}
+
+// ValidateBasic checks the fields of the proposal that need no state.
+func (tx *ProposalTx) ValidateBasic() error {
+	if tx.Title == "" {
+		return fmt.Errorf("%w: empty title", ErrInvalidProposal)
+	}
+	if len(tx.Options) > MaxProposalOptions {
+		return fmt.Errorf("%w: too many options", ErrInvalidProposal)
+	}
+	return nil
+}
+
+// ValidateEndHeight checks that a proposal made at height ends after it,
+// within MaxProposalBlocks. An EndHeight of 0 takes DefaultProposalBlocks.
+func (tx *ProposalTx) ValidateEndHeight(height uint64) error {
+	if tx.EndHeight == 0 {
+		return nil
+	}
+	if tx.EndHeight <= height || tx.EndHeight-height > MaxProposalBlocks {
+		return fmt.Errorf("%w: end height %d at height %d", ErrInvalidProposal, tx.EndHeight, height)
+	}
+	return nil
+}
+
+// Expired reports whether the proposal expired by blockTime. Expiry is
+// judged on block time so every validator, and every replay, agrees on it.
+func (tx *ProposalTx) Expired(blockTime time.Time) bool {
+	return blockTime.Unix() > int64(tx.ExpireTimestamp)
 }
 
 type SettleProposalTx struct {
@@ -52,10 +151,274 @@ type SettleProposalTx struct {
 	ExpireTimestamp uint   `json:"expire_timestamp"`
 }
 
+// Expired reports whether the settlement expired by blockTime.
+func (tx *SettleProposalTx) Expired(blockTime time.Time) bool {
+	return blockTime.Unix() > int64(tx.ExpireTimestamp)
+}
+
+// RationaleTx carries the vote extensions of the last commit. The block
+// proposer injects it in PrepareProposal; it is authenticated by the
+// extension signatures of the voters, not by an account signature.
+type RationaleTx struct {
+	Height int64           `json:"height"`
+	Round  int32           `json:"round"`
+	Votes  []RationaleVote `json:"votes"`
+}
+
+type RationaleVote struct {
+	Validator string `json:"validator"`
+	Extension []byte `json:"extension"`
+	Signature []byte `json:"signature"`
+}
+
+// RetractTx moves Amount of the sender's stake to the unbonding queue, part
+// of it or all.
 type RetractTx struct {
 	Amount uint64 `json:"amount"`
 }
 
+// StakeTx tops up the sender's stake by Amount. Statement is recorded with
+// the top up.
+type StakeTx struct {
+	Amount    uint64 `json:"amount"`
+	Statement string `json:"statement"`
+}
+
+// ApplyTx applies for membership on behalf of the holder of Pubkey, who is
+// not an account yet and signs it with that key. The applicant agrees to
+// the ManifestVersion of the manifest, which must be the active one.
+type ApplyTx struct {
+	Pubkey          []byte `json:"pubkey"`
+	Statement       string `json:"statement"`
+	AgentUrl        string `json:"agentUrl"`
+	Name            string `json:"name"`
+	ManifestVersion uint64 `json:"manifestVersion"`
+}
+
+// ValidateBasic checks the fields of the application that need no state.
+func (tx *ApplyTx) ValidateBasic() error {
+	if tx.Statement == "" {
+		return fmt.Errorf("%w: empty statement", ErrInvalidGrant)
+	}
+	return validateMember(tx.Pubkey, tx.Statement, tx.Name, tx.AgentUrl)
+}
+
+// UpdateProfileTx replaces the profile of the sender's account, the fields
+// other members know its agent by.
+type UpdateProfileTx struct {
+	Name      string `json:"name"`
+	AgentUrl  string `json:"agentUrl"`
+	SelfIntro string `json:"selfIntro"`
+}
+
+// ValidateBasic checks the fields of the profile that need no state.
+func (tx *UpdateProfileTx) ValidateBasic() error {
+	if len(tx.SelfIntro) > MaxSelfIntroLen || !utf8.ValidString(tx.SelfIntro) {
+		return fmt.Errorf("%w: self introduction", ErrInvalidProfile)
+	}
+	return validateProfile(ErrInvalidProfile, tx.Name, tx.AgentUrl)
+}
+
+// RotateKeyTx replaces the key of the sender's account with Pubkey. The tx
+// is signed by the current key, which authorizes the new one; Pop is the
+// signature of the new key over PopData, by which its holder consents.
+type RotateKeyTx struct {
+	Pubkey []byte `json:"pubkey"`
+	Pop    []byte `json:"pop"`
+}
+
+// PopData returns the data the new key signs to prove its possession, bound
+// to the chain and the account it is rotated into.
+func (tx *RotateKeyTx) PopData(chainId []byte, account uint64) []byte {
+	dat, _ := json.Marshal(struct {
+		Domain  string `json:"domain"`
+		ChainId string `json:"chainId"`
+		Account uint64 `json:"account"`
+	}{"rotate_key_pop", string(chainId), account})
+	return dat
+}
+
+// ValidateBasic checks the sizes of the new key and its proof.
+func (tx *RotateKeyTx) ValidateBasic() error {
+	if len(tx.Pubkey) != ed25519.PubKeySize || len(tx.Pop) != ed25519.SignatureSize {
+		return fmt.Errorf("%w: new key or proof of possession size", ErrInvalidTx)
+	}
+	return nil
+}
+
+// SetSignersTx puts the sender's account under the control of Threshold of
+// Signers, or back under its own key when both are empty. It is authorized
+// as any tx of the account, by the signers in place; Pops are the
+// signatures of each of the new Signers over PopData, by which they consent.
+type SetSignersTx struct {
+	Signers   [][]byte `json:"signers"`
+	Threshold uint32   `json:"threshold"`
+	Pops      [][]byte `json:"pops"`
+}
+
+// PopData returns the data the new signers sign to prove their possession,
+// bound to the chain and the account they are to control.
+func (tx *SetSignersTx) PopData(chainId []byte, account uint64) []byte {
+	dat, _ := json.Marshal(struct {
+		Domain  string `json:"domain"`
+		ChainId string `json:"chainId"`
+		Account uint64 `json:"account"`
+	}{"signer_pop", string(chainId), account})
+	return dat
+}
+
+// ValidateBasic checks the signer set is well formed, with no duplicate key.
+func (tx *SetSignersTx) ValidateBasic() error {
+	if tx.Threshold == 0 {
+		if len(tx.Signers) != 0 || len(tx.Pops) != 0 {
+			return fmt.Errorf("%w: signers without threshold", ErrInvalidSigners)
+		}
+		return nil
+	}
+	if len(tx.Signers) > MaxSigners || int(tx.Threshold) > len(tx.Signers) {
+		return fmt.Errorf("%w: threshold out of range", ErrInvalidSigners)
+	}
+	if len(tx.Pops) != len(tx.Signers) {
+		return fmt.Errorf("%w: one proof of possession per signer", ErrInvalidSigners)
+	}
+	seen := make(map[string]bool, len(tx.Signers))
+	for i, signer := range tx.Signers {
+		if len(signer) != ed25519.PubKeySize || len(tx.Pops[i]) != ed25519.SignatureSize {
+			return fmt.Errorf("%w: signer %d key or proof size", ErrInvalidSigners, i)
+		}
+		if seen[string(signer)] {
+			return fmt.Errorf("%w: duplicate signer %d", ErrInvalidSigners, i)
+		}
+		seen[string(signer)] = true
+	}
+	return nil
+}
+
+// SessionTx registers Pubkey as a session key of the sender's account, or
+// renews it. Until ExpireHeight the key may sign the txs of the account of
+// the types in Scope, at most RateCap of them in a window of
+// config.SessionRateWindow blocks, or with no cap if it is 0. Pop is the
+// signature of the session key over PopData.
+type SessionTx struct {
+	Pubkey       []byte      `json:"pubkey"`
+	Scope        []HACTxType `json:"scope"`
+	ExpireHeight uint64      `json:"expireHeight"`
+	RateCap      uint64      `json:"rateCap"`
+	Pop          []byte      `json:"pop"`
+}
+
+// PopData returns the data the session key signs to prove its possession,
+// bound to the chain and the account it is to act for.
+func (tx *SessionTx) PopData(chainId []byte, account uint64) []byte {
+	dat, _ := json.Marshal(struct {
+		Domain  string `json:"domain"`
+		ChainId string `json:"chainId"`
+		Account uint64 `json:"account"`
+	}{"session_pop", string(chainId), account})
+	return dat
+}
+
+// ValidateBasic checks the key sizes and that the scope holds only
+// delegable tx types, each once.
+func (tx *SessionTx) ValidateBasic() error {
+	if len(tx.Pubkey) != ed25519.PubKeySize || len(tx.Pop) != ed25519.SignatureSize {
+		return fmt.Errorf("%w: key or proof of possession size", ErrInvalidSession)
+	}
+	if len(tx.Scope) == 0 {
+		return fmt.Errorf("%w: empty scope", ErrInvalidSession)
+	}
+	seen := make(map[HACTxType]bool, len(tx.Scope))
+	for _, t := range tx.Scope {
+		if !t.Delegable() {
+			return fmt.Errorf("%w: tx type %d can not be delegated", ErrInvalidSession, t)
+		}
+		if seen[t] {
+			return fmt.Errorf("%w: duplicate tx type %d", ErrInvalidSession, t)
+		}
+		seen[t] = true
+	}
+	return nil
+}
+
+// RevokeSessionTx removes the session key of Address from the sender's
+// account.
+type RevokeSessionTx struct {
+	Address string `json:"address"`
+}
+
+// DisqualifyTx asks the validators to expel Target for breaking the clause
+// of the manifest it cites. Clause must be quoted from the stored manifest.
+type DisqualifyTx struct {
+	Target   uint64 `json:"target"`
+	Clause   string `json:"clause"`
+	Evidence string `json:"evidence"`
+}
+
+// AmendManifestTx proposes a new version of the manifest, either as its
+// whole Text or as Edits to the active version.
+type AmendManifestTx struct {
+	Text   string         `json:"text,omitempty"`
+	Edits  []ManifestEdit `json:"edits,omitempty"`
+	Reason string         `json:"reason"`
+}
+
+// ManifestEdit replaces the one occurrence of Old in the manifest with New.
+type ManifestEdit struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// Amend returns the manifest the tx amends current to.
+func (tx *AmendManifestTx) Amend(current string) (string, error) {
+	if (tx.Text == "") == (len(tx.Edits) == 0) {
+		return "", fmt.Errorf("%w: need either text or edits", ErrInvalidAmendment)
+	}
+	amended := tx.Text
+	if len(tx.Edits) != 0 {
+		amended = current
+		for i, edit := range tx.Edits {
+			if edit.Old == "" || strings.Count(amended, edit.Old) != 1 {
+				return "", fmt.Errorf("%w: edit %d does not match once", ErrInvalidAmendment, i)
+			}
+			amended = strings.Replace(amended, edit.Old, edit.New, 1)
+		}
+	}
+	if strings.TrimSpace(amended) == "" || len(amended) > MaxManifestLen {
+		return "", fmt.Errorf("%w: manifest empty or too long", ErrInvalidAmendment)
+	}
+	if amended == current {
+		return "", fmt.Errorf("%w: manifest unchanged", ErrInvalidAmendment)
+	}
+	return amended, nil
+}
+
+// AmendParamsTx proposes new values of chain params, each named by Key,
+// its JSON name.
+type AmendParamsTx struct {
+	Changes []ParamChange `json:"changes"`
+	Reason  string        `json:"reason"`
+}
+
+// ParamChange sets the param Key to Value.
+type ParamChange struct {
+	Key   string `json:"key"`
+	Value uint64 `json:"value"`
+}
+
+func (tx *AmendParamsTx) ValidateBasic() error {
+	if len(tx.Changes) == 0 {
+		return fmt.Errorf("%w: no changes", ErrInvalidParamsAmendment)
+	}
+	keys := make(map[string]bool, len(tx.Changes))
+	for i, c := range tx.Changes {
+		if c.Key == "" || keys[c.Key] {
+			return fmt.Errorf("%w: change %d has an empty or repeated key", ErrInvalidParamsAmendment, i)
+		}
+		keys[c.Key] = true
+	}
+	return nil
+}
+
 type hacTxTmpl[Tx any] struct {
 	Version   uint8     `json:"version"`
 	Type      HACTxType `json:"type"`
@@ -65,7 +428,12 @@ type hacTxTmpl[Tx any] struct {
 	Sig       [][]byte  `json:"sig"`
 }
 
+// SigData returns the bytes the signers of the tx sign, ext being the chain
+// id. A JSON tx signs its re-encoding with ext in place of the signatures.
 func (tx *HACTx) SigData(ext []byte) (dat []byte, err error) {
+	if tx.Version >= HACTxVersion2 {
+		return tx.sigDataBinary(ext)
+	}
 	ntx := *tx
 	ntx.Sig = [][]byte{ext}
 	dat, err = json.Marshal(ntx)
@@ -99,7 +467,30 @@ func unmarshalHACTx[Tx any](dat []byte) (btx *HACTx, err error) {
 	return
 }
 
+// UnmarshalHACTx decodes a tx of any version, so that the blocks of JSON
+// txs still replay.
 func UnmarshalHACTx(dat []byte) (btx *HACTx, err error) {
+	if isBinaryTx(dat) {
+		btx, err = unmarshalBinary(dat)
+	} else {
+		btx, err = unmarshalJSONTx(dat)
+		if err == nil && btx.Version > HACTxVersion1 {
+			return nil, ErrUnsupportedTxVersion
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if gtx, ok := btx.Tx.(*GenericTx); ok {
+		err = gtx.decode()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+func unmarshalJSONTx(dat []byte) (btx *HACTx, err error) {
 	tp := parseHACTxType(dat)
 	switch tp {
 	case HACTxTypeProposal:
@@ -112,12 +503,40 @@ func UnmarshalHACTx(dat []byte) (btx *HACTx, err error) {
 		return unmarshalHACTx[RetractTx](dat)
 	case HACTxTypeSettleProposal:
 		return unmarshalHACTx[SettleProposalTx](dat)
+	case HACTxTypeRationale:
+		return unmarshalHACTx[RationaleTx](dat)
+	case HACTxTypeDisqualify:
+		return unmarshalHACTx[DisqualifyTx](dat)
+	case HACTxTypeAmendManifest:
+		return unmarshalHACTx[AmendManifestTx](dat)
+	case HACTxTypeStake:
+		return unmarshalHACTx[StakeTx](dat)
+	case HACTxTypeApply:
+		return unmarshalHACTx[ApplyTx](dat)
+	case HACTxTypeUpdateProfile:
+		return unmarshalHACTx[UpdateProfileTx](dat)
+	case HACTxTypeRotateKey:
+		return unmarshalHACTx[RotateKeyTx](dat)
+	case HACTxTypeSetSigners:
+		return unmarshalHACTx[SetSignersTx](dat)
+	case HACTxTypeSession:
+		return unmarshalHACTx[SessionTx](dat)
+	case HACTxTypeRevokeSession:
+		return unmarshalHACTx[RevokeSessionTx](dat)
+	case HACTxTypeAmendParams:
+		return unmarshalHACTx[AmendParamsTx](dat)
+	case HACTxTypeGeneric:
+		return unmarshalHACTx[GenericTx](dat)
 	default:
 		err = ErrUnsupportedTxType
 	}
 	return
 }
 
+// MarshalHACTx encodes the tx in the format of its version.
 func MarshalHACTx(btx *HACTx) (dat []byte, err error) {
+	if btx.Version >= HACTxVersion2 {
+		return marshalBinary(btx)
+	}
 	return json.Marshal(btx)
 }
@@ -0,0 +1,75 @@
+package tx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestProposalValidateEndHeight(t *testing.T) {
+	tests := []struct {
+		name      string
+		endHeight uint64
+		height    uint64
+		valid     bool
+	}{
+		{"default", 0, 10, true},
+		{"next block", 11, 10, true},
+		{"at height", 10, 10, false},
+		{"past", 9, 10, false},
+		{"at cap", 10 + MaxProposalBlocks, 10, true},
+		{"past cap", 11 + MaxProposalBlocks, 10, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ptx := &ProposalTx{Title: "title", EndHeight: tt.endHeight}
+			err := ptx.ValidateEndHeight(tt.height)
+			if (err == nil) != tt.valid {
+				t.Fatalf("err %v, want valid %v", err, tt.valid)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidProposal) {
+				t.Fatalf("err %v, want %v", err, ErrInvalidProposal)
+			}
+		})
+	}
+}
+
+func TestAmendManifest(t *testing.T) {
+	const current = "be kind. share."
+	tests := []struct {
+		name  string
+		tx    AmendManifestTx
+		want  string
+		valid bool
+	}{
+		{"text", AmendManifestTx{Text: "be brave."}, "be brave.", true},
+		{"edit", AmendManifestTx{Edits: []ManifestEdit{{Old: "kind", New: "brave"}}}, "be brave. share.", true},
+		{"edits in turn", AmendManifestTx{Edits: []ManifestEdit{{Old: "kind", New: "brave"}, {Old: "brave", New: "bold"}}}, "be bold. share.", true},
+		{"edit removing", AmendManifestTx{Edits: []ManifestEdit{{Old: " share.", New: ""}}}, "be kind.", true},
+		{"nothing", AmendManifestTx{}, "", false},
+		{"text and edits", AmendManifestTx{Text: "be brave.", Edits: []ManifestEdit{{Old: "kind", New: "brave"}}}, "", false},
+		{"edit not matching", AmendManifestTx{Edits: []ManifestEdit{{Old: "cruel", New: "brave"}}}, "", false},
+		{"edit matching twice", AmendManifestTx{Edits: []ManifestEdit{{Old: ".", New: "!"}}}, "", false},
+		{"edit of nothing", AmendManifestTx{Edits: []ManifestEdit{{Old: "", New: "brave"}}}, "", false},
+		{"unchanged", AmendManifestTx{Text: current}, "", false},
+		{"edited back", AmendManifestTx{Edits: []ManifestEdit{{Old: "kind", New: "brave"}, {Old: "brave", New: "kind"}}}, "", false},
+		{"blank", AmendManifestTx{Text: " \n"}, "", false},
+		{"emptied", AmendManifestTx{Edits: []ManifestEdit{{Old: current, New: ""}}}, "", false},
+		{"at max length", AmendManifestTx{Text: strings.Repeat("a", MaxManifestLen)}, strings.Repeat("a", MaxManifestLen), true},
+		{"too long", AmendManifestTx{Text: strings.Repeat("a", MaxManifestLen+1)}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amended, err := tt.tx.Amend(current)
+			if (err == nil) != tt.valid {
+				t.Fatalf("err %v, want valid %v", err, tt.valid)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidAmendment) {
+				t.Fatalf("err %v, want %v", err, ErrInvalidAmendment)
+			}
+			if amended != tt.want {
+				t.Fatalf("amended %q, want %q", amended, tt.want)
+			}
+		})
+	}
+}
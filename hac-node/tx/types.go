@@ -9,14 +9,98 @@ import (
 type VoteCode int64
 
 const (
-	VoteIgnoreProposal  VoteCode = 200
-	VoteProcessProposal VoteCode = 201
-	VoteAcceptProposal  VoteCode = 202
-	VoteRejectProposal  VoteCode = 203
-	VoteGrantNewMember  VoteCode = 204
-	VoteRejectNewMember VoteCode = 205
+	VoteIgnoreProposal   VoteCode = 200
+	VoteProcessProposal  VoteCode = 201
+	VoteAcceptProposal   VoteCode = 202
+	VoteRejectProposal   VoteCode = 203
+	VoteGrantNewMember   VoteCode = 204
+	VoteRejectNewMember  VoteCode = 205
+	VoteAbstain          VoteCode = 206
+	VoteDisqualifyMember VoteCode = 207
+	VoteKeepMember       VoteCode = 208
+	VoteAmendManifest    VoteCode = 209
+	VoteRejectAmendment  VoteCode = 210
+	VoteAcceptGeneric    VoteCode = 211
+	VoteRejectGeneric    VoteCode = 212
+	VoteAmendParams      VoteCode = 213
+
+	// VoteNoQuorum is the code consensus decides when no single code is
+	// backed by +2/3 of the voting power. It mirrors types.VoteCodeNoQuorum
+	// in cometbft and is never cast by an agent.
+	VoteNoQuorum VoteCode = -1
+
+	// VoteOptionBase is the code of the first option of a multi-option
+	// proposal; option i is voted with VoteOptionBase + i.
+	VoteOptionBase VoteCode = 1000
+)
+
+// MaxProposalOptions bounds the number of options a proposal may offer.
+const MaxProposalOptions = 16
+
+// MaxEvidenceLen bounds the evidence text of a disqualification.
+const MaxEvidenceLen = 4096
+
+// MaxManifestLen bounds the text of an amended manifest.
+const MaxManifestLen = 64 << 10
+
+// MaxGrantPower bounds the voting power a grant gives a new member.
+const MaxGrantPower = 10000
+
+// MaxGrantNameLen, MaxAgentUrlLen and MaxStatementLen bound the text fields
+// of a grant.
+const (
+	MaxGrantNameLen = 64
+	MaxAgentUrlLen  = 256
+	MaxStatementLen = 4096
 )
 
+// MaxSelfIntroLen bounds the self introduction of a member's profile.
+const MaxSelfIntroLen = 4096
+
+// MaxSigners bounds the keys of a multi-signature account.
+const MaxSigners = 16
+
+// MaxSessions bounds the live session keys of an account.
+const MaxSessions = 8
+
+// MaxGenericDataLen bounds the data of a generic tx.
+const MaxGenericDataLen = 64 << 10
+
+// MaxApplicationsPerBlock bounds the membership applications in a block,
+// as applicants are not accounts yet.
+const MaxApplicationsPerBlock = 16
+
+// MaxGovernanceTxs bounds the number of governance txs in a block. Each one
+// is voted on with its own vote code.
+const MaxGovernanceTxs = 16
+
+// DefaultProposalBlocks is how long a proposal made without an EndHeight
+// stays open, in blocks.
+const DefaultProposalBlocks = 100000
+
+// MaxProposalBlocks is how long a proposal stays open at most, in blocks,
+// so that neither it nor its deposit is held forever.
+const MaxProposalBlocks = 1000000
+
+// OptionVoteCode returns the vote code for the option at index i.
+func OptionVoteCode(i int) VoteCode {
+	return VoteOptionBase + VoteCode(i)
+}
+
+// Option returns the option index the code votes for, if any.
+func (c VoteCode) Option() (int, bool) {
+	if c < VoteOptionBase || c >= VoteOptionBase+MaxProposalOptions {
+		return 0, false
+	}
+	return int(c - VoteOptionBase), true
+}
+
+// Undecided reports whether the code leaves the decision open, either
+// because the validators abstained or because they could not agree.
+func (c VoteCode) Undecided() bool {
+	return c == VoteAbstain || c == VoteNoQuorum
+}
+
 type HACTxType uint8
 type HACTxCompressType uint8
 type HACTxEncodingType uint8
@@ -28,21 +112,69 @@ const (
 	HACTxTypeGrant          HACTxType = 3
 	HACTxTypeRetract        HACTxType = 4
 	HACTxTypeSettleProposal HACTxType = 5
+	HACTxTypeRationale      HACTxType = 6
+	HACTxTypeDisqualify     HACTxType = 7
+	HACTxTypeAmendManifest  HACTxType = 8
+	HACTxTypeStake          HACTxType = 9
+	HACTxTypeApply          HACTxType = 10
+	HACTxTypeUpdateProfile  HACTxType = 11
+	HACTxTypeRotateKey      HACTxType = 12
+	HACTxTypeSetSigners     HACTxType = 13
+	HACTxTypeSession        HACTxType = 14
+	HACTxTypeRevokeSession  HACTxType = 15
+	HACTxTypeAmendParams    HACTxType = 16
 
 	HACTxTypeGeneric HACTxType = 255
 )
 
+// Governance reports whether txs of the type are decided by the validators'
+// vote, which gives each of them a vote code.
+func (t HACTxType) Governance() bool {
+	return t == HACTxTypeGrant || t == HACTxTypeProposal || t == HACTxTypeSettleProposal ||
+		t == HACTxTypeDisqualify || t == HACTxTypeAmendManifest || t == HACTxTypeAmendParams
+}
+
+// Delegable reports whether txs of the type may be signed by a session key
+// of the account. Only the agent's routine txs on proposals are; txs that
+// change the membership, the manifest, the params, the account's profile or
+// who controls the account are left to the account's own signers.
+func (t HACTxType) Delegable() bool {
+	return t == HACTxTypeProposal || t == HACTxTypeDiscussion || t == HACTxTypeSettleProposal
+}
+
 const (
 	HACTxPrefixLen = 4
 	HACTxSuffixLen = 8 + 8 + crypto.SignatureLength
 	HACTxCheckLen  = HACTxPrefixLen + HACTxSuffixLen
 )
 
+// Versions 0 and 1 are plain JSON. Version 2 is binary: an HACExtTxHeader
+// followed by a protobuf TxEnvelope, compressed as the header says.
 const (
 	HACTxVersion0 uint8 = 0
 	HACTxVersion1 uint8 = 1
+	HACTxVersion2 uint8 = 2
+)
+
+const (
+	HACTxEncodingJSON  HACTxEncodingType = 0
+	HACTxEncodingProto HACTxEncodingType = 1
+)
+
+const (
+	HACTxCompressNone HACTxCompressType = 0
+	HACTxCompressZstd HACTxCompressType = 1
 )
 
+// MinCompressLen is the envelope size from which a version 2 tx is
+// compressed; below it the compression header costs more than it saves. A
+// shorter envelope must not be compressed.
+const MinCompressLen = 1024
+
+// MaxTxDecodedLen bounds the size of a version 2 envelope once
+// decompressed.
+const MaxTxDecodedLen = 4 << 20
+
 var (
 	ErrInvalidTx         = errors.New("invalid tx")
 	ErrUnsupportedTxType = errors.New("unsupported tx type")
@@ -52,6 +184,17 @@ var (
 	ErrUnsupportedTxCompress = errors.New("unsupported tx compress")
 	ErrUnsupportedTxEncoding = errors.New("unsupported tx encoding")
 	ErrUnsupportedTxData     = errors.New("unsupported tx data")
+
+	ErrInvalidAmendment       = errors.New("invalid manifest amendment")
+	ErrInvalidParamsAmendment = errors.New("invalid params amendment")
+	ErrInvalidGrant           = errors.New("invalid grant")
+	ErrInvalidProfile         = errors.New("invalid profile")
+	ErrInvalidSigners         = errors.New("invalid signers")
+	ErrInvalidSession         = errors.New("invalid session")
+	ErrInvalidGeneric         = errors.New("invalid generic tx")
+	ErrInvalidProposal        = errors.New("invalid proposal")
+
+	ErrGenericTxRegistered = errors.New("generic tx subtype already registered")
 )
 
 type HACTxHeader struct {
@@ -30,6 +30,8 @@ type AgentInfo struct {
 type GenesisAppState struct {
 	Agents   []AgentInfo `json:"agents"`
 	Manifest string      `json:"manifest"`
+	// Params are the chain parameters, DefaultParams if not set.
+	Params *Params `json:"params,omitempty"`
 }
 
 // GenesisDoc defines the initial conditions for a CometBFT blockchain, in particular its validator set.
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Params are the chain parameters kept in the state. They are set at
+// genesis, the defaults if the genesis has none, and amended by the
+// validators' vote on an AmendParamsTx.
+type Params struct {
+	// MaxDiscussionLen bounds the data of a discussion, in bytes.
+	MaxDiscussionLen uint64 `json:"max_discussion_len"`
+	// MaxProposalDiscussions bounds the discussions an account holds on a
+	// proposal.
+	MaxProposalDiscussions uint64 `json:"max_proposal_discussions"`
+	// MaxBlockDiscussions bounds the discussions in a block.
+	MaxBlockDiscussions uint64 `json:"max_block_discussions"`
+	// MaxAccountBlockDiscussions bounds the discussions of an account in a
+	// block.
+	MaxAccountBlockDiscussions uint64 `json:"max_account_block_discussions"`
+	// ProposalDeposit is taken from the stake of the proposer of a
+	// proposal, 0 for none.
+	ProposalDeposit uint64 `json:"proposal_deposit"`
+}
+
+func DefaultParams() *Params {
+	return &Params{
+		MaxDiscussionLen:           4096,
+		MaxProposalDiscussions:     32,
+		MaxBlockDiscussions:        64,
+		MaxAccountBlockDiscussions: 2,
+		// a tenth of a power
+		ProposalDeposit: 100000000,
+	}
+}
+
+func (p *Params) Clone() *Params {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Set sets the param named key, its JSON name, to value.
+func (p *Params) Set(key string, value uint64) error {
+	switch key {
+	case "max_discussion_len":
+		p.MaxDiscussionLen = value
+	case "max_proposal_discussions":
+		p.MaxProposalDiscussions = value
+	case "max_block_discussions":
+		p.MaxBlockDiscussions = value
+	case "max_account_block_discussions":
+		p.MaxAccountBlockDiscussions = value
+	case "proposal_deposit":
+		p.ProposalDeposit = value
+	default:
+		return fmt.Errorf("unknown param %q", key)
+	}
+	return nil
+}
+
+func (p *Params) ValidateBasic() error {
+	if p.MaxDiscussionLen == 0 {
+		return errors.New("max_discussion_len must be positive")
+	}
+	if p.MaxProposalDiscussions == 0 {
+		return errors.New("max_proposal_discussions must be positive")
+	}
+	if p.MaxBlockDiscussions == 0 {
+		return errors.New("max_block_discussions must be positive")
+	}
+	if p.MaxAccountBlockDiscussions == 0 || p.MaxAccountBlockDiscussions > p.MaxBlockDiscussions {
+		return errors.New("max_account_block_discussions must be positive and at most max_block_discussions")
+	}
+	return nil
+}
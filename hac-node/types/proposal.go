@@ -11,6 +11,71 @@ type Proposal struct {
 	ImageUrl        string         `json:"image_url"`
 	Title           string         `json:"title"`
 	Link            string         `json:"link"`
+	Options         []string       `json:"options,omitempty"`
+	Option          string         `json:"option,omitempty"`
+	// Deposit is the deposit of the proposer held on the proposal, until
+	// it is refunded at EndHeight.
+	Deposit uint64 `json:"deposit,omitempty"`
+}
+
+// What becomes of the deposit of a proposal on the validators' draft vote.
+const (
+	// DepositRefunded is the deposit of a proposal the validators agreed
+	// to process, given back at once.
+	DepositRefunded = "refunded"
+	// DepositForfeited is the deposit of a proposal the validators
+	// ignored, sent to the community pool.
+	DepositForfeited = "forfeited"
+	// DepositHeld is the deposit of a proposal the validators could not
+	// decide on, held until its EndHeight.
+	DepositHeld = "held"
+)
+
+// VoteRationale is what a validator carries in its precommit vote
+// extension for each proposal or settle tx of the block: the code its agent
+// chose, the reason the agent gave and the hash of the tx the agent judged.
+type VoteRationale struct {
+	Proposal uint64 `json:"proposal"`
+	VoteCode int64  `json:"vote_code"`
+	Reason   string `json:"reason"`
+	Context  []byte `json:"context"`
+	// Fallback is set when the agent could not answer and the vote was
+	// cast by the fallback policy; it holds one of the FallbackReason codes.
+	Fallback string `json:"fallback,omitempty"`
+	// Index is the index of the tx among the governance txs of the block,
+	// that of its vote code. It is kept by the node that judged the tx and
+	// is not carried in the extension.
+	Index int `json:"-"`
+}
+
+// Reason codes of a vote cast by the fallback policy.
+const (
+	FallbackReasonTimeout = "agent_timeout"
+	FallbackReasonError   = "agent_error"
+)
+
+// MaxRationaleReasonLen bounds the reason a vote extension may carry.
+const MaxRationaleReasonLen = 1024
+
+// Rationale is the on-chain record of a validator's VoteRationale for the
+// block at Height.
+type Rationale struct {
+	VoteRationale
+	Height           uint64 `json:"height"`
+	Validator        uint64 `json:"validator"`
+	ValidatorAddress string `json:"validator_address"`
+}
+
+// Manifest is a version of the Genesis Contract. Version 1 is the manifest
+// of the genesis, each accepted amendment takes the next version from the
+// block at Height on.
+type Manifest struct {
+	Version         uint64 `json:"version"`
+	Text            string `json:"text"`
+	Height          uint64 `json:"height"`
+	Proposer        uint64 `json:"proposer"`
+	ProposerAddress string `json:"proposer_address"`
+	Reason          string `json:"reason"`
 }
 
 type Discussion struct {
@@ -29,4 +94,8 @@ const (
 	ProposalStatusProcessing ProposalStatus = 2
 	ProposalStatusAccepted   ProposalStatus = 3
 	ProposalStatusRejected   ProposalStatus = 4
+	ProposalStatusUndecided  ProposalStatus = 5
+	// ProposalStatusExpired is a processing proposal that was not settled
+	// by its EndHeight.
+	ProposalStatusExpired ProposalStatus = 6
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -16,15 +17,90 @@ const (
 	EventProposalType        = "proposal"
 	EventSettleProposalType  = "settle_proposal"
 	EventDiscussionType      = "discussion"
+	EventVoteCodeType        = "vote_code"
+	EventRationaleType       = "rationale"
+	EventProposalExpiredType = "proposal_expired"
+	EventDisqualifyType      = "disqualify"
+	EventAmendManifestType   = "amend_manifest"
+	EventAmendParamsType     = "amend_params"
+	EventStakeType           = "stake"
+	EventStakeReleasedType   = "stake_released"
+	EventSlashType           = "slash"
+	EventApplyType           = "apply"
+	EventApplyExpiredType    = "application_expired"
+	EventUpdateProfileType   = "update_profile"
+	EventRotateKeyType       = "rotate_key"
+	EventSetSignersType      = "set_signers"
+	EventSessionType         = "session"
+	EventRevokeSessionType   = "revoke_session"
+	EventDepositRefundType   = "deposit_refund"
+	EventRewardType          = "reward"
+
+	// EventGenericPrefix starts the type of every event of a generic tx.
+	EventGenericPrefix = "generic."
 )
 
+// Unbonding is stake retracted by Account at Height, held in the unbonding
+// queue until ReleaseHeight. It can still be slashed while it waits.
+type Unbonding struct {
+	Account       uint64 `json:"account"`
+	Amount        uint64 `json:"amount"`
+	Height        uint64 `json:"height"`
+	ReleaseHeight uint64 `json:"releaseHeight"`
+}
+
+// Application is a pending membership application by the holder of Pubkey,
+// open to a grant by the members until ExpireHeight.
+type Application struct {
+	Address         string `json:"address"`
+	Pubkey          []byte `json:"pubkey"`
+	Statement       string `json:"statement"`
+	AgentUrl        string `json:"agentUrl"`
+	Name            string `json:"name"`
+	ManifestVersion uint64 `json:"manifestVersion"`
+	Height          uint64 `json:"height"`
+	ExpireHeight    uint64 `json:"expireHeight"`
+}
+
+// Session is a key of Address that may sign the txs of Account of the types
+// in Scope until ExpireHeight, at most RateCap of them in a rate window when
+// RateCap is not 0. Used counts the txs it signed in the window starting at
+// WindowStart.
+type Session struct {
+	Account      uint64 `json:"account"`
+	Address      string `json:"address"`
+	Pubkey       []byte `json:"pubkey"`
+	Scope        []int  `json:"scope"`
+	ExpireHeight uint64 `json:"expireHeight"`
+	RateCap      uint64 `json:"rateCap"`
+	Height       uint64 `json:"height"`
+	WindowStart  uint64 `json:"windowStart"`
+	Used         uint64 `json:"used"`
+}
+
+// Permits reports whether the session may sign a tx of type txType.
+func (s *Session) Permits(txType int) bool {
+	for _, t := range s.Scope {
+		if t == txType {
+			return true
+		}
+	}
+	return false
+}
+
+// EventUnStake records Amount retracted from the stake of Validator, which
+// is left with Stake. The amount unbonds until ReleaseHeight.
 type EventUnStake struct {
-	Validator uint64 `json:"validatorIndex"`
-	Address   string `json:"address"`
-	Amount    uint64 `json:"amount"`
+	Validator     uint64 `json:"validatorIndex"`
+	Address       string `json:"address"`
+	Amount        uint64 `json:"amount"`
+	Stake         uint64 `json:"stake"`
+	ReleaseHeight uint64 `json:"releaseHeight"`
 }
 
 type EventGrant struct {
+	// Validator is the index of the new account, 0 if the grant was
+	// rejected and made none.
 	Validator       uint64 `json:"validatorIndex"`
 	Address         string `json:"address"`
 	Amount          uint64 `json:"amount"`
@@ -50,6 +126,11 @@ type EventProposal struct {
 	Title           string `json:"title"`
 	Link            string `json:"link"`
 	ImageUrl        string `json:"imageUrl"`
+	// Deposit is taken from the stake of the proposer, and DepositStatus
+	// tells what became of it. Stake is the stake left to the proposer.
+	Deposit       uint64 `json:"deposit"`
+	DepositStatus string `json:"depositStatus"`
+	Stake         uint64 `json:"stake"`
 }
 
 func EncodeEventProposal(event *EventProposal) abci.Event {
@@ -65,6 +146,9 @@ func EncodeEventProposal(event *EventProposal) abci.Event {
 			{Key: "title", Value: event.Title, Index: false},
 			{Key: "link", Value: event.Link, Index: false},
 			{Key: "imageUrl", Value: event.ImageUrl, Index: false},
+			{Key: "deposit", Value: fmt.Sprintf("%v", event.Deposit), Index: false},
+			{Key: "depositStatus", Value: event.DepositStatus, Index: false},
+			{Key: "stake", Value: fmt.Sprintf("%v", event.Stake), Index: false},
 		},
 	}
 }
@@ -78,79 +162,823 @@ func DecodeEventProposal(originEvent abci.Event) *EventProposal {
 			if err != nil {
 				return nil
 			}
-			event.ProposalIndex = proposal
-		case "proposer":
-			proposer, err := strconv.ParseUint(v.Value, 10, 64)
+			event.ProposalIndex = proposal
+		case "proposer":
+			proposer, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposer = proposer
+		case "endHeight":
+			endHeight, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.EndHeight = endHeight
+		case "status":
+			status, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Status = status
+		case "data":
+			event.Data = []byte(v.Value)
+		case "proposerAddress":
+			event.ProposerAddress = fmt.Sprintf("%v", v.Value)
+		case "title":
+			event.Title = v.Value
+		case "link":
+			event.Link = v.Value
+		case "imageUrl":
+			event.ImageUrl = v.Value
+		case "deposit":
+			deposit, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Deposit = deposit
+		case "depositStatus":
+			event.DepositStatus = v.Value
+		case "stake":
+			stake, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Stake = stake
+		}
+	}
+	return event
+}
+
+type EventSettleProposal struct {
+	Proposer uint64 `json:"proposerIndex"`
+	Proposal uint64 `json:"proposal"`
+	State    int64  `json:"state"`
+	VoteCode int64  `json:"voteCode"`
+	Option   string `json:"option"`
+}
+
+func EncodeEventSettleProposal(event *EventSettleProposal) abci.Event {
+	return abci.Event{
+		Type: EventSettleProposalType,
+		Attributes: []abci.EventAttribute{
+			{Key: "proposer", Value: fmt.Sprintf("%v", event.Proposer), Index: true},
+			{Key: "proposal", Value: fmt.Sprintf("%v", event.Proposal), Index: true},
+			{Key: "state", Value: fmt.Sprintf("%v", event.State), Index: false},
+			{Key: "voteCode", Value: fmt.Sprintf("%v", event.VoteCode), Index: false},
+			{Key: "option", Value: event.Option, Index: false},
+		},
+	}
+}
+
+func DecodeEventSettleProposal(originEvent abci.Event) *EventSettleProposal {
+	event := &EventSettleProposal{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "proposer":
+			proposer, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposer = proposer
+		case "proposal":
+			proposal, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposal = proposal
+		case "state":
+			state, err := strconv.ParseInt(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.State = state
+		case "voteCode":
+			code, err := strconv.ParseInt(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.VoteCode = code
+		case "option":
+			event.Option = v.Value
+		}
+	}
+	return event
+}
+
+// EventProposalExpired records a processing proposal expired at Height,
+// the first block past its EndHeight.
+type EventProposalExpired struct {
+	Proposal  uint64 `json:"proposal"`
+	Proposer  uint64 `json:"proposerIndex"`
+	EndHeight uint64 `json:"endHeight"`
+	Height    uint64 `json:"height"`
+}
+
+func EncodeEventProposalExpired(event *EventProposalExpired) abci.Event {
+	return abci.Event{
+		Type: EventProposalExpiredType,
+		Attributes: []abci.EventAttribute{
+			{Key: "proposal", Value: fmt.Sprintf("%v", event.Proposal), Index: true},
+			{Key: "proposer", Value: fmt.Sprintf("%v", event.Proposer), Index: true},
+			{Key: "endHeight", Value: fmt.Sprintf("%v", event.EndHeight), Index: false},
+			{Key: "height", Value: fmt.Sprintf("%v", event.Height), Index: false},
+		},
+	}
+}
+
+func DecodeEventProposalExpired(originEvent abci.Event) *EventProposalExpired {
+	event := &EventProposalExpired{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "proposal":
+			proposal, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposal = proposal
+		case "proposer":
+			proposer, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposer = proposer
+		case "endHeight":
+			endHeight, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.EndHeight = endHeight
+		case "height":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Height = height
+		}
+	}
+	return event
+}
+
+// EventDepositRefund records the deposit held on Proposal refunded to its
+// proposer at Height. Stake is the stake of the proposer after it.
+type EventDepositRefund struct {
+	Proposal uint64 `json:"proposal"`
+	Proposer uint64 `json:"proposerIndex"`
+	Amount   uint64 `json:"amount"`
+	Stake    uint64 `json:"stake"`
+	Height   uint64 `json:"height"`
+}
+
+func EncodeEventDepositRefund(event *EventDepositRefund) abci.Event {
+	return abci.Event{
+		Type: EventDepositRefundType,
+		Attributes: []abci.EventAttribute{
+			{Key: "proposal", Value: fmt.Sprintf("%v", event.Proposal), Index: true},
+			{Key: "proposer", Value: fmt.Sprintf("%v", event.Proposer), Index: true},
+			{Key: "amount", Value: fmt.Sprintf("%v", event.Amount), Index: false},
+			{Key: "stake", Value: fmt.Sprintf("%v", event.Stake), Index: false},
+			{Key: "height", Value: fmt.Sprintf("%v", event.Height), Index: false},
+		},
+	}
+}
+
+func DecodeEventDepositRefund(originEvent abci.Event) *EventDepositRefund {
+	event := &EventDepositRefund{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "proposal":
+			proposal, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposal = proposal
+		case "proposer":
+			proposer, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposer = proposer
+		case "amount":
+			amount, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Amount = amount
+		case "stake":
+			stake, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Stake = stake
+		case "height":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Height = height
+		}
+	}
+	return event
+}
+
+// What a member is rewarded for.
+const (
+	RewardCommit     = "commit"
+	RewardProposal   = "proposal"
+	RewardDiscussion = "discussion"
+)
+
+// EventReward records Amount issued at Height to the stake of Account for
+// Kind of participation. Proposal is the proposal of a proposal or
+// discussion reward. Stake is the stake of Account after the rewards of
+// the block.
+type EventReward struct {
+	Account  uint64 `json:"account"`
+	Address  string `json:"address"`
+	Kind     string `json:"kind"`
+	Proposal uint64 `json:"proposal"`
+	Amount   uint64 `json:"amount"`
+	Stake    uint64 `json:"stake"`
+	Height   uint64 `json:"height"`
+}
+
+func EncodeEventReward(event *EventReward) abci.Event {
+	return abci.Event{
+		Type: EventRewardType,
+		Attributes: []abci.EventAttribute{
+			{Key: "account", Value: fmt.Sprintf("%v", event.Account), Index: true},
+			{Key: "addr", Value: event.Address, Index: true},
+			{Key: "kind", Value: event.Kind, Index: true},
+			{Key: "proposal", Value: fmt.Sprintf("%v", event.Proposal), Index: false},
+			{Key: "amount", Value: fmt.Sprintf("%v", event.Amount), Index: false},
+			{Key: "stake", Value: fmt.Sprintf("%v", event.Stake), Index: false},
+			{Key: "height", Value: fmt.Sprintf("%v", event.Height), Index: false},
+		},
+	}
+}
+
+func DecodeEventReward(originEvent abci.Event) *EventReward {
+	event := &EventReward{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "account":
+			account, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Account = account
+		case "addr":
+			event.Address = v.Value
+		case "kind":
+			event.Kind = v.Value
+		case "proposal":
+			proposal, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposal = proposal
+		case "amount":
+			amount, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Amount = amount
+		case "stake":
+			stake, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Stake = stake
+		case "height":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Height = height
+		}
+	}
+	return event
+}
+
+// EventDisqualify records the vote on a disqualification of Target. When
+// Expelled is set the target lost its Stake and left the validator set.
+type EventDisqualify struct {
+	Target         uint64 `json:"targetIndex"`
+	TargetAddress  string `json:"targetAddress"`
+	Accuser        uint64 `json:"accuserIndex"`
+	AccuserAddress string `json:"accuserAddress"`
+	Clause         string `json:"clause"`
+	Evidence       string `json:"evidence"`
+	Stake          uint64 `json:"stake"`
+	Unbonding      uint64 `json:"unbonding"`
+	Expelled       bool   `json:"expelled"`
+	VoteCode       int64  `json:"voteCode"`
+}
+
+func EncodeEventDisqualify(event *EventDisqualify) abci.Event {
+	return abci.Event{
+		Type: EventDisqualifyType,
+		Attributes: []abci.EventAttribute{
+			{Key: "target", Value: fmt.Sprintf("%v", event.Target), Index: true},
+			{Key: "targetAddress", Value: event.TargetAddress, Index: false},
+			{Key: "accuser", Value: fmt.Sprintf("%v", event.Accuser), Index: true},
+			{Key: "accuserAddress", Value: event.AccuserAddress, Index: false},
+			{Key: "clause", Value: event.Clause, Index: false},
+			{Key: "evidence", Value: event.Evidence, Index: false},
+			{Key: "stake", Value: fmt.Sprintf("%v", event.Stake), Index: false},
+			{Key: "unbonding", Value: fmt.Sprintf("%v", event.Unbonding), Index: false},
+			{Key: "expelled", Value: fmt.Sprintf("%v", event.Expelled), Index: false},
+			{Key: "voteCode", Value: fmt.Sprintf("%v", event.VoteCode), Index: false},
+		},
+	}
+}
+
+func DecodeEventDisqualify(originEvent abci.Event) *EventDisqualify {
+	event := &EventDisqualify{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "target":
+			target, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Target = target
+		case "targetAddress":
+			event.TargetAddress = v.Value
+		case "accuser":
+			accuser, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Accuser = accuser
+		case "accuserAddress":
+			event.AccuserAddress = v.Value
+		case "clause":
+			event.Clause = v.Value
+		case "evidence":
+			event.Evidence = v.Value
+		case "stake":
+			stake, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Stake = stake
+		case "unbonding":
+			unbonding, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Unbonding = unbonding
+		case "expelled":
+			expelled, err := strconv.ParseBool(v.Value)
+			if err != nil {
+				return nil
+			}
+			event.Expelled = expelled
+		case "voteCode":
+			code, err := strconv.ParseInt(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.VoteCode = code
+		}
+	}
+	return event
+}
+
+// EventAmendManifest records the vote on a manifest amendment. When Accepted
+// the amended manifest is active as Version from Height on.
+type EventAmendManifest struct {
+	Proposer        uint64 `json:"proposerIndex"`
+	ProposerAddress string `json:"proposerAddress"`
+	Accepted        bool   `json:"accepted"`
+	Version         uint64 `json:"version"`
+	Height          uint64 `json:"height"`
+	Reason          string `json:"reason"`
+	VoteCode        int64  `json:"voteCode"`
+}
+
+func EncodeEventAmendManifest(event *EventAmendManifest) abci.Event {
+	return abci.Event{
+		Type: EventAmendManifestType,
+		Attributes: []abci.EventAttribute{
+			{Key: "proposer", Value: fmt.Sprintf("%v", event.Proposer), Index: true},
+			{Key: "proposerAddress", Value: event.ProposerAddress, Index: false},
+			{Key: "accepted", Value: fmt.Sprintf("%v", event.Accepted), Index: false},
+			{Key: "version", Value: fmt.Sprintf("%v", event.Version), Index: true},
+			{Key: "height", Value: fmt.Sprintf("%v", event.Height), Index: false},
+			{Key: "reason", Value: event.Reason, Index: false},
+			{Key: "voteCode", Value: fmt.Sprintf("%v", event.VoteCode), Index: false},
+		},
+	}
+}
+
+func DecodeEventAmendManifest(originEvent abci.Event) *EventAmendManifest {
+	event := &EventAmendManifest{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "proposer":
+			proposer, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposer = proposer
+		case "proposerAddress":
+			event.ProposerAddress = v.Value
+		case "accepted":
+			accepted, err := strconv.ParseBool(v.Value)
+			if err != nil {
+				return nil
+			}
+			event.Accepted = accepted
+		case "version":
+			version, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Version = version
+		case "height":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Height = height
+		case "reason":
+			event.Reason = v.Value
+		case "voteCode":
+			code, err := strconv.ParseInt(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.VoteCode = code
+		}
+	}
+	return event
+}
+
+// EventAmendParams records the vote on a params amendment. When Accepted
+// the chain runs on Params from Height on.
+type EventAmendParams struct {
+	Proposer        uint64  `json:"proposerIndex"`
+	ProposerAddress string  `json:"proposerAddress"`
+	Accepted        bool    `json:"accepted"`
+	Params          *Params `json:"params"`
+	Height          uint64  `json:"height"`
+	Reason          string  `json:"reason"`
+	VoteCode        int64   `json:"voteCode"`
+}
+
+func EncodeEventAmendParams(event *EventAmendParams) abci.Event {
+	params, _ := json.Marshal(event.Params)
+	return abci.Event{
+		Type: EventAmendParamsType,
+		Attributes: []abci.EventAttribute{
+			{Key: "proposer", Value: fmt.Sprintf("%v", event.Proposer), Index: true},
+			{Key: "proposerAddress", Value: event.ProposerAddress, Index: false},
+			{Key: "accepted", Value: fmt.Sprintf("%v", event.Accepted), Index: false},
+			{Key: "params", Value: string(params), Index: false},
+			{Key: "height", Value: fmt.Sprintf("%v", event.Height), Index: false},
+			{Key: "reason", Value: event.Reason, Index: false},
+			{Key: "voteCode", Value: fmt.Sprintf("%v", event.VoteCode), Index: false},
+		},
+	}
+}
+
+func DecodeEventAmendParams(originEvent abci.Event) *EventAmendParams {
+	event := &EventAmendParams{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "proposer":
+			proposer, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposer = proposer
+		case "proposerAddress":
+			event.ProposerAddress = v.Value
+		case "accepted":
+			accepted, err := strconv.ParseBool(v.Value)
+			if err != nil {
+				return nil
+			}
+			event.Accepted = accepted
+		case "params":
+			event.Params = new(Params)
+			if err := json.Unmarshal([]byte(v.Value), event.Params); err != nil {
+				return nil
+			}
+		case "height":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Height = height
+		case "reason":
+			event.Reason = v.Value
+		case "voteCode":
+			code, err := strconv.ParseInt(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.VoteCode = code
+		}
+	}
+	return event
+}
+
+// EventStake records Validator topping up its stake by Amount. The
+// validator holds Stake from then on.
+type EventStake struct {
+	Validator uint64 `json:"validatorIndex"`
+	Address   string `json:"address"`
+	Amount    uint64 `json:"amount"`
+	Stake     uint64 `json:"stake"`
+	Statement string `json:"statement"`
+}
+
+func EncodeEventStake(event *EventStake) abci.Event {
+	return abci.Event{
+		Type: EventStakeType,
+		Attributes: []abci.EventAttribute{
+			{Key: "validator", Value: fmt.Sprintf("%v", event.Validator), Index: true},
+			{Key: "addr", Value: event.Address, Index: false},
+			{Key: "amount", Value: fmt.Sprintf("%v", event.Amount), Index: false},
+			{Key: "stake", Value: fmt.Sprintf("%v", event.Stake), Index: false},
+			{Key: "statement", Value: event.Statement, Index: false},
+		},
+	}
+}
+
+func DecodeEventStake(originEvent abci.Event) *EventStake {
+	event := &EventStake{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "validator":
+			validator, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Validator = validator
+		case "addr":
+			event.Address = v.Value
+		case "amount":
+			amount, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Amount = amount
+		case "stake":
+			stake, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Stake = stake
+		case "statement":
+			event.Statement = v.Value
+		}
+	}
+	return event
+}
+
+// EventStakeReleased records Amount retracted by Validator at RetractHeight
+// leaving the unbonding queue at Height.
+type EventStakeReleased struct {
+	Validator     uint64 `json:"validatorIndex"`
+	Address       string `json:"address"`
+	Amount        uint64 `json:"amount"`
+	RetractHeight uint64 `json:"retractHeight"`
+	Height        uint64 `json:"height"`
+}
+
+func EncodeEventStakeReleased(event *EventStakeReleased) abci.Event {
+	return abci.Event{
+		Type: EventStakeReleasedType,
+		Attributes: []abci.EventAttribute{
+			{Key: "validator", Value: fmt.Sprintf("%v", event.Validator), Index: true},
+			{Key: "addr", Value: event.Address, Index: false},
+			{Key: "amount", Value: fmt.Sprintf("%v", event.Amount), Index: false},
+			{Key: "retractHeight", Value: fmt.Sprintf("%v", event.RetractHeight), Index: false},
+			{Key: "height", Value: fmt.Sprintf("%v", event.Height), Index: false},
+		},
+	}
+}
+
+func DecodeEventStakeReleased(originEvent abci.Event) *EventStakeReleased {
+	event := &EventStakeReleased{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "validator":
+			validator, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Validator = validator
+		case "addr":
+			event.Address = v.Value
+		case "amount":
+			amount, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Amount = amount
+		case "retractHeight":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.RetractHeight = height
+		case "height":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Height = height
+		}
+	}
+	return event
+}
+
+// EventSlash records the stake and the unbonding stake Validator lost for
+// the misbehavior committed at InfractionHeight.
+type EventSlash struct {
+	Validator        uint64 `json:"validatorIndex"`
+	Address          string `json:"address"`
+	Stake            uint64 `json:"stake"`
+	Unbonding        uint64 `json:"unbonding"`
+	Reason           string `json:"reason"`
+	InfractionHeight uint64 `json:"infractionHeight"`
+	Height           uint64 `json:"height"`
+}
+
+func EncodeEventSlash(event *EventSlash) abci.Event {
+	return abci.Event{
+		Type: EventSlashType,
+		Attributes: []abci.EventAttribute{
+			{Key: "validator", Value: fmt.Sprintf("%v", event.Validator), Index: true},
+			{Key: "addr", Value: event.Address, Index: false},
+			{Key: "stake", Value: fmt.Sprintf("%v", event.Stake), Index: false},
+			{Key: "unbonding", Value: fmt.Sprintf("%v", event.Unbonding), Index: false},
+			{Key: "reason", Value: event.Reason, Index: false},
+			{Key: "infractionHeight", Value: fmt.Sprintf("%v", event.InfractionHeight), Index: false},
+			{Key: "height", Value: fmt.Sprintf("%v", event.Height), Index: false},
+		},
+	}
+}
+
+func DecodeEventSlash(originEvent abci.Event) *EventSlash {
+	event := &EventSlash{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "validator":
+			validator, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Validator = validator
+		case "addr":
+			event.Address = v.Value
+		case "stake":
+			stake, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Stake = stake
+		case "unbonding":
+			unbonding, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Unbonding = unbonding
+		case "reason":
+			event.Reason = v.Value
+		case "infractionHeight":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.InfractionHeight = height
+		case "height":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Height = height
+		}
+	}
+	return event
+}
+
+type EventRationale struct {
+	Proposal         uint64 `json:"proposal"`
+	Height           uint64 `json:"height"`
+	Validator        uint64 `json:"validatorIndex"`
+	ValidatorAddress string `json:"address"`
+	VoteCode         int64  `json:"voteCode"`
+	Reason           string `json:"reason"`
+	Context          []byte `json:"context"`
+}
+
+func EncodeEventRationale(event *EventRationale) abci.Event {
+	return abci.Event{
+		Type: EventRationaleType,
+		Attributes: []abci.EventAttribute{
+			{Key: "proposal", Value: fmt.Sprintf("%v", event.Proposal), Index: true},
+			{Key: "height", Value: fmt.Sprintf("%v", event.Height), Index: false},
+			{Key: "validator", Value: fmt.Sprintf("%v", event.Validator), Index: true},
+			{Key: "address", Value: event.ValidatorAddress, Index: false},
+			{Key: "voteCode", Value: fmt.Sprintf("%v", event.VoteCode), Index: false},
+			{Key: "reason", Value: event.Reason, Index: false},
+			{Key: "context", Value: hex.EncodeToString(event.Context), Index: false},
+		},
+	}
+}
+
+func DecodeEventRationale(originEvent abci.Event) *EventRationale {
+	event := &EventRationale{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "proposal":
+			proposal, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Proposal = proposal
+		case "height":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
 			if err != nil {
 				return nil
 			}
-			event.Proposer = proposer
-		case "endHeight":
-			endHeight, err := strconv.ParseUint(v.Value, 10, 64)
+			event.Height = height
+		case "validator":
+			validator, err := strconv.ParseUint(v.Value, 10, 64)
 			if err != nil {
 				return nil
 			}
-			event.EndHeight = endHeight
-		case "status":
-			status, err := strconv.ParseUint(v.Value, 10, 64)
+			event.Validator = validator
+		case "address":
+			event.ValidatorAddress = v.Value
+		case "voteCode":
+			code, err := strconv.ParseInt(v.Value, 10, 64)
 			if err != nil {
 				return nil
 			}
-			event.Status = status
-		case "data":
-			event.Data = []byte(v.Value)
-		case "proposerAddress":
-			event.ProposerAddress = fmt.Sprintf("%v", v.Value)
-		case "title":
-			event.Title = v.Value
-		case "link":
-			event.Link = v.Value
-		case "imageUrl":
-			event.ImageUrl = v.Value
+			event.VoteCode = code
+		case "reason":
+			event.Reason = v.Value
+		case "context":
+			context, err := hex.DecodeString(v.Value)
+			if err != nil {
+				return nil
+			}
+			event.Context = context
 		}
 	}
 	return event
 }
 
-type EventSettleProposal struct {
-	Proposer uint64 `json:"proposerIndex"`
-	Proposal uint64 `json:"proposal"`
-	State    int64  `json:"state"`
+// EventVoteCode records a governance tx whose vote did not reach a quorum
+// on any single code, so it was settled by the no-quorum fallback. Index is
+// the position of the tx among the governance txs of the block.
+type EventVoteCode struct {
+	Height   uint64 `json:"height"`
+	Index    uint64 `json:"index"`
+	VoteCode int64  `json:"voteCode"`
 }
 
-func EncodeEventSettleProposal(event *EventSettleProposal) abci.Event {
+func EncodeEventVoteCode(event *EventVoteCode) abci.Event {
 	return abci.Event{
-		Type: EventSettleProposalType,
+		Type: EventVoteCodeType,
 		Attributes: []abci.EventAttribute{
-			{Key: "proposer", Value: fmt.Sprintf("%v", event.Proposer), Index: true},
-			{Key: "proposal", Value: fmt.Sprintf("%v", event.Proposal), Index: true},
-			{Key: "state", Value: fmt.Sprintf("%v", event.State), Index: false},
+			{Key: "height", Value: fmt.Sprintf("%v", event.Height), Index: true},
+			{Key: "index", Value: fmt.Sprintf("%v", event.Index), Index: false},
+			{Key: "voteCode", Value: fmt.Sprintf("%v", event.VoteCode), Index: false},
 		},
 	}
 }
 
-func DecodeEventSettleProposal(originEvent abci.Event) *EventSettleProposal {
-	event := &EventSettleProposal{}
+func DecodeEventVoteCode(originEvent abci.Event) *EventVoteCode {
+	event := &EventVoteCode{}
 	for _, v := range originEvent.Attributes {
 		switch v.Key {
-		case "proposer":
-			proposer, err := strconv.ParseUint(v.Value, 10, 64)
+		case "height":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
 			if err != nil {
 				return nil
 			}
-			event.Proposer = proposer
-		case "proposal":
-			proposal, err := strconv.ParseUint(v.Value, 10, 64)
+			event.Height = height
+		case "index":
+			index, err := strconv.ParseUint(v.Value, 10, 64)
 			if err != nil {
 				return nil
 			}
-			event.Proposal = proposal
-		case "state":
-			state, err := strconv.ParseInt(v.Value, 10, 64)
+			event.Index = index
+		case "voteCode":
+			code, err := strconv.ParseInt(v.Value, 10, 64)
 			if err != nil {
 				return nil
 			}
-			event.State = state
+			event.VoteCode = code
 		}
 	}
 	return event
@@ -334,7 +1162,376 @@ func ParseEventUnStake(originEvent abci.Event) *EventUnStake {
 			event.Amount = amount
 		case "addr":
 			event.Address = fmt.Sprintf("%v", v.Value)
+		case "stake":
+			stake, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Stake = stake
+		case "releaseHeight":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.ReleaseHeight = height
+		}
+	}
+	return event
+}
+
+// EventApply records a membership application pending until ExpireHeight.
+type EventApply struct {
+	Address         string `json:"address"`
+	Pubkey          string `json:"pubkey"`
+	Name            string `json:"name"`
+	AgentUrl        string `json:"agentUrl"`
+	Statement       string `json:"statement"`
+	ManifestVersion uint64 `json:"manifestVersion"`
+	ExpireHeight    uint64 `json:"expireHeight"`
+}
+
+func EncodeEventApply(event *EventApply) abci.Event {
+	return abci.Event{
+		Type: EventApplyType,
+		Attributes: []abci.EventAttribute{
+			{Key: "addr", Value: event.Address, Index: true},
+			{Key: "pubkey", Value: event.Pubkey, Index: false},
+			{Key: "name", Value: event.Name, Index: false},
+			{Key: "agentUrl", Value: event.AgentUrl, Index: false},
+			{Key: "statement", Value: event.Statement, Index: false},
+			{Key: "manifestVersion", Value: fmt.Sprintf("%v", event.ManifestVersion), Index: false},
+			{Key: "expireHeight", Value: fmt.Sprintf("%v", event.ExpireHeight), Index: false},
+		},
+	}
+}
+
+func DecodeEventApply(originEvent abci.Event) *EventApply {
+	event := &EventApply{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "addr":
+			event.Address = v.Value
+		case "pubkey":
+			event.Pubkey = v.Value
+		case "name":
+			event.Name = v.Value
+		case "agentUrl":
+			event.AgentUrl = v.Value
+		case "statement":
+			event.Statement = v.Value
+		case "manifestVersion":
+			version, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.ManifestVersion = version
+		case "expireHeight":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.ExpireHeight = height
+		}
+	}
+	return event
+}
+
+// EventApplicationExpired records a membership application no member
+// granted by its ExpireHeight, dropped at Height.
+type EventApplicationExpired struct {
+	Address      string `json:"address"`
+	ExpireHeight uint64 `json:"expireHeight"`
+	Height       uint64 `json:"height"`
+}
+
+func EncodeEventApplicationExpired(event *EventApplicationExpired) abci.Event {
+	return abci.Event{
+		Type: EventApplyExpiredType,
+		Attributes: []abci.EventAttribute{
+			{Key: "addr", Value: event.Address, Index: true},
+			{Key: "expireHeight", Value: fmt.Sprintf("%v", event.ExpireHeight), Index: false},
+			{Key: "height", Value: fmt.Sprintf("%v", event.Height), Index: false},
+		},
+	}
+}
+
+func DecodeEventApplicationExpired(originEvent abci.Event) *EventApplicationExpired {
+	event := &EventApplicationExpired{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "addr":
+			event.Address = v.Value
+		case "expireHeight":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.ExpireHeight = height
+		case "height":
+			height, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Height = height
+		}
+	}
+	return event
+}
+
+// EventUpdateProfile records the new profile of Validator.
+type EventUpdateProfile struct {
+	Validator uint64 `json:"validatorIndex"`
+	Address   string `json:"address"`
+	Name      string `json:"name"`
+	AgentUrl  string `json:"agentUrl"`
+	SelfIntro string `json:"selfIntro"`
+}
+
+func EncodeEventUpdateProfile(event *EventUpdateProfile) abci.Event {
+	return abci.Event{
+		Type: EventUpdateProfileType,
+		Attributes: []abci.EventAttribute{
+			{Key: "validator", Value: fmt.Sprintf("%v", event.Validator), Index: true},
+			{Key: "addr", Value: event.Address, Index: false},
+			{Key: "name", Value: event.Name, Index: false},
+			{Key: "agentUrl", Value: event.AgentUrl, Index: false},
+			{Key: "selfIntro", Value: event.SelfIntro, Index: false},
+		},
+	}
+}
+
+func DecodeEventUpdateProfile(originEvent abci.Event) *EventUpdateProfile {
+	event := &EventUpdateProfile{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "validator":
+			validator, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Validator = validator
+		case "addr":
+			event.Address = v.Value
+		case "name":
+			event.Name = v.Value
+		case "agentUrl":
+			event.AgentUrl = v.Value
+		case "selfIntro":
+			event.SelfIntro = v.Value
+		}
+	}
+	return event
+}
+
+// EventRotateKey records Validator moving from the key of OldAddress to
+// Pubkey, whose address is Address.
+type EventRotateKey struct {
+	Validator  uint64 `json:"validatorIndex"`
+	OldAddress string `json:"oldAddress"`
+	Address    string `json:"address"`
+	Pubkey     string `json:"pubkey"`
+}
+
+func EncodeEventRotateKey(event *EventRotateKey) abci.Event {
+	return abci.Event{
+		Type: EventRotateKeyType,
+		Attributes: []abci.EventAttribute{
+			{Key: "validator", Value: fmt.Sprintf("%v", event.Validator), Index: true},
+			{Key: "oldAddr", Value: event.OldAddress, Index: true},
+			{Key: "addr", Value: event.Address, Index: true},
+			{Key: "pubkey", Value: event.Pubkey, Index: false},
+		},
+	}
+}
+
+func DecodeEventRotateKey(originEvent abci.Event) *EventRotateKey {
+	event := &EventRotateKey{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "validator":
+			validator, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Validator = validator
+		case "oldAddr":
+			event.OldAddress = v.Value
+		case "addr":
+			event.Address = v.Value
+		case "pubkey":
+			event.Pubkey = v.Value
+		}
+	}
+	return event
+}
+
+// EventSetSigners records the signer set controlling Validator, Threshold
+// of Signers, or its own key when Threshold is 0.
+type EventSetSigners struct {
+	Validator uint64   `json:"validatorIndex"`
+	Address   string   `json:"address"`
+	Signers   []string `json:"signers"`
+	Threshold uint32   `json:"threshold"`
+}
+
+func EncodeEventSetSigners(event *EventSetSigners) abci.Event {
+	return abci.Event{
+		Type: EventSetSignersType,
+		Attributes: []abci.EventAttribute{
+			{Key: "validator", Value: fmt.Sprintf("%v", event.Validator), Index: true},
+			{Key: "addr", Value: event.Address, Index: false},
+			{Key: "signers", Value: strings.Join(event.Signers, ","), Index: false},
+			{Key: "threshold", Value: fmt.Sprintf("%v", event.Threshold), Index: false},
+		},
+	}
+}
+
+func DecodeEventSetSigners(originEvent abci.Event) *EventSetSigners {
+	event := &EventSetSigners{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "validator":
+			validator, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Validator = validator
+		case "addr":
+			event.Address = v.Value
+		case "signers":
+			if v.Value != "" {
+				event.Signers = strings.Split(v.Value, ",")
+			}
+		case "threshold":
+			threshold, err := strconv.ParseUint(v.Value, 10, 32)
+			if err != nil {
+				return nil
+			}
+			event.Threshold = uint32(threshold)
+		}
+	}
+	return event
+}
+
+// EventSession records the session key of Address registered, or renewed,
+// for Validator.
+type EventSession struct {
+	Validator    uint64 `json:"validatorIndex"`
+	Address      string `json:"address"`
+	Pubkey       string `json:"pubkey"`
+	Scope        []int  `json:"scope"`
+	ExpireHeight uint64 `json:"expireHeight"`
+	RateCap      uint64 `json:"rateCap"`
+}
+
+func EncodeEventSession(event *EventSession) abci.Event {
+	scope := make([]string, len(event.Scope))
+	for i, t := range event.Scope {
+		scope[i] = strconv.Itoa(t)
+	}
+	return abci.Event{
+		Type: EventSessionType,
+		Attributes: []abci.EventAttribute{
+			{Key: "validator", Value: fmt.Sprintf("%v", event.Validator), Index: true},
+			{Key: "addr", Value: event.Address, Index: true},
+			{Key: "pubkey", Value: event.Pubkey, Index: false},
+			{Key: "scope", Value: strings.Join(scope, ","), Index: false},
+			{Key: "expireHeight", Value: fmt.Sprintf("%v", event.ExpireHeight), Index: false},
+			{Key: "rateCap", Value: fmt.Sprintf("%v", event.RateCap), Index: false},
+		},
+	}
+}
+
+func DecodeEventSession(originEvent abci.Event) *EventSession {
+	event := &EventSession{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "validator":
+			validator, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Validator = validator
+		case "addr":
+			event.Address = v.Value
+		case "pubkey":
+			event.Pubkey = v.Value
+		case "scope":
+			if v.Value == "" {
+				continue
+			}
+			for _, t := range strings.Split(v.Value, ",") {
+				txType, err := strconv.Atoi(t)
+				if err != nil {
+					return nil
+				}
+				event.Scope = append(event.Scope, txType)
+			}
+		case "expireHeight":
+			expireHeight, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.ExpireHeight = expireHeight
+		case "rateCap":
+			rateCap, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.RateCap = rateCap
+		}
+	}
+	return event
+}
+
+// EventRevokeSession records the session key of Address removed from
+// Validator.
+type EventRevokeSession struct {
+	Validator uint64 `json:"validatorIndex"`
+	Address   string `json:"address"`
+}
+
+func EncodeEventRevokeSession(event *EventRevokeSession) abci.Event {
+	return abci.Event{
+		Type: EventRevokeSessionType,
+		Attributes: []abci.EventAttribute{
+			{Key: "validator", Value: fmt.Sprintf("%v", event.Validator), Index: true},
+			{Key: "addr", Value: event.Address, Index: true},
+		},
+	}
+}
+
+func DecodeEventRevokeSession(originEvent abci.Event) *EventRevokeSession {
+	event := &EventRevokeSession{}
+	for _, v := range originEvent.Attributes {
+		switch v.Key {
+		case "validator":
+			validator, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			event.Validator = validator
+		case "addr":
+			event.Address = v.Value
 		}
 	}
 	return event
 }
+
+// GenericEventType returns the type an event of type typ emitted by the
+// handler of a generic subtype is recorded with. The events of modules are
+// so kept apart from the events of the chain and of each other.
+func GenericEventType(subtype, typ string) string {
+	return EventGenericPrefix + subtype + "." + typ
+}
+
+// ParseGenericEventType splits the type of an event of a generic tx into
+// its subtype and the type the handler emitted it with.
+func ParseGenericEventType(eventType string) (subtype, typ string, ok bool) {
+	rest, ok := strings.CutPrefix(eventType, EventGenericPrefix)
+	if !ok {
+		return "", "", false
+	}
+	subtype, typ, ok = strings.Cut(rest, ".")
+	return
+}